@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/auth"
+	"github.com/augustdev/autoclip/internal/bootstrap"
+	"github.com/augustdev/autoclip/internal/dns"
+	"github.com/augustdev/autoclip/internal/githubapp"
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/augustdev/autoclip/internal/powerdns"
+	"github.com/augustdev/autoclip/internal/prometheus"
+	"github.com/augustdev/autoclip/internal/storage/pg"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/clusters"
+	"github.com/go-chi/chi/v5"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.uber.org/fx"
+)
+
+type config struct {
+	fx.Out
+
+	Db         pg.DbConfig
+	Temporal   bootstrap.TemporalClientConfig
+	GitHubApp  githubapp.Config
+	K8sWorker  k8sdeployments.Config
+	Cluster    bootstrap.ClusterConfig
+	PowerDNS   powerdns.Config
+	Prometheus prometheus.Config
+	Auth       auth.Config
+}
+
+type Workers struct {
+	K8s       worker.Worker
+	DNS       worker.Worker
+	Region    string
+	TaskQueue string
+}
+
+func main() {
+	fx.New(
+		fx.StopTimeout(1*time.Minute),
+		fx.Provide(
+			bootstrap.NewLogger,
+			bootstrap.LoadConfig[config],
+			bootstrap.CreateTemporalClient,
+			newWorkers,
+			bootstrap.NewK8sClient,
+			bootstrap.NewK8sDynamicClient,
+			pg.NewDatabase,
+			pg.NewServiceQueries,
+			pg.NewDeploymentQueries,
+			pg.NewProjectQueries,
+			pg.NewUserQueries,
+			pg.NewDnsQueries,
+			powerdns.NewClient,
+			prometheus.NewClient,
+			pg.NewClusterMap,
+			githubapp.NewService,
+			k8sdeployments.NewActivities,
+			k8sdeployments.NewWaker,
+			dns.NewActivities,
+		),
+		fx.Invoke(
+			registerAndStart,
+		),
+	).Run()
+}
+
+func newWorkers(c client.Client, clusterMap map[string]clusters.Cluster, cfg bootstrap.ClusterConfig) (*Workers, error) {
+	region := strings.TrimSpace(cfg.Region)
+	if region == "" {
+		return nil, fmt.Errorf("cluster.region is required (set CLUSTER_REGION)")
+	}
+	cluster, ok := clusterMap[region]
+	if !ok {
+		return nil, fmt.Errorf("unknown region %q", region)
+	}
+	if cluster.Status != "active" {
+		return nil, fmt.Errorf("region %q is not active (status=%q)", region, cluster.Status)
+	}
+	if cluster.TaskQueue == "" {
+		return nil, fmt.Errorf("region %q has empty task_queue", region)
+	}
+
+	slog.Info("Resolved cluster for worker",
+		"region", cluster.Region,
+		"task_queue", cluster.TaskQueue,
+		"has_dns", cluster.HasDns)
+
+	k8sWorker := worker.New(c, cluster.TaskQueue, worker.Options{
+		WorkerStopTimeout: 10 * time.Minute,
+	})
+
+	w := &Workers{K8s: k8sWorker, Region: cluster.Region, TaskQueue: cluster.TaskQueue}
+
+	if cluster.HasDns {
+		w.DNS = worker.New(c, dns.TaskQueue, worker.Options{
+			WorkerStopTimeout: 10 * time.Minute,
+		})
+	}
+
+	return w, nil
+}
+
+func registerAndStart(
+	lc fx.Lifecycle,
+	w *Workers,
+	c client.Client,
+	activities *k8sdeployments.Activities,
+	dnsActivities *dns.Activities,
+	waker *k8sdeployments.Waker,
+	k8sCfg k8sdeployments.Config,
+	logger *slog.Logger,
+) {
+	k8sdeployments.RegisterWorkflowsAndActivities(w.K8s, activities)
+	if w.DNS != nil {
+		dns.RegisterWorkflowsAndActivities(w.DNS, dnsActivities)
+	}
+
+	router := chi.NewRouter()
+	waker.RegisterRoutes(router)
+	wakeServer := &http.Server{
+		Addr:    ":" + k8sCfg.WakerPort,
+		Handler: router,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := startSleepSchedule(ctx, c, w); err != nil {
+				return err
+			}
+			if err := startRegistryGCSchedule(ctx, c, w); err != nil {
+				return err
+			}
+
+			logger.Info("Starting waker server", "port", k8sCfg.WakerPort)
+			go func() {
+				if err := wakeServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					logger.Error("Waker server failed", "error", err)
+				}
+			}()
+
+			logger.Info("Starting k8s temporal worker")
+			go func() {
+				if err := w.K8s.Run(worker.InterruptCh()); err != nil {
+					logger.Error(fmt.Sprintf("k8s worker failed: %v", err))
+					os.Exit(1)
+				}
+			}()
+			if w.DNS != nil {
+				logger.Info("Starting DNS temporal worker")
+				go func() {
+					if err := w.DNS.Run(worker.InterruptCh()); err != nil {
+						logger.Error(fmt.Sprintf("DNS worker failed: %v", err))
+						os.Exit(1)
+					}
+				}()
+			}
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Stopping temporal workers")
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := wakeServer.Shutdown(shutdownCtx); err != nil {
+				logger.Warn("Waker server shutdown failed", "error", err)
+			}
+			w.K8s.Stop()
+			if w.DNS != nil {
+				w.DNS.Stop()
+			}
+			return nil
+		},
+	})
+}
+
+// startSleepSchedule makes sure the region's idle sleeper cron workflow is
+// running. Every worker in the region tries; the first one wins.
+func startSleepSchedule(ctx context.Context, c client.Client, w *Workers) error {
+	_, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:           "sleep-idle-" + w.Region,
+		TaskQueue:    w.TaskQueue,
+		CronSchedule: "*/5 * * * *",
+	}, k8sdeployments.SleepIdleServicesWorkflow, k8sdeployments.SleepIdleServicesInput{
+		Region: w.Region,
+	})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if err != nil && !errors.As(err, &alreadyStarted) {
+		return fmt.Errorf("start idle sleep schedule: %w", err)
+	}
+	return nil
+}
+
+// startRegistryGCSchedule makes sure the region's registry retention cron
+// workflow is running. It runs ahead of the registry-gc CronJob, which
+// frees the blobs of the manifests deleted here.
+func startRegistryGCSchedule(ctx context.Context, c client.Client, w *Workers) error {
+	_, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:           "registry-gc-" + w.Region,
+		TaskQueue:    w.TaskQueue,
+		CronSchedule: "0 3 * * *",
+	}, k8sdeployments.RegistryGCWorkflow, k8sdeployments.RegistryGCInput{
+		Region: w.Region,
+	})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if err != nil && !errors.As(err, &alreadyStarted) {
+		return fmt.Errorf("start registry gc schedule: %w", err)
+	}
+	return nil
+}
@@ -2,14 +2,23 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"os"
 	"time"
 
+	"github.com/augustdev/autoclip/internal/auth"
 	"github.com/augustdev/autoclip/internal/bootstrap"
 	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/augustdev/autoclip/internal/githubapp"
 	"github.com/augustdev/autoclip/internal/gitserver"
 	"github.com/augustdev/autoclip/internal/storage/pg"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
 	"go.uber.org/fx"
 )
 
@@ -19,9 +28,16 @@ type config struct {
 	Db        pg.DbConfig
 	Temporal  bootstrap.TemporalClientConfig
 	GitServer gitserver.Config
+	Auth      auth.Config
+	GitHubApp githubapp.Config
 }
 
 func main() {
+	// receive-pack runs this binary as its pre-receive hook
+	if code, ok := gitserver.RunHook(os.Args); ok {
+		os.Exit(code)
+	}
+
 	fx.New(
 		fx.StopTimeout(15*time.Second),
 		fx.Provide(
@@ -34,13 +50,23 @@ func main() {
 			pg.NewUserQueries,
 			pg.NewGitHubCredsQueries,
 			pg.NewGitTokenQueries,
+			pg.NewSSHKeyQueries,
+			pg.NewInternalReposQueries,
+			pg.NewDnsQueries,
+			pg.NewProjectRouteQueries,
 			pg.NewClusterMap,
 			bootstrap.CreateTemporalClient,
+			githubapp.NewService,
 			deployments.NewService,
 			gitserver.NewServer,
+			gitserver.NewActivities,
+			newTemporalWorker,
 		),
 		fx.Invoke(
+			gitserver.RegisterWorkflowsAndActivities,
 			startGitServer,
+			startSSHServer,
+			startWorker,
 		),
 	).Run()
 }
@@ -74,3 +100,76 @@ func startGitServer(lc fx.Lifecycle, server *gitserver.Server, config gitserver.
 		},
 	})
 }
+
+func startSSHServer(lc fx.Lifecycle, server *gitserver.Server, config gitserver.Config, logger *slog.Logger) {
+	if config.SSHPort == "" {
+		return
+	}
+
+	var listener net.Listener
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			var err error
+			listener, err = net.Listen("tcp", ":"+config.SSHPort)
+			if err != nil {
+				return fmt.Errorf("listen for ssh: %w", err)
+			}
+			logger.Info("Starting git ssh server", "port", config.SSHPort)
+			go func() {
+				if err := server.ServeSSH(listener); err != nil && !errors.Is(err, net.ErrClosed) {
+					logger.Error("Git ssh server failed", "error", err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Shutting down git ssh server...")
+			return listener.Close()
+		},
+	})
+}
+
+func newTemporalWorker(c client.Client) worker.Worker {
+	return worker.New(c, gitserver.TaskQueue, worker.Options{
+		WorkerStopTimeout: time.Minute,
+	})
+}
+
+func startWorker(lc fx.Lifecycle, w worker.Worker, c client.Client, logger *slog.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := startMaintenanceSchedule(ctx, c); err != nil {
+				logger.Error("Failed to start git maintenance schedule", "error", err)
+			}
+
+			logger.Info("Starting git server temporal worker")
+			go func() {
+				if err := w.Run(worker.InterruptCh()); err != nil {
+					logger.Error(fmt.Sprintf("Worker failed: %v", err))
+					os.Exit(1)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Stopping git server temporal worker")
+			w.Stop()
+			return nil
+		},
+	})
+}
+
+// startMaintenanceSchedule makes sure the nightly git gc cron workflow is
+// running.
+func startMaintenanceSchedule(ctx context.Context, c client.Client) error {
+	_, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:           "git-maintenance",
+		TaskQueue:    gitserver.TaskQueue,
+		CronSchedule: "0 4 * * *",
+	}, gitserver.MaintainReposWorkflow, gitserver.MaintainReposInput{})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if err != nil && !errors.As(err, &alreadyStarted) {
+		return fmt.Errorf("start git maintenance schedule: %w", err)
+	}
+	return nil
+}
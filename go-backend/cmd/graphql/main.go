@@ -53,6 +53,7 @@ func main() {
 			pg.NewResourceQueries,
 			pg.NewInternalReposQueries,
 			pg.NewDnsQueries,
+			pg.NewProjectRouteQueries,
 			pg.NewClusterMap,
 			bootstrap.CreateTemporalClient,
 			github_oauth.NewOAuthService,
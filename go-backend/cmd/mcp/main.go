@@ -13,8 +13,10 @@ import (
 	"github.com/augustdev/autoclip/internal/mcp_oauth"
 	"github.com/augustdev/autoclip/internal/mcpserver"
 	"github.com/augustdev/autoclip/internal/powerdns"
+	"github.com/augustdev/autoclip/internal/projectplan"
 	"github.com/augustdev/autoclip/internal/resources"
 	"github.com/augustdev/autoclip/internal/storage/pg"
+	"github.com/augustdev/autoclip/internal/templates"
 	"github.com/augustdev/autoclip/internal/turso"
 
 	"go.uber.org/fx"
@@ -37,6 +39,7 @@ type config struct {
 	Loki           mcpserver.LokiConfig
 	DNS            dns.Config
 	PowerDNS       powerdns.Config
+	Templates      templates.Config
 }
 
 func main() {
@@ -55,6 +58,7 @@ func main() {
 			pg.NewResourceQueries,
 			pg.NewInternalReposQueries,
 			pg.NewDnsQueries,
+			pg.NewProjectRouteQueries,
 			pg.NewClusterMap,
 			bootstrap.CreateTemporalClient,
 			github_oauth.NewOAuthService,
@@ -66,6 +70,8 @@ func main() {
 			dns.NewService,
 			resources.NewService,
 			internalgit.NewService,
+			projectplan.NewService,
+			templates.NewService,
 			bootstrap.NewTokenValidator,
 			mcpserver.NewServer,
 			mcp_oauth.NewMCPOAuthService,
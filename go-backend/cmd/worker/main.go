@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/account"
+	"github.com/augustdev/autoclip/internal/auth"
+	"github.com/augustdev/autoclip/internal/bootstrap"
+	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/augustdev/autoclip/internal/dns"
+	"github.com/augustdev/autoclip/internal/powerdns"
+	"github.com/augustdev/autoclip/internal/projectplan"
+	"github.com/augustdev/autoclip/internal/resources"
+	"github.com/augustdev/autoclip/internal/storage/pg"
+	"github.com/augustdev/autoclip/internal/turso"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.uber.org/fx"
+)
+
+type config struct {
+	fx.Out
+
+	Db       pg.DbConfig
+	Temporal bootstrap.TemporalClientConfig
+	Auth     auth.Config
+	Turso    turso.Config
+	DNS      dns.Config
+	PowerDNS powerdns.Config
+}
+
+func main() {
+	fx.New(
+		fx.StopTimeout(1*time.Minute),
+		fx.Provide(
+			bootstrap.NewLogger,
+			bootstrap.LoadConfig[config],
+			pg.NewDatabase,
+			pg.NewProjectQueries,
+			pg.NewServiceQueries,
+			pg.NewDeploymentQueries,
+			pg.NewUserQueries,
+			pg.NewGitHubCredsQueries,
+			pg.NewResourceQueries,
+			pg.NewDnsQueries,
+			pg.NewProjectRouteQueries,
+			pg.NewClusterMap,
+			bootstrap.CreateTemporalClient,
+			bootstrap.NewTursoClient,
+			powerdns.NewClient,
+			deployments.NewService,
+			resources.NewService,
+			dns.NewService,
+			newTemporalWorker,
+			account.NewActivities,
+			projectplan.NewActivities,
+		),
+		fx.Invoke(
+			account.RegisterWorkflowsAndActivities,
+			projectplan.RegisterWorkflowsAndActivities,
+			startWorker,
+		),
+	).Run()
+}
+
+func newTemporalWorker(c client.Client) worker.Worker {
+	return worker.New(c, "default", worker.Options{
+		WorkerStopTimeout: 10 * time.Minute,
+	})
+}
+
+func startWorker(lc fx.Lifecycle, w worker.Worker, logger *slog.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("Starting temporal worker")
+			go func() {
+				if err := w.Run(worker.InterruptCh()); err != nil {
+					logger.Error(fmt.Sprintf("Worker failed: %v", err))
+					os.Exit(1)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Stopping temporal worker")
+			w.Stop()
+			return nil
+		},
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/augustdev/autoclip/internal/githubapp"
 	"github.com/augustdev/autoclip/internal/graph"
 	"github.com/augustdev/autoclip/internal/graph/dataloader"
+	"github.com/augustdev/autoclip/internal/internalgit"
 	"github.com/augustdev/autoclip/internal/prometheus"
 	"github.com/augustdev/autoclip/internal/storage/pg"
 	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
@@ -47,6 +48,7 @@ func NewResolver(
 	deployService *deployments.Service,
 	dnsService *dns.Service,
 	githubAppService *githubapp.Service,
+	internalGitService *internalgit.Service,
 	serviceQueries services.Querier,
 	projectQueries projects.Querier,
 	resourceQueries resources.Querier,
@@ -54,17 +56,18 @@ func NewResolver(
 	prometheusClient *prometheus.Client,
 ) *graph.Resolver {
 	return &graph.Resolver{
-		Db:               pgdb,
-		Logger:           logger,
-		AuthService:      authService,
-		DeployService:    deployService,
-		DNSService:       dnsService,
-		GitHubAppService: githubAppService,
-		ServiceQueries:   serviceQueries,
-		ProjectQueries:   projectQueries,
-		ResourceQueries:  resourceQueries,
-		FirebaseAuth:     firebaseAuth,
-		PrometheusClient: prometheusClient,
+		Db:                 pgdb,
+		Logger:             logger,
+		AuthService:        authService,
+		DeployService:      deployService,
+		DNSService:         dnsService,
+		GitHubAppService:   githubAppService,
+		InternalGitService: internalGitService,
+		ServiceQueries:     serviceQueries,
+		ProjectQueries:     projectQueries,
+		ResourceQueries:    resourceQueries,
+		FirebaseAuth:       firebaseAuth,
+		PrometheusClient:   prometheusClient,
 	}
 }
 
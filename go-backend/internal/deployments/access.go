@@ -0,0 +1,80 @@
+package deployments
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	maxBasicAuthUsers = 20
+	maxIPAllowList    = 50
+	minPasswordLength = 8
+)
+
+// buildAccessPolicy validates the input, hashes passwords and normalizes
+// allowlist entries to CIDR notation. An empty input yields a public policy.
+func buildAccessPolicy(input AccessPolicyInput) (k8sdeployments.AccessPolicy, error) {
+	var policy k8sdeployments.AccessPolicy
+
+	if len(input.BasicAuth) > maxBasicAuthUsers {
+		return policy, fmt.Errorf("at most %d basic auth users are allowed", maxBasicAuthUsers)
+	}
+	seen := make(map[string]bool, len(input.BasicAuth))
+	for _, cred := range input.BasicAuth {
+		username := strings.TrimSpace(cred.Username)
+		if username == "" {
+			return policy, fmt.Errorf("basic auth username is required")
+		}
+		if strings.ContainsAny(username, ": \t\n") {
+			return policy, fmt.Errorf("basic auth username %q must not contain colons or whitespace", username)
+		}
+		if seen[username] {
+			return policy, fmt.Errorf("duplicate basic auth username %q", username)
+		}
+		seen[username] = true
+		if len(cred.Password) < minPasswordLength {
+			return policy, fmt.Errorf("password for %q must be at least %d characters", username, minPasswordLength)
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(cred.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return policy, fmt.Errorf("hash password: %w", err)
+		}
+		policy.BasicAuth = append(policy.BasicAuth, k8sdeployments.BasicAuthUser{
+			Username:     username,
+			PasswordHash: string(hash),
+		})
+	}
+
+	if len(input.IPAllowList) > maxIPAllowList {
+		return policy, fmt.Errorf("at most %d ip allowlist entries are allowed", maxIPAllowList)
+	}
+	for _, entry := range input.IPAllowList {
+		cidr, err := normalizeCIDR(entry)
+		if err != nil {
+			return policy, err
+		}
+		policy.IPAllowList = append(policy.IPAllowList, cidr)
+	}
+
+	return policy, nil
+}
+
+// normalizeCIDR accepts a bare IP or a CIDR and returns canonical CIDR form.
+func normalizeCIDR(entry string) (string, error) {
+	entry = strings.TrimSpace(entry)
+	if ip := net.ParseIP(entry); ip != nil {
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+	_, ipNet, err := net.ParseCIDR(entry)
+	if err != nil {
+		return "", fmt.Errorf("invalid ip allowlist entry %q: expected an IP address or CIDR", entry)
+	}
+	return ipNet.String(), nil
+}
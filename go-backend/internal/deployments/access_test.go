@@ -0,0 +1,76 @@
+package deployments
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestNormalizeCIDR(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   string
+		want    string
+		wantErr bool
+	}{
+		{name: "bare ipv4", entry: "203.0.113.7", want: "203.0.113.7/32"},
+		{name: "bare ipv6", entry: "2001:db8::1", want: "2001:db8::1/128"},
+		{name: "cidr is canonicalized", entry: "10.1.2.3/8", want: "10.0.0.0/8"},
+		{name: "surrounding whitespace", entry: " 192.168.0.0/16 ", want: "192.168.0.0/16"},
+		{name: "garbage", entry: "example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeCIDR(tt.entry)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeCIDR(%q) expected error, got %q", tt.entry, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeCIDR(%q) unexpected error: %v", tt.entry, err)
+			}
+			if got != tt.want {
+				t.Fatalf("normalizeCIDR(%q) = %q, want %q", tt.entry, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildAccessPolicy(t *testing.T) {
+	policy, err := buildAccessPolicy(AccessPolicyInput{
+		BasicAuth:   []BasicAuthCredential{{Username: "admin", Password: "correct-horse"}},
+		IPAllowList: []string{"203.0.113.7"},
+	})
+	if err != nil {
+		t.Fatalf("buildAccessPolicy unexpected error: %v", err)
+	}
+	if len(policy.BasicAuth) != 1 || policy.BasicAuth[0].Username != "admin" {
+		t.Fatalf("unexpected basic auth users: %+v", policy.BasicAuth)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(policy.BasicAuth[0].PasswordHash), []byte("correct-horse")); err != nil {
+		t.Fatalf("stored hash does not match password: %v", err)
+	}
+	if len(policy.IPAllowList) != 1 || policy.IPAllowList[0] != "203.0.113.7/32" {
+		t.Fatalf("unexpected allowlist: %v", policy.IPAllowList)
+	}
+
+	empty, err := buildAccessPolicy(AccessPolicyInput{})
+	if err != nil || !empty.IsPublic() {
+		t.Fatalf("empty input should yield a public policy, got %+v, %v", empty, err)
+	}
+
+	invalid := []AccessPolicyInput{
+		{BasicAuth: []BasicAuthCredential{{Username: "a:b", Password: "long-enough"}}},
+		{BasicAuth: []BasicAuthCredential{{Username: "admin", Password: "short"}}},
+		{BasicAuth: []BasicAuthCredential{{Username: "x", Password: "long-enough"}, {Username: "x", Password: "long-enough"}}},
+		{IPAllowList: []string{"not-an-ip"}},
+	}
+	for _, in := range invalid {
+		if _, err := buildAccessPolicy(in); err == nil {
+			t.Fatalf("buildAccessPolicy(%+v) expected error", in)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"go.temporal.io/sdk/client"
+)
+
+const maxDeployDebounce = 10 * time.Minute
+
+// parseDeployPolicy validates a deploy_policy and its debounce window (a
+// duration such as "45s") into the values stored on the service. An empty
+// policy means cancel_previous; debounce defaults to 30s and only applies
+// to the debounce policy.
+func parseDeployPolicy(policy, debounce string) (string, *int32, error) {
+	policy = strings.TrimSpace(policy)
+	debounce = strings.TrimSpace(debounce)
+	switch policy {
+	case "":
+		policy = k8sdeployments.DeployPolicyCancelPrevious
+	case k8sdeployments.DeployPolicyCancelPrevious, k8sdeployments.DeployPolicyQueue, k8sdeployments.DeployPolicyDebounce:
+	default:
+		return "", nil, fmt.Errorf("invalid deploy_policy %q: use cancel_previous, queue or debounce", policy)
+	}
+
+	if policy != k8sdeployments.DeployPolicyDebounce {
+		if debounce != "" {
+			return "", nil, fmt.Errorf("deploy_debounce only applies to deploy_policy=debounce")
+		}
+		return policy, nil, nil
+	}
+	if debounce == "" {
+		return policy, nil, nil
+	}
+	d, err := time.ParseDuration(debounce)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid deploy_debounce %q: use a duration like 30s or 2m", debounce)
+	}
+	if d < time.Second || d > maxDeployDebounce {
+		return "", nil, fmt.Errorf("deploy_debounce must be between 1s and %s", maxDeployDebounce)
+	}
+	seconds := int32(d / time.Second)
+	return policy, &seconds, nil
+}
+
+// FormatDeployDebounce renders a stored deploy_debounce for display; nil
+// means the default window.
+func FormatDeployDebounce(policy string, seconds *int32) string {
+	if policy != k8sdeployments.DeployPolicyDebounce {
+		return ""
+	}
+	if seconds == nil {
+		return k8sdeployments.DefaultDeployDebounce.String()
+	}
+	return (time.Duration(*seconds) * time.Second).String()
+}
+
+// enqueueDeploy hands a deployment to the service's deploy coordinator,
+// starting the coordinator if it is not running, and returns the ID the
+// deploy workflow will run under.
+func (s *Service) enqueueDeploy(ctx context.Context, taskQueue, policy string, debounce *int32, initial bool, input k8sdeployments.DeployServiceInput) (string, error) {
+	req := k8sdeployments.DeployRequest{
+		Policy:  policy,
+		Initial: initial,
+		Deploy:  input,
+	}
+	if debounce != nil {
+		req.Debounce = time.Duration(*debounce) * time.Second
+	}
+
+	coordinatorID := k8sdeployments.DeployCoordinatorWorkflowID(input.ServiceID)
+	run, err := s.temporalClient.SignalWithStartWorkflow(ctx, coordinatorID, k8sdeployments.DeploySignal, req,
+		client.StartWorkflowOptions{
+			ID:        coordinatorID,
+			TaskQueue: taskQueue,
+		},
+		k8sdeployments.DeployCoordinatorWorkflow, k8sdeployments.DeployCoordinatorInput{ServiceID: input.ServiceID})
+	if err != nil {
+		return "", fmt.Errorf("signal deploy coordinator: %w", err)
+	}
+
+	s.logger.Info("queued deploy",
+		"service_id", input.ServiceID,
+		"deployment_id", input.DeploymentID,
+		"deploy_policy", policy,
+		"coordinator_run_id", run.GetRunID())
+	return k8sdeployments.DeployWorkflowID(input.DeploymentID), nil
+}
@@ -0,0 +1,71 @@
+package deployments
+
+import "testing"
+
+func TestParseDeployPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       string
+		debounce     string
+		wantPolicy   string
+		wantDebounce int32 // 0 means nil
+		wantErr      bool
+	}{
+		{name: "default", wantPolicy: "cancel_previous"},
+		{name: "queue", policy: "queue", wantPolicy: "queue"},
+		{name: "debounce default window", policy: "debounce", wantPolicy: "debounce"},
+		{name: "debounce window", policy: " debounce ", debounce: "45s", wantPolicy: "debounce", wantDebounce: 45},
+		{name: "debounce minutes", policy: "debounce", debounce: "2m", wantPolicy: "debounce", wantDebounce: 120},
+		{name: "unknown policy", policy: "parallel", wantErr: true},
+		{name: "window without debounce", policy: "queue", debounce: "30s", wantErr: true},
+		{name: "window too short", policy: "debounce", debounce: "500ms", wantErr: true},
+		{name: "window too long", policy: "debounce", debounce: "1h", wantErr: true},
+		{name: "bad window", policy: "debounce", debounce: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, debounce, err := parseDeployPolicy(tt.policy, tt.debounce)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseDeployPolicy(%q, %q) expected error", tt.policy, tt.debounce)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDeployPolicy(%q, %q) unexpected error: %v", tt.policy, tt.debounce, err)
+			}
+			if policy != tt.wantPolicy {
+				t.Errorf("policy = %q, want %q", policy, tt.wantPolicy)
+			}
+			if tt.wantDebounce == 0 {
+				if debounce != nil {
+					t.Errorf("debounce = %d, want nil", *debounce)
+				}
+				return
+			}
+			if debounce == nil || *debounce != tt.wantDebounce {
+				t.Errorf("debounce = %v, want %d", debounce, tt.wantDebounce)
+			}
+		})
+	}
+}
+
+func TestFormatDeployDebounce(t *testing.T) {
+	seconds := int32(90)
+	tests := []struct {
+		policy  string
+		seconds *int32
+		want    string
+	}{
+		{policy: "cancel_previous", want: ""},
+		{policy: "queue", seconds: &seconds, want: ""},
+		{policy: "debounce", want: "30s"},
+		{policy: "debounce", seconds: &seconds, want: "1m30s"},
+	}
+	for _, tt := range tests {
+		if got := FormatDeployDebounce(tt.policy, tt.seconds); got != tt.want {
+			t.Errorf("FormatDeployDebounce(%q, %v) = %q, want %q", tt.policy, tt.seconds, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	commitSHARe      = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	shortCommitSHARe = regexp.MustCompile(`^[0-9a-f]{7,39}$`)
+)
+
+// normalizeDeployRef validates a commit SHA, tag or branch to deploy. Full
+// SHAs are lowercased; abbreviated ones are rejected because a shallow
+// fetch cannot resolve them.
+func normalizeDeployRef(ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", fmt.Errorf("ref is required")
+	}
+	if lower := strings.ToLower(ref); commitSHARe.MatchString(lower) {
+		return lower, nil
+	}
+	if shortCommitSHARe.MatchString(ref) && strings.ContainsAny(ref, "abcdef") {
+		return "", fmt.Errorf("ref %q looks like an abbreviated commit SHA: use the full 40-character SHA", ref)
+	}
+	if strings.HasPrefix(ref, "-") || strings.HasPrefix(ref, "/") || strings.HasSuffix(ref, "/") ||
+		strings.HasSuffix(ref, ".lock") || strings.HasSuffix(ref, ".") ||
+		strings.Contains(ref, "..") || strings.Contains(ref, "//") || strings.Contains(ref, "@{") ||
+		strings.ContainsAny(ref, " ~^:?*[\\") {
+		return "", fmt.Errorf("invalid ref %q", ref)
+	}
+	for _, r := range ref {
+		if r < 0x20 || r == 0x7f {
+			return "", fmt.Errorf("invalid ref %q", ref)
+		}
+	}
+	return ref, nil
+}
+
+func isCommitSHA(ref string) bool {
+	return commitSHARe.MatchString(ref)
+}
+
+// RedeployServiceAtRef deploys the service at a commit SHA, tag or branch
+// instead of its branch HEAD.
+func (s *Service) RedeployServiceAtRef(ctx context.Context, svcID, ref string) (string, error) {
+	ref, err := normalizeDeployRef(ref)
+	if err != nil {
+		return "", err
+	}
+	return s.redeployWithTrigger(ctx, svcID, "manual_ref", ref, "")
+}
@@ -0,0 +1,44 @@
+package deployments
+
+import "testing"
+
+func TestNormalizeDeployRef(t *testing.T) {
+	sha := "3f2c9a1b7e4d6c8a0b1e2f3a4b5c6d7e8f9a0b1c"
+	tests := []struct {
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{ref: sha, want: sha},
+		{ref: " 3F2C9A1B7E4D6C8A0B1E2F3A4B5C6D7E8F9A0B1C ", want: sha},
+		{ref: "v1.4.0", want: "v1.4.0"},
+		{ref: "release/2024-10", want: "release/2024-10"},
+		{ref: "refs/tags/v2", want: "refs/tags/v2"},
+		{ref: "1234567", want: "1234567"},
+		{ref: "", wantErr: true},
+		{ref: "3f2c9a1", wantErr: true},
+		{ref: "--upload-pack=sh", wantErr: true},
+		{ref: "main..dev", wantErr: true},
+		{ref: "main:prod", wantErr: true},
+		{ref: "HEAD~1", wantErr: true},
+		{ref: "feature branch", wantErr: true},
+		{ref: "topic.lock", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeDeployRef(tt.ref)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("normalizeDeployRef(%q) = %q, expected error", tt.ref, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("normalizeDeployRef(%q) unexpected error: %v", tt.ref, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeDeployRef(%q) = %q, want %q", tt.ref, got, tt.want)
+		}
+	}
+}
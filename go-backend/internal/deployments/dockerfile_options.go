@@ -0,0 +1,97 @@
+package deployments
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+)
+
+const (
+	maxBuildArgs        = 50
+	maxDockerfileTarget = 128
+)
+
+var (
+	buildArgKeyRe      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	dockerfileTargetRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+)
+
+// normalizeBuildArgs turns build_args into the map stored in build_config.
+// They are passed as --build-arg only and never reach the running service.
+func normalizeBuildArgs(args []EnvVar) (map[string]string, error) {
+	if len(args) > maxBuildArgs {
+		return nil, fmt.Errorf("at most %d build_args are allowed", maxBuildArgs)
+	}
+	if len(args) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]string, len(args))
+	for _, a := range args {
+		key := strings.TrimSpace(a.Key)
+		if !buildArgKeyRe.MatchString(key) {
+			return nil, fmt.Errorf("invalid build_args key %q", a.Key)
+		}
+		out[key] = a.Value
+	}
+	return out, nil
+}
+
+// normalizeDockerfileTarget validates a multi-stage build target (the
+// stage name after "AS" in a FROM line).
+func normalizeDockerfileTarget(target string) (string, error) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return "", nil
+	}
+	if len(target) > maxDockerfileTarget || !dockerfileTargetRe.MatchString(target) {
+		return "", fmt.Errorf("invalid dockerfile_target %q", target)
+	}
+	return target, nil
+}
+
+// normalizeBuildContext validates build_context, which is relative to
+// rootDirectory and may point above it, but not outside the repo. "." and
+// "" both mean the root_directory itself.
+func normalizeBuildContext(rootDirectory, buildContext string) (string, error) {
+	buildContext = strings.TrimSpace(buildContext)
+	if buildContext == "" {
+		return "", nil
+	}
+	if strings.HasPrefix(buildContext, "/") {
+		return "", fmt.Errorf("build_context %q must be relative to root_directory", buildContext)
+	}
+	cleaned := path.Clean(buildContext)
+	if cleaned == "." {
+		return "", nil
+	}
+	joined := path.Join(strings.Trim(path.Clean("/"+rootDirectory), "/"), cleaned)
+	if joined == ".." || strings.HasPrefix(joined, "../") {
+		return "", fmt.Errorf("build_context %q points outside the repo", buildContext)
+	}
+	return cleaned, nil
+}
+
+// checkDockerfileOptions rejects options only the Dockerfile build reads, so
+// setting them on another build pack fails instead of being ignored.
+func checkDockerfileOptions(buildPack string, bc k8sdeployments.BuildConfig) error {
+	if buildPack == "dockerfile" {
+		return nil
+	}
+	var field string
+	switch {
+	case bc.DockerfilePath != "":
+		field = "dockerfile_path"
+	case bc.DockerfileTarget != "":
+		field = "dockerfile_target"
+	case len(bc.BuildArgs) > 0:
+		field = "build_args"
+	case bc.BuildContext != "":
+		field = "build_context"
+	default:
+		return nil
+	}
+	return fmt.Errorf("%s is only supported with build_pack=dockerfile", field)
+}
@@ -0,0 +1,143 @@
+package deployments
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+)
+
+func TestNormalizeBuildArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []EnvVar
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "empty", args: nil, want: nil},
+		{name: "trims keys", args: []EnvVar{{Key: " VERSION ", Value: "1.2.3"}, {Key: "_X", Value: ""}}, want: map[string]string{"VERSION": "1.2.3", "_X": ""}},
+		{name: "last wins", args: []EnvVar{{Key: "A", Value: "1"}, {Key: "A", Value: "2"}}, want: map[string]string{"A": "2"}},
+		{name: "leading digit", args: []EnvVar{{Key: "1A", Value: "x"}}, wantErr: true},
+		{name: "dash", args: []EnvVar{{Key: "NODE-ENV", Value: "x"}}, wantErr: true},
+		{name: "empty key", args: []EnvVar{{Key: "", Value: "x"}}, wantErr: true},
+		{name: "too many", args: make([]EnvVar, maxBuildArgs+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeBuildArgs(tt.args)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeBuildArgs(%v) expected error", tt.args)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeBuildArgs(%v) unexpected error: %v", tt.args, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeBuildArgs(%v) = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeDockerfileTarget(t *testing.T) {
+	tests := []struct {
+		target  string
+		want    string
+		wantErr bool
+	}{
+		{target: "", want: ""},
+		{target: " prod ", want: "prod"},
+		{target: "build-stage.v2", want: "build-stage.v2"},
+		{target: "-prod", wantErr: true},
+		{target: "prod stage", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			got, err := normalizeDockerfileTarget(tt.target)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeDockerfileTarget(%q) expected error", tt.target)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeDockerfileTarget(%q) unexpected error: %v", tt.target, err)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeDockerfileTarget(%q) = %q, want %q", tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeBuildContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		root    string
+		context string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", root: "services/api", context: "", want: ""},
+		{name: "dot", root: "services/api", context: "./", want: ""},
+		{name: "subdirectory", root: "", context: "./docker/", want: "docker"},
+		{name: "parent within repo", root: "services/api", context: "../..", want: "../.."},
+		{name: "escapes repo", root: "services/api", context: "../../..", wantErr: true},
+		{name: "parent of repo root", root: "", context: "..", wantErr: true},
+		{name: "absolute", root: "", context: "/etc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeBuildContext(tt.root, tt.context)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeBuildContext(%q, %q) expected error", tt.root, tt.context)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeBuildContext(%q, %q) unexpected error: %v", tt.root, tt.context, err)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeBuildContext(%q, %q) = %q, want %q", tt.root, tt.context, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckDockerfileOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		buildPack string
+		bc        k8sdeployments.BuildConfig
+		wantField string
+	}{
+		{name: "dockerfile", buildPack: "dockerfile", bc: k8sdeployments.BuildConfig{DockerfilePath: "docker/Dockerfile", DockerfileTarget: "prod", BuildArgs: map[string]string{"A": "1"}, BuildContext: ".."}},
+		{name: "railpack without options", buildPack: "railpack", bc: k8sdeployments.BuildConfig{BuildCommand: "make"}},
+		{name: "railpack dockerfile_path", buildPack: "railpack", bc: k8sdeployments.BuildConfig{DockerfilePath: "Dockerfile.prod"}, wantField: "dockerfile_path"},
+		{name: "static target", buildPack: "static", bc: k8sdeployments.BuildConfig{DockerfileTarget: "prod"}, wantField: "dockerfile_target"},
+		{name: "image build_args", buildPack: k8sdeployments.BuildPackImage, bc: k8sdeployments.BuildConfig{BuildArgs: map[string]string{"A": "1"}}, wantField: "build_args"},
+		{name: "railpack build_context", buildPack: "railpack", bc: k8sdeployments.BuildConfig{BuildContext: ".."}, wantField: "build_context"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDockerfileOptions(tt.buildPack, tt.bc)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantField+" ") {
+				t.Fatalf("error = %v, want one naming %s", err, tt.wantField)
+			}
+		})
+	}
+}
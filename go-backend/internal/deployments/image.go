@@ -0,0 +1,87 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/lithammer/shortuuid/v4"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+const imageCheckTimeout = 45 * time.Second
+
+// registryCredentialsInput turns the optional username/password pair into
+// credentials; both empty means the image is pulled anonymously.
+func registryCredentialsInput(username, password string) (*k8sdeployments.RegistryCredentials, error) {
+	username = strings.TrimSpace(username)
+	if username == "" && password == "" {
+		return nil, nil
+	}
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("registry_username and registry_password must be set together")
+	}
+	return &k8sdeployments.RegistryCredentials{Username: username, Password: password}, nil
+}
+
+// prepareImage normalizes a prebuilt image reference, encrypts the
+// credentials to store on the service and checks that the image can be
+// pulled with them. The check runs on the region's deployer worker, which
+// holds the registry config and keeps user registries off private and
+// in-cluster addresses; the API never contacts a registry itself.
+func (s *Service) prepareImage(ctx context.Context, taskQueue, image string, creds *k8sdeployments.RegistryCredentials) (*string, *string, error) {
+	if strings.TrimSpace(image) == "" {
+		return nil, nil, fmt.Errorf("image is required when build_pack is image")
+	}
+	named, err := k8sdeployments.ParseImage(image)
+	if err != nil {
+		return nil, nil, err
+	}
+	normalized := named.String()
+
+	var encrypted *string
+	if creds != nil {
+		e, err := k8sdeployments.EncryptRegistryCredentials(*creds, s.authConfig.APIKeyEncryptionKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encrypt registry credentials: %w", err)
+		}
+		encrypted = &e
+	}
+
+	if err := s.checkImage(ctx, taskQueue, normalized, encrypted); err != nil {
+		return nil, nil, err
+	}
+	return &normalized, encrypted, nil
+}
+
+func (s *Service) checkImage(ctx context.Context, taskQueue, image string, registryCreds *string) error {
+	checkCtx, cancel := context.WithTimeout(ctx, imageCheckTimeout)
+	defer cancel()
+
+	run, err := s.temporalClient.ExecuteWorkflow(checkCtx, client.StartWorkflowOptions{
+		ID:                       "check-image-" + shortuuid.New(),
+		TaskQueue:                taskQueue,
+		WorkflowExecutionTimeout: imageCheckTimeout,
+	}, k8sdeployments.CheckImageWorkflow, k8sdeployments.CheckImageInput{
+		Image:               image,
+		RegistryCredentials: registryCreds,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start image check: %w", err)
+	}
+	if err := run.Get(checkCtx, nil); err != nil {
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) {
+			return fmt.Errorf("image not accessible: %s", appErr.Message())
+		}
+		if checkCtx.Err() != nil && ctx.Err() == nil {
+			return fmt.Errorf("image check for %s timed out; try again", image)
+		}
+		return fmt.Errorf("image check for %s: %w", image, err)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package deployments
+
+import "testing"
+
+func TestRegistryCredentialsInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		password string
+		wantNil  bool
+		wantErr  bool
+	}{
+		{name: "anonymous", wantNil: true},
+		{name: "both set", username: "bot", password: "s3cret"},
+		{name: "username only", username: "bot", wantErr: true},
+		{name: "password only", password: "s3cret", wantErr: true},
+		{name: "blank username", username: "  ", password: "s3cret", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := registryCredentialsInput(tt.username, tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (creds == nil) != tt.wantNil {
+				t.Errorf("creds = %+v, wantNil %v", creds, tt.wantNil)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/lithammer/shortuuid/v4"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+type ServiceLifecycleParams struct {
+	Name    string
+	Project string
+	UserID  string
+}
+
+type ServiceLifecycleResult struct {
+	ServiceID  string
+	Name       string
+	Action     string
+	EventID    string
+	Status     string
+	WorkflowID string
+}
+
+// StopService scales the current deployment to zero. Routes, env vars and
+// the image stay in place so StartService brings back the same revision.
+func (s *Service) StopService(ctx context.Context, params ServiceLifecycleParams) (*ServiceLifecycleResult, error) {
+	return s.runLifecycle(ctx, params, k8sdeployments.LifecycleStop)
+}
+
+// StartService scales a stopped (or sleeping) service back to one replica.
+func (s *Service) StartService(ctx context.Context, params ServiceLifecycleParams) (*ServiceLifecycleResult, error) {
+	return s.runLifecycle(ctx, params, k8sdeployments.LifecycleStart)
+}
+
+// RestartService replaces the running pods without rebuilding.
+func (s *Service) RestartService(ctx context.Context, params ServiceLifecycleParams) (*ServiceLifecycleResult, error) {
+	return s.runLifecycle(ctx, params, k8sdeployments.LifecycleRestart)
+}
+
+// checkLifecycleAction rejects actions that make no sense for the service's
+// current state.
+func checkLifecycleAction(action, name string, stopped, sleeping bool) error {
+	switch action {
+	case k8sdeployments.LifecycleStop:
+		if stopped {
+			return fmt.Errorf("service %s is already stopped", name)
+		}
+	case k8sdeployments.LifecycleStart:
+		if !stopped && !sleeping {
+			return fmt.Errorf("service %s is already running", name)
+		}
+	case k8sdeployments.LifecycleRestart:
+		if stopped {
+			return fmt.Errorf("service %s is stopped; start it instead", name)
+		}
+		// A sleeping service has no pods to replace, and restarting would
+		// leave it scaled to zero with the sleep annotation in place.
+		if sleeping {
+			return fmt.Errorf("service %s is asleep; start it instead", name)
+		}
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+	return nil
+}
+
+func (s *Service) runLifecycle(ctx context.Context, params ServiceLifecycleParams, action string) (*ServiceLifecycleResult, error) {
+	project := params.Project
+	if project == "" {
+		project = "default"
+	}
+
+	svc, err := s.servicesQ.GetServiceByNameAndUserProject(ctx, services.GetServiceByNameAndUserProjectParams{
+		Name:   &params.Name,
+		UserID: params.UserID,
+		Ref:    project,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("service not found: %s in project %s", params.Name, project)
+	}
+
+	var name string
+	if svc.Name != nil {
+		name = *svc.Name
+	}
+
+	if svc.CurrentDeploymentID == nil {
+		return nil, fmt.Errorf("service %s has no active deployment", name)
+	}
+	if err := checkLifecycleAction(action, name, svc.StoppedAt.Valid, svc.SleepingAt.Valid); err != nil {
+		return nil, err
+	}
+
+	cluster, ok := s.clusters[svc.Region]
+	if !ok {
+		return nil, fmt.Errorf("unknown region %q for service %s", svc.Region, svc.ID)
+	}
+
+	proj, err := s.projectsQ.GetProjectByID(ctx, svc.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("project not found: %w", err)
+	}
+
+	eventID := shortuuid.New()
+	workflowID := fmt.Sprintf("lifecycle-%s", svc.ID)
+
+	if _, err := s.deploymentsQ.CreateServiceEvent(ctx, deploymentsdb.CreateServiceEventParams{
+		ID:         eventID,
+		ServiceID:  svc.ID,
+		Action:     action,
+		WorkflowID: workflowID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to create service event: %w", err)
+	}
+
+	// One lifecycle workflow per service at a time; a stop racing a start
+	// would leave the replica count to chance.
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                                       workflowID,
+		TaskQueue:                                cluster.TaskQueue,
+		WorkflowIDConflictPolicy:                 enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}
+
+	run, err := s.temporalClient.ExecuteWorkflow(ctx, workflowOptions, k8sdeployments.ServiceLifecycleWorkflow, k8sdeployments.ServiceLifecycleWorkflowInput{
+		EventID:   eventID,
+		ServiceID: svc.ID,
+		Namespace: k8sdeployments.NamespaceName(svc.UserID, proj.Ref),
+		Name:      k8sdeployments.ServiceName(name),
+		Action:    action,
+	})
+	if err != nil {
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			err = fmt.Errorf("another stop, start or restart is in progress for service %s", name)
+		} else {
+			err = fmt.Errorf("failed to start %s workflow: %w", action, err)
+		}
+		errMsg := err.Error()
+		if markErr := s.deploymentsQ.MarkServiceEventFailed(ctx, deploymentsdb.MarkServiceEventFailedParams{
+			ID:           eventID,
+			ErrorMessage: &errMsg,
+		}); markErr != nil {
+			s.logger.Warn("failed to mark service event failed", "event_id", eventID, "error", markErr)
+		}
+		return nil, err
+	}
+
+	s.logger.Info("started service lifecycle workflow",
+		"service_id", svc.ID,
+		"action", action,
+		"event_id", eventID,
+		"workflow_id", run.GetID())
+
+	return &ServiceLifecycleResult{
+		ServiceID:  svc.ID,
+		Name:       name,
+		Action:     action,
+		EventID:    eventID,
+		Status:     "queued",
+		WorkflowID: run.GetID(),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package deployments
+
+import (
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+)
+
+func TestCheckLifecycleAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   string
+		stopped  bool
+		sleeping bool
+		wantErr  bool
+	}{
+		{name: "stop running", action: k8sdeployments.LifecycleStop},
+		{name: "stop sleeping", action: k8sdeployments.LifecycleStop, sleeping: true},
+		{name: "stop stopped", action: k8sdeployments.LifecycleStop, stopped: true, wantErr: true},
+		{name: "start stopped", action: k8sdeployments.LifecycleStart, stopped: true},
+		{name: "start sleeping", action: k8sdeployments.LifecycleStart, sleeping: true},
+		{name: "start running", action: k8sdeployments.LifecycleStart, wantErr: true},
+		{name: "restart running", action: k8sdeployments.LifecycleRestart},
+		{name: "restart stopped", action: k8sdeployments.LifecycleRestart, stopped: true, wantErr: true},
+		{name: "restart sleeping", action: k8sdeployments.LifecycleRestart, sleeping: true, wantErr: true},
+		{name: "unknown", action: "pause", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkLifecycleAction(tt.action, "web", tt.stopped, tt.sleeping)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkLifecycleAction(%q) error = %v, wantErr %v", tt.action, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/jackc/pgx/v5"
+)
+
+// Public ports are NodePorts, so the pool must stay inside the apiserver's
+// --service-node-port-range (default 30000-32767).
+const (
+	publicPortRangeStart = 30000
+	publicPortRangeEnd   = 32767
+	maxServicePorts      = 10
+
+	maxPortAllocationAttempts = 5
+)
+
+func validatePorts(ports []PortInput) ([]PortInput, error) {
+	if len(ports) > maxServicePorts {
+		return nil, fmt.Errorf("at most %d additional ports are allowed", maxServicePorts)
+	}
+	seen := make(map[string]bool, len(ports))
+	out := make([]PortInput, 0, len(ports))
+	for _, p := range ports {
+		protocol := strings.ToLower(strings.TrimSpace(p.Protocol))
+		if protocol == "" {
+			protocol = "tcp"
+		}
+		switch protocol {
+		case "tcp", "udp":
+		case "http":
+			return nil, fmt.Errorf("port %d: http traffic is served on the service's main port; use tcp for raw TCP or gRPC", p.Port)
+		default:
+			return nil, fmt.Errorf("port %d: protocol must be tcp or udp", p.Port)
+		}
+		if p.Port < 1 || p.Port > 65535 {
+			return nil, fmt.Errorf("port %d is out of range", p.Port)
+		}
+		key := protocol + "/" + strconv.Itoa(int(p.Port))
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate port %s", key)
+		}
+		seen[key] = true
+		out = append(out, PortInput{Port: p.Port, Protocol: protocol})
+	}
+	return out, nil
+}
+
+// reconcileServicePorts keeps existing public port allocations for ports that
+// are still requested and allocates new ones through q, which should be the
+// transaction that saves the service's ports. It returns the JSON stored in
+// services.ports (nil when no ports are exposed) and the allocations no
+// longer requested, for releaseServicePorts once the service is saved.
+func (s *Service) reconcileServicePorts(ctx context.Context, q services.Querier, svc services.Service, requested []PortInput) ([]byte, []services.PortAllocation, error) {
+	requested, err := validatePorts(requested)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing, err := q.ListPortAllocationsByServiceID(ctx, svc.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list port allocations: %w", err)
+	}
+	allocated := make(map[string]services.PortAllocation, len(existing))
+	for _, a := range existing {
+		allocated[a.Protocol+"/"+strconv.Itoa(int(a.TargetPort))] = a
+	}
+
+	var ports []k8sdeployments.ServicePort
+	for _, p := range requested {
+		key := p.Protocol + "/" + strconv.Itoa(int(p.Port))
+		alloc, ok := allocated[key]
+		if ok {
+			delete(allocated, key)
+		} else {
+			alloc, err = allocatePublicPort(ctx, q, svc, p)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		ports = append(ports, k8sdeployments.ServicePort{
+			Port:       p.Port,
+			Protocol:   p.Protocol,
+			PublicPort: alloc.PublicPort,
+		})
+	}
+
+	stale := make([]services.PortAllocation, 0, len(allocated))
+	for _, a := range allocated {
+		stale = append(stale, a)
+	}
+	if len(ports) == 0 {
+		return nil, stale, nil
+	}
+	portsJSON, _ := json.Marshal(ports)
+	return portsJSON, stale, nil
+}
+
+// allocatePublicPort takes the lowest free public port in the service's
+// region. A concurrent allocation of the same port makes the insert a
+// no-op, so it looks again a few times before calling the pool full.
+func allocatePublicPort(ctx context.Context, q services.Querier, svc services.Service, p PortInput) (services.PortAllocation, error) {
+	for attempt := 0; ; attempt++ {
+		alloc, err := q.AllocateServicePort(ctx, services.AllocateServicePortParams{
+			Region:     svc.Region,
+			Protocol:   p.Protocol,
+			ServiceID:  svc.ID,
+			TargetPort: p.Port,
+			RangeStart: publicPortRangeStart,
+			RangeEnd:   publicPortRangeEnd,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			if attempt < maxPortAllocationAttempts {
+				continue
+			}
+			return services.PortAllocation{}, fmt.Errorf("no free public ports left in region %s", svc.Region)
+		}
+		if err != nil {
+			return services.PortAllocation{}, fmt.Errorf("failed to allocate public port: %w", err)
+		}
+		return alloc, nil
+	}
+}
+
+// releaseServicePorts frees allocations reconcileServicePorts found stale.
+func releaseServicePorts(ctx context.Context, q services.Querier, serviceID string, stale []services.PortAllocation) error {
+	for _, a := range stale {
+		if err := q.ReleaseServicePort(ctx, services.ReleaseServicePortParams{
+			ServiceID:  serviceID,
+			Protocol:   a.Protocol,
+			TargetPort: a.TargetPort,
+		}); err != nil {
+			return fmt.Errorf("failed to release public port: %w", err)
+		}
+	}
+	return nil
+}
+
+// PublicPorts reports the host:port clients use to reach the service's
+// TCP/UDP ports.
+func (s *Service) PublicPorts(svc *services.Service) []PublicPort {
+	ports := k8sdeployments.ParseServicePorts(svc.Ports)
+	if len(ports) == 0 {
+		return nil
+	}
+	host := ""
+	if cluster, ok := s.clusters[svc.Region]; ok {
+		host = cluster.IngressIp
+	}
+	out := make([]PublicPort, len(ports))
+	for i, p := range ports {
+		out[i] = PublicPort{
+			Port:     p.Port,
+			Protocol: p.Protocol,
+			Address:  fmt.Sprintf("%s:%d", host, p.PublicPort),
+		}
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+package deployments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestValidatePorts(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []PortInput
+		want    []PortInput
+		wantErr bool
+	}{
+		{name: "empty", in: nil, want: []PortInput{}},
+		{name: "defaults to tcp", in: []PortInput{{Port: 1883}}, want: []PortInput{{Port: 1883, Protocol: "tcp"}}},
+		{name: "normalizes case", in: []PortInput{{Port: 27015, Protocol: "UDP"}}, want: []PortInput{{Port: 27015, Protocol: "udp"}}},
+		{name: "same port on both protocols", in: []PortInput{{Port: 53, Protocol: "tcp"}, {Port: 53, Protocol: "udp"}}, want: []PortInput{{Port: 53, Protocol: "tcp"}, {Port: 53, Protocol: "udp"}}},
+		{name: "rejects http", in: []PortInput{{Port: 8080, Protocol: "http"}}, wantErr: true},
+		{name: "rejects unknown protocol", in: []PortInput{{Port: 5000, Protocol: "sctp"}}, wantErr: true},
+		{name: "rejects out of range", in: []PortInput{{Port: 70000}}, wantErr: true},
+		{name: "rejects duplicates", in: []PortInput{{Port: 1883}, {Port: 1883, Protocol: "tcp"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validatePorts(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("validatePorts(%v) expected error, got %v", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validatePorts(%v) unexpected error: %v", tt.in, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("validatePorts(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("validatePorts(%v)[%d] = %v, want %v", tt.in, i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// portsQ fails the first conflicts allocations as if another service had
+// taken the candidate port concurrently.
+type portsQ struct {
+	services.Querier
+	existing  []services.PortAllocation
+	conflicts int
+	next      int32
+}
+
+func (q *portsQ) ListPortAllocationsByServiceID(context.Context, string) ([]services.PortAllocation, error) {
+	return q.existing, nil
+}
+
+func (q *portsQ) AllocateServicePort(_ context.Context, arg services.AllocateServicePortParams) (services.PortAllocation, error) {
+	if q.conflicts > 0 {
+		q.conflicts--
+		return services.PortAllocation{}, pgx.ErrNoRows
+	}
+	q.next++
+	return services.PortAllocation{Protocol: arg.Protocol, TargetPort: arg.TargetPort, PublicPort: publicPortRangeStart + q.next}, nil
+}
+
+func TestReconcileServicePorts(t *testing.T) {
+	ctx := context.Background()
+	svc := services.Service{ID: "svc", Region: "eu"}
+	s := &Service{}
+
+	q := &portsQ{
+		existing:  []services.PortAllocation{{Protocol: "tcp", TargetPort: 1883, PublicPort: 30500}, {Protocol: "udp", TargetPort: 53, PublicPort: 30501}},
+		conflicts: 2,
+	}
+	portsJSON, stale, err := s.reconcileServicePorts(ctx, q, svc, []PortInput{{Port: 1883}, {Port: 5432}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `[{"port":1883,"protocol":"tcp","public_port":30500},{"port":5432,"protocol":"tcp","public_port":30001}]`; string(portsJSON) != want {
+		t.Errorf("ports = %s, want %s", portsJSON, want)
+	}
+	if len(stale) != 1 || stale[0].TargetPort != 53 {
+		t.Errorf("stale = %+v, want only udp/53", stale)
+	}
+
+	q = &portsQ{conflicts: maxPortAllocationAttempts + 1}
+	if _, _, err := s.reconcileServicePorts(ctx, q, svc, []PortInput{{Port: 1883}}); err == nil {
+		t.Error("allocation succeeded with every candidate taken")
+	}
+}
@@ -0,0 +1,43 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// normalizeTagPattern validates a release tag_pattern such as "v*" or
+// "release/*". An empty pattern returns nil: the service tracks its branch.
+func normalizeTagPattern(pattern, buildPack string) (*string, error) {
+	pattern = strings.TrimPrefix(strings.TrimSpace(pattern), "refs/tags/")
+	if pattern == "" {
+		return nil, nil
+	}
+	if buildPack == k8sdeployments.BuildPackImage {
+		return nil, fmt.Errorf("tag_pattern does not apply to build_pack=image")
+	}
+	if !doublestar.ValidatePattern(pattern) {
+		return nil, fmt.Errorf("invalid tag_pattern %q", pattern)
+	}
+	return &pattern, nil
+}
+
+// MatchesTagPattern reports whether a pushed tag (without refs/tags/)
+// releases a service with the given tag_pattern.
+func MatchesTagPattern(pattern, tag string) bool {
+	ok, _ := doublestar.Match(pattern, tag)
+	return ok
+}
+
+// RedeployFromTag deploys a release service at a pushed tag. commitSHA is
+// the commit the tag points to, when the caller knows it.
+func (s *Service) RedeployFromTag(ctx context.Context, svcID, tag, commitSHA string) (string, error) {
+	commitSHA = strings.TrimSpace(commitSHA)
+	if !isCommitSHA(commitSHA) || commitSHA == "0000000000000000000000000000000000000000" {
+		commitSHA = ""
+	}
+	return s.redeployWithTrigger(ctx, svcID, "git_tag", commitSHA, tag)
+}
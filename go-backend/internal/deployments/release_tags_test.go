@@ -0,0 +1,68 @@
+package deployments
+
+import (
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+)
+
+func TestNormalizeTagPattern(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		buildPack string
+		want      string // "" means nil
+		wantErr   bool
+	}{
+		{pattern: "", want: ""},
+		{pattern: "  ", want: ""},
+		{pattern: " v* ", want: "v*"},
+		{pattern: "refs/tags/release/*", want: "release/*"},
+		{pattern: "v[0-9]*", want: "v[0-9]*"},
+		{pattern: "v[", wantErr: true},
+		{pattern: "v*", buildPack: k8sdeployments.BuildPackImage, wantErr: true},
+		{pattern: "", buildPack: k8sdeployments.BuildPackImage, want: ""},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeTagPattern(tt.pattern, tt.buildPack)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("normalizeTagPattern(%q, %q) expected error", tt.pattern, tt.buildPack)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("normalizeTagPattern(%q, %q) unexpected error: %v", tt.pattern, tt.buildPack, err)
+			continue
+		}
+		if tt.want == "" {
+			if got != nil {
+				t.Errorf("normalizeTagPattern(%q) = %q, want nil", tt.pattern, *got)
+			}
+			continue
+		}
+		if got == nil || *got != tt.want {
+			t.Errorf("normalizeTagPattern(%q) = %v, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestMatchesTagPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		tag     string
+		want    bool
+	}{
+		{pattern: "v*", tag: "v1.2.0", want: true},
+		{pattern: "v*", tag: "release-1", want: false},
+		{pattern: "v*", tag: "v1/hotfix", want: false},
+		{pattern: "release/*", tag: "release/2024-10", want: true},
+		{pattern: "**", tag: "any/nested/tag", want: true},
+		{pattern: "v{1,2}.*", tag: "v2.0", want: true},
+	}
+	for _, tt := range tests {
+		if got := MatchesTagPattern(tt.pattern, tt.tag); got != tt.want {
+			t.Errorf("MatchesTagPattern(%q, %q) = %v, want %v", tt.pattern, tt.tag, got, tt.want)
+		}
+	}
+}
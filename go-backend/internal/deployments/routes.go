@@ -0,0 +1,379 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/projectroutes"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/lithammer/shortuuid/v4"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+)
+
+var (
+	dnsLabelRe   = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	pathPrefixRe = regexp.MustCompile(`^(/[A-Za-z0-9._~-]+)+$`)
+)
+
+// reservedLabels are the subdomains of the apps domain the platform serves
+// itself: the API and MCP endpoints, git over HTTPS and SSH, the DNS
+// nameservers and the observability stack. Neither routes nor service
+// default domains may take them.
+var reservedLabels = []string{
+	"api", "cname", "git", "grafana", "loki", "mcp", "ns1", "ns2",
+	"prometheus", "ssh", "www",
+}
+
+// isReservedLabel also covers the per-region status pages
+// (status-eu-central-1).
+func isReservedLabel(label string) bool {
+	return slices.Contains(reservedLabels, label) || strings.HasPrefix(label, "status-")
+}
+
+// reservedLabelsError explains which labels are taken so callers don't have
+// to guess at alternatives.
+func reservedLabelsError(what string) error {
+	return fmt.Errorf("%s is reserved by the platform (reserved: %s, status-*)", what, strings.Join(reservedLabels, ", "))
+}
+
+type AddRouteInput struct {
+	UserID      string
+	Project     string
+	Host        string
+	PathPrefix  string
+	ServiceName string
+	StripPrefix bool
+}
+
+type RouteInfo struct {
+	ID          string
+	Host        string
+	PathPrefix  string
+	ServiceName string
+	StripPrefix bool
+	URL         string
+}
+
+type RemoveRouteInput struct {
+	UserID     string
+	Project    string
+	Host       string
+	PathPrefix string
+}
+
+// normalizeRoutePath cleans a user-supplied prefix into "/" or "/a/b" form.
+func normalizeRoutePath(p string) (string, error) {
+	p = strings.TrimSpace(p)
+	if p == "" || p == "/" {
+		return "/", nil
+	}
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	p = path.Clean(p)
+	if p == "/" {
+		return p, nil
+	}
+	if !pathPrefixRe.MatchString(p) {
+		return "", fmt.Errorf("invalid path prefix %q: use letters, digits, '.', '_', '~' and '-' separated by '/'", p)
+	}
+	return p, nil
+}
+
+func normalizeRouteHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
+
+func (s *Service) AddRoute(ctx context.Context, input AddRouteInput) (*RouteInfo, error) {
+	host := normalizeRouteHost(input.Host)
+	if host == "" {
+		return nil, fmt.Errorf("host is required")
+	}
+	pathPrefix, err := normalizeRoutePath(input.PathPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := s.GetServiceByName(ctx, GetServiceByNameParams{
+		Name:    input.ServiceName,
+		Project: input.Project,
+		UserID:  input.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRouteHost(ctx, svc, host); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.routesQ.GetRouteByHostAndPath(ctx, projectroutes.GetRouteByHostAndPathParams{
+		Host:       host,
+		PathPrefix: pathPrefix,
+	}); err == nil {
+		return nil, fmt.Errorf("route %s%s already exists; remove it first", host, pathPrefix)
+	}
+
+	route, err := s.routesQ.CreateRoute(ctx, projectroutes.CreateRouteParams{
+		ID:          shortuuid.New(),
+		ProjectID:   svc.ProjectID,
+		ServiceID:   svc.ID,
+		Host:        host,
+		PathPrefix:  pathPrefix,
+		StripPrefix: input.StripPrefix && pathPrefix != "/",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create route: %w", err)
+	}
+
+	if err := s.syncProjectRoutes(ctx, svc.ProjectID); err != nil {
+		return nil, fmt.Errorf("route saved but sync failed: %w", err)
+	}
+
+	return routeInfo(route, input.ServiceName), nil
+}
+
+func (s *Service) ListRoutes(ctx context.Context, userID, projectRef string) ([]RouteInfo, error) {
+	proj, err := s.lookupProject(ctx, userID, projectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := s.routesQ.ListRoutesByProjectID(ctx, proj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routes: %w", err)
+	}
+
+	names, err := s.projectServiceNames(ctx, proj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]RouteInfo, 0, len(routes))
+	for _, r := range routes {
+		result = append(result, *routeInfo(r, names[r.ServiceID]))
+	}
+	return result, nil
+}
+
+func (s *Service) RemoveRoute(ctx context.Context, input RemoveRouteInput) error {
+	proj, err := s.lookupProject(ctx, input.UserID, input.Project)
+	if err != nil {
+		return err
+	}
+	pathPrefix, err := normalizeRoutePath(input.PathPrefix)
+	if err != nil {
+		return err
+	}
+	host := normalizeRouteHost(input.Host)
+
+	route, err := s.routesQ.GetRouteByHostAndPath(ctx, projectroutes.GetRouteByHostAndPathParams{
+		Host:       host,
+		PathPrefix: pathPrefix,
+	})
+	if err != nil || route.ProjectID != proj.ID {
+		return fmt.Errorf("route not found: %s%s", host, pathPrefix)
+	}
+
+	if err := s.routesQ.DeleteRoute(ctx, route.ID); err != nil {
+		return fmt.Errorf("failed to delete route: %w", err)
+	}
+
+	return s.syncProjectRoutes(ctx, proj.ID)
+}
+
+// validateRouteHost accepts either the default domain (a label under the
+// cluster AppsDomain) of a service in the same project or a custom domain attached to a service in the same
+// project. A host already used by another project is rejected.
+func (s *Service) validateRouteHost(ctx context.Context, svc *services.Service, host string) error {
+	existing, err := s.routesQ.ListRoutesByHost(ctx, host)
+	if err != nil {
+		return fmt.Errorf("failed to check host: %w", err)
+	}
+	for _, r := range existing {
+		if r.ProjectID != svc.ProjectID {
+			return fmt.Errorf("host %s is already routed by another project", host)
+		}
+	}
+
+	cluster, ok := s.clusters[svc.Region]
+	if !ok {
+		return fmt.Errorf("unknown region %q for service %s", svc.Region, svc.ID)
+	}
+
+	if label, ok := strings.CutSuffix(host, "."+cluster.AppsDomain); ok {
+		if !dnsLabelRe.MatchString(label) {
+			return fmt.Errorf("host must be a single label under %s (e.g. web.%s)", cluster.AppsDomain, cluster.AppsDomain)
+		}
+		if isReservedLabel(label) {
+			return reservedLabelsError("host " + host)
+		}
+		// Every label is some service's default domain, so only the
+		// labels of this project's own services can be routed; any other
+		// one could be claimed by a service created later.
+		owners, err := s.servicesQ.ListServicesByName(ctx, &label)
+		if err != nil {
+			return fmt.Errorf("failed to check host: %w", err)
+		}
+		inProject := false
+		for _, o := range owners {
+			if o.ProjectID != svc.ProjectID {
+				return fmt.Errorf("host %s belongs to another service", host)
+			}
+			inProject = true
+		}
+		if !inProject {
+			return fmt.Errorf("host %s must be the default domain of a service in this project", host)
+		}
+		return nil
+	}
+
+	projectServices, err := s.servicesQ.ListServicesByProjectIDs(ctx, []string{svc.ProjectID})
+	if err != nil {
+		return fmt.Errorf("failed to list project services: %w", err)
+	}
+	ids := make([]string, len(projectServices))
+	for i, ps := range projectServices {
+		ids[i] = ps.ID
+	}
+	domains, err := s.dnsQ.ListCustomDomainsByServiceIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to list custom domains: %w", err)
+	}
+	for _, d := range domains {
+		fqdn := d.Zone
+		if d.Name != "@" {
+			fqdn = d.Name + "." + d.Zone
+		}
+		if strings.EqualFold(fqdn, host) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("host %s must be under %s or a custom domain attached to a service in this project", host, cluster.AppsDomain)
+}
+
+// syncProjectRoutes renders the project's full route set on every cluster
+// that hosts one of its services.
+func (s *Service) syncProjectRoutes(ctx context.Context, projectID string) error {
+	proj, err := s.projectsQ.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("project not found: %w", err)
+	}
+
+	projectServices, err := s.servicesQ.ListServicesByProjectIDs(ctx, []string{projectID})
+	if err != nil {
+		return fmt.Errorf("failed to list project services: %w", err)
+	}
+	byID := make(map[string]services.Service, len(projectServices))
+	regions := make(map[string]bool)
+	for _, svc := range projectServices {
+		byID[svc.ID] = svc
+		regions[svc.Region] = true
+	}
+
+	routes, err := s.routesQ.ListRoutesByProjectID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list routes: %w", err)
+	}
+
+	specs := make(map[string][]k8sdeployments.ProjectRouteSpec)
+	for _, r := range routes {
+		svc, ok := byID[r.ServiceID]
+		if !ok || svc.Name == nil {
+			continue
+		}
+		name := k8sdeployments.ServiceName(*svc.Name)
+		policy, err := k8sdeployments.ParseAccessPolicy(svc.AccessPolicy)
+		if err != nil {
+			return fmt.Errorf("service %s: %w", *svc.Name, err)
+		}
+		specs[svc.Region] = append(specs[svc.Region], k8sdeployments.ProjectRouteSpec{
+			RouteID:     r.ID,
+			Host:        r.Host,
+			PathPrefix:  r.PathPrefix,
+			ServiceName: name,
+			ServicePort: k8sdeployments.EffectivePort(svc.BuildPack, svc.Port, svc.BuildConfig),
+			StripPrefix: r.StripPrefix,
+			Middlewares: append(
+				k8sdeployments.AccessMiddlewares(name, policy),
+				k8sdeployments.WakeMiddlewares(name, svc.SleepAfterIdle)...,
+			),
+		})
+	}
+
+	namespace := k8sdeployments.NamespaceName(proj.UserID, proj.Ref)
+	for region := range regions {
+		cluster, ok := s.clusters[region]
+		if !ok {
+			s.logger.Warn("skipping route sync for unknown region", "projectID", projectID, "region", region)
+			continue
+		}
+
+		workflowID := fmt.Sprintf("sync-routes-%s-%s", projectID, region)
+		_, err := s.temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+			ID:                       workflowID,
+			TaskQueue:                cluster.TaskQueue,
+			WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+		}, k8sdeployments.SyncProjectRoutesWorkflow, k8sdeployments.SyncProjectRoutesInput{
+			ProjectID: projectID,
+			Namespace: namespace,
+			Routes:    specs[region],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to start route sync workflow: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) lookupProject(ctx context.Context, userID, ref string) (*projects.Project, error) {
+	if ref == "" {
+		ref = "default"
+	}
+	proj, err := s.projectsQ.GetProjectByRef(ctx, projects.GetProjectByRefParams{
+		UserID: userID,
+		Ref:    ref,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("project not found: %s", ref)
+	}
+	return &proj, nil
+}
+
+func (s *Service) projectServiceNames(ctx context.Context, projectID string) (map[string]string, error) {
+	projectServices, err := s.servicesQ.ListServicesByProjectIDs(ctx, []string{projectID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project services: %w", err)
+	}
+	names := make(map[string]string, len(projectServices))
+	for _, svc := range projectServices {
+		if svc.Name != nil {
+			names[svc.ID] = *svc.Name
+		}
+	}
+	return names, nil
+}
+
+func routeInfo(r projectroutes.ProjectRoute, serviceName string) *RouteInfo {
+	url := "https://" + r.Host
+	if r.PathPrefix != "/" {
+		url += r.PathPrefix
+	}
+	return &RouteInfo{
+		ID:          r.ID,
+		Host:        r.Host,
+		PathPrefix:  r.PathPrefix,
+		ServiceName: serviceName,
+		StripPrefix: r.StripPrefix,
+		URL:         url,
+	}
+}
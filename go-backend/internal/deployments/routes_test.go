@@ -0,0 +1,52 @@
+package deployments
+
+import "testing"
+
+func TestNormalizeRoutePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty is root", in: "", want: "/"},
+		{name: "root", in: "/", want: "/"},
+		{name: "adds leading slash", in: "api", want: "/api"},
+		{name: "trims trailing slash", in: "/api/", want: "/api"},
+		{name: "nested", in: "/api/v1", want: "/api/v1"},
+		{name: "collapses dot segments", in: "/api/../admin", want: "/admin"},
+		{name: "rejects spaces", in: "/my api", wantErr: true},
+		{name: "rejects query", in: "/api?x=1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeRoutePath(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeRoutePath(%q) expected error, got %q", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeRoutePath(%q) unexpected error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("normalizeRoutePath(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsReservedLabel(t *testing.T) {
+	for _, label := range []string{"git", "api", "mcp", "www", "status-eu-central-1"} {
+		if !isReservedLabel(label) {
+			t.Errorf("isReservedLabel(%q) = false, want true", label)
+		}
+	}
+	for _, label := range []string{"web", "app", "gitlab", "my-api", "statuspage"} {
+		if isReservedLabel(label) {
+			t.Errorf("isReservedLabel(%q) = true, want false", label)
+		}
+	}
+}
@@ -3,51 +3,69 @@ package deployments
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
 
+	"github.com/augustdev/autoclip/internal/auth"
 	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/augustdev/autoclip/internal/storage/pg"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/clusters"
 	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/dnsdb"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/githubcreds"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/projectroutes"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/users"
+	"github.com/jackc/pgx/v5"
 	"github.com/lithammer/shortuuid/v4"
-	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 )
 
 type Service struct {
+	db             *pg.DB
 	temporalClient client.Client
 	servicesQ      services.Querier
 	deploymentsQ   deploymentsdb.Querier
 	projectsQ      projects.Querier
 	usersQ         users.Querier
 	ghCredsQ       githubcreds.Querier
+	routesQ        projectroutes.Querier
+	dnsQ           dnsdb.Querier
 	clusters       map[string]clusters.Cluster
+	authConfig     auth.Config
 	logger         *slog.Logger
 }
 
 func NewService(
+	db *pg.DB,
 	temporalClient client.Client,
 	servicesQ services.Querier,
 	deploymentsQ deploymentsdb.Querier,
 	projectsQ projects.Querier,
 	usersQ users.Querier,
 	ghCredsQ githubcreds.Querier,
+	routesQ projectroutes.Querier,
+	dnsQ dnsdb.Querier,
 	clusters map[string]clusters.Cluster,
+	authConfig auth.Config,
 	logger *slog.Logger,
 ) *Service {
 	return &Service{
+		db:             db,
 		temporalClient: temporalClient,
 		servicesQ:      servicesQ,
 		deploymentsQ:   deploymentsQ,
 		projectsQ:      projectsQ,
 		usersQ:         usersQ,
 		ghCredsQ:       ghCredsQ,
+		routesQ:        routesQ,
+		dnsQ:           dnsQ,
 		clusters:       clusters,
+		authConfig:     authConfig,
 		logger:         logger,
 	}
 }
@@ -72,6 +90,19 @@ type CreateServiceInput struct {
 	RootDirectory    string
 	DockerfilePath   string
 	Region           string
+	Ports            []PortInput
+	SleepAfterIdle   string
+	Image            string // build_pack "image" only
+	RegistryUsername string
+	RegistryPassword string
+	DeployPolicy     string
+	DeployDebounce   string
+	WatchPaths       []string
+	TagPattern       string // deploy only tags matching this glob instead of branch pushes
+	DockerfileTarget string
+	BuildArgs        []EnvVar // --build-arg only, not set on the running service
+	BuildContext     string   // relative to RootDirectory
+	StaticSite       k8sdeployments.StaticSiteConfig
 }
 
 type CreateServiceResult struct {
@@ -106,13 +137,43 @@ func (s *Service) CreateService(ctx context.Context, input CreateServiceInput) (
 
 	envVarsJSON, _ := json.Marshal(input.EnvVars)
 
-	buildConfigJSON, _ := json.Marshal(k8sdeployments.BuildConfig{
+	watchPaths, err := normalizeWatchPaths(input.WatchPaths)
+	if err != nil {
+		return nil, err
+	}
+	dockerfileTarget, err := normalizeDockerfileTarget(input.DockerfileTarget)
+	if err != nil {
+		return nil, err
+	}
+	buildArgs, err := normalizeBuildArgs(input.BuildArgs)
+	if err != nil {
+		return nil, err
+	}
+	buildContext, err := normalizeBuildContext(input.RootDirectory, input.BuildContext)
+	if err != nil {
+		return nil, err
+	}
+	staticSite, err := normalizeStaticSite(input.StaticSite)
+	if err != nil {
+		return nil, err
+	}
+
+	buildConfig := k8sdeployments.BuildConfig{
 		RootDirectory:    input.RootDirectory,
 		DockerfilePath:   input.DockerfilePath,
 		PublishDirectory: input.PublishDirectory,
 		BuildCommand:     input.BuildCommand,
 		StartCommand:     input.StartCommand,
-	})
+		WatchPaths:       watchPaths,
+		DockerfileTarget: dockerfileTarget,
+		BuildArgs:        buildArgs,
+		BuildContext:     buildContext,
+		StaticSiteConfig: staticSite,
+	}
+	if err := checkDockerfileOptions(input.BuildPack, buildConfig); err != nil {
+		return nil, err
+	}
+	buildConfigJSON, _ := json.Marshal(buildConfig)
 
 	memory := input.Memory
 	if memory == "" {
@@ -138,8 +199,45 @@ func (s *Service) CreateService(ctx context.Context, input CreateServiceInput) (
 	if input.Name == "" {
 		return nil, fmt.Errorf("service name is required")
 	}
+	if isReservedLabel(input.Name) {
+		return nil, reservedLabelsError(fmt.Sprintf("service name %q", input.Name))
+	}
+
+	if _, err := validatePorts(input.Ports); err != nil {
+		return nil, err
+	}
 
-	_, err := s.servicesQ.GetServiceByNameAndProject(ctx, services.GetServiceByNameAndProjectParams{
+	sleepAfterIdle, err := parseSleepAfterIdle(input.SleepAfterIdle)
+	if err != nil {
+		return nil, err
+	}
+
+	deployPolicy, deployDebounce, err := parseDeployPolicy(input.DeployPolicy, input.DeployDebounce)
+	if err != nil {
+		return nil, err
+	}
+
+	tagPattern, err := normalizeTagPattern(input.TagPattern, input.BuildPack)
+	if err != nil {
+		return nil, err
+	}
+
+	var image, registryCreds *string
+	if input.BuildPack == k8sdeployments.BuildPackImage {
+		creds, err := registryCredentialsInput(input.RegistryUsername, input.RegistryPassword)
+		if err != nil {
+			return nil, err
+		}
+		image, registryCreds, err = s.prepareImage(ctx, cluster.TaskQueue, input.Image, creds)
+		if err != nil {
+			return nil, err
+		}
+		input.Repo = ""
+		input.Branch = ""
+		gitProvider = k8sdeployments.BuildPackImage
+	}
+
+	_, err = s.servicesQ.GetServiceByNameAndProject(ctx, services.GetServiceByNameAndProjectParams{
 		Name:      &input.Name,
 		ProjectID: projectID,
 	})
@@ -149,27 +247,88 @@ func (s *Service) CreateService(ctx context.Context, input CreateServiceInput) (
 
 	svcID := shortuuid.New()
 	deploymentID := shortuuid.New()
-	workflowID := fmt.Sprintf("deploy-%s", deploymentID)
-
-	_, err = s.servicesQ.CreateService(ctx, services.CreateServiceParams{
-		ID:          svcID,
-		UserID:      input.UserID,
-		ProjectID:   projectID,
-		Repo:        input.Repo,
-		Branch:      input.Branch,
-		ServerUuid:  "k8s",
-		Name:        &input.Name,
-		BuildPack:   input.BuildPack,
-		Port:        input.Port,
-		EnvVars:     envVarsJSON,
-		GitProvider: gitProvider,
-		BuildConfig: buildConfigJSON,
-		Memory:      memory,
-		Vcpus:       vcpus,
-		Region:      cluster.Region,
+	workflowID := k8sdeployments.DeployWorkflowID(deploymentID)
+
+	// The service row and the rest of its config are saved together so a
+	// failed write doesn't leave a half-configured service behind that
+	// blocks retrying the name.
+	err = pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		q := services.New(tx)
+		svc, err := q.CreateService(ctx, services.CreateServiceParams{
+			ID:          svcID,
+			UserID:      input.UserID,
+			ProjectID:   projectID,
+			Repo:        input.Repo,
+			Branch:      input.Branch,
+			ServerUuid:  "k8s",
+			Name:        &input.Name,
+			BuildPack:   input.BuildPack,
+			Port:        input.Port,
+			EnvVars:     envVarsJSON,
+			GitProvider: gitProvider,
+			BuildConfig: buildConfigJSON,
+			Memory:      memory,
+			Vcpus:       vcpus,
+			Region:      cluster.Region,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create service record: %w", err)
+		}
+
+		if len(input.Ports) > 0 {
+			portsJSON, _, err := s.reconcileServicePorts(ctx, q, svc, input.Ports)
+			if err != nil {
+				return err
+			}
+			if err := q.SetServicePorts(ctx, services.SetServicePortsParams{
+				ID:    svcID,
+				Ports: portsJSON,
+			}); err != nil {
+				return fmt.Errorf("failed to save service ports: %w", err)
+			}
+		}
+
+		if sleepAfterIdle != nil {
+			if err := q.SetServiceSleepAfterIdle(ctx, services.SetServiceSleepAfterIdleParams{
+				ID:             svcID,
+				SleepAfterIdle: sleepAfterIdle,
+			}); err != nil {
+				return fmt.Errorf("failed to save sleep_after_idle: %w", err)
+			}
+		}
+
+		if image != nil {
+			if err := q.SetServiceImage(ctx, services.SetServiceImageParams{
+				ID:                  svcID,
+				Image:               image,
+				RegistryCredentials: registryCreds,
+			}); err != nil {
+				return fmt.Errorf("failed to save image: %w", err)
+			}
+		}
+
+		if deployPolicy != k8sdeployments.DeployPolicyCancelPrevious {
+			if err := q.SetServiceDeployPolicy(ctx, services.SetServiceDeployPolicyParams{
+				ID:             svcID,
+				DeployPolicy:   deployPolicy,
+				DeployDebounce: deployDebounce,
+			}); err != nil {
+				return fmt.Errorf("failed to save deploy_policy: %w", err)
+			}
+		}
+
+		if tagPattern != nil {
+			if err := q.SetServiceTagPattern(ctx, services.SetServiceTagPatternParams{
+				ID:         svcID,
+				TagPattern: tagPattern,
+			}); err != nil {
+				return fmt.Errorf("failed to save tag_pattern: %w", err)
+			}
+		}
+		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create service record: %w", err)
+		return nil, err
 	}
 
 	_, err = s.deploymentsQ.CreateDeployment(ctx, deploymentsdb.CreateDeploymentParams{
@@ -196,40 +355,16 @@ func (s *Service) CreateService(ctx context.Context, input CreateServiceInput) (
 		GitProvider:    gitProvider,
 		InstallationID: input.InstallationID,
 		AppsDomain:     cluster.AppsDomain,
+		BuildPack:      input.BuildPack,
 	}
 
-	workflowOptions := client.StartWorkflowOptions{
-		ID:                                       workflowID,
-		TaskQueue:                                cluster.TaskQueue,
-		WorkflowIDConflictPolicy:                 enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
-		WorkflowIDReusePolicy:                    enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
-		WorkflowExecutionErrorWhenAlreadyStarted: true,
-	}
-
-	run, err := s.temporalClient.ExecuteWorkflow(ctx, workflowOptions, k8sdeployments.CreateServiceWorkflow, workflowInput)
-	if err != nil {
+	if _, err := s.enqueueDeploy(ctx, cluster.TaskQueue, deployPolicy, deployDebounce, true, workflowInput); err != nil {
 		s.logger.Error("failed to start deploy workflow",
 			"workflowID", workflowID,
 			"error", err)
 		return nil, fmt.Errorf("failed to start deploy workflow: %w", err)
 	}
 
-	runID := run.GetRunID()
-	if err := s.deploymentsQ.UpdateDeploymentWorkflowRunID(ctx, deploymentsdb.UpdateDeploymentWorkflowRunIDParams{
-		ID:            deploymentID,
-		WorkflowRunID: &runID,
-	}); err != nil {
-		s.logger.Warn("failed to persist workflow run id",
-			"deploymentID", deploymentID,
-			"workflowID", workflowID,
-			"runID", runID,
-			"error", err)
-	}
-
-	s.logger.Info("started deploy workflow",
-		"workflowID", workflowID,
-		"runID", run.GetRunID())
-
 	return &CreateServiceResult{
 		ServiceID:    svcID,
 		DeploymentID: deploymentID,
@@ -257,6 +392,23 @@ type UpdateServiceInput struct {
 	PublishDirectory *string
 	RootDirectory    *string
 	DockerfilePath   *string
+	Access           *AccessPolicyInput
+	Ports            *[]PortInput
+	SleepAfterIdle   *string
+	Image            *string // build_pack "image" only
+	RegistryUsername *string
+	RegistryPassword *string
+	DeployPolicy     *string
+	DeployDebounce   *string
+	WatchPaths       *[]string // empty list restores the root_directory default
+	TagPattern       *string   // empty string goes back to tracking the branch
+	DockerfileTarget *string
+	BuildArgs        *[]EnvVar // replaces all build args; empty list clears them
+	BuildContext     *string
+	SPAFallback      *bool
+	NotFoundPage     *string
+	Redirects        *[]k8sdeployments.StaticRedirect // replaces all redirects
+	Headers          *[]k8sdeployments.StaticHeader   // replaces all headers
 }
 
 type UpdateServiceResult struct {
@@ -326,6 +478,51 @@ func (s *Service) UpdateService(ctx context.Context, input UpdateServiceInput) (
 	if input.DockerfilePath != nil {
 		currentBC.DockerfilePath = *input.DockerfilePath
 	}
+	if input.WatchPaths != nil {
+		currentBC.WatchPaths, err = normalizeWatchPaths(*input.WatchPaths)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.DockerfileTarget != nil {
+		currentBC.DockerfileTarget, err = normalizeDockerfileTarget(*input.DockerfileTarget)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.BuildArgs != nil {
+		currentBC.BuildArgs, err = normalizeBuildArgs(*input.BuildArgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.BuildContext != nil {
+		currentBC.BuildContext = *input.BuildContext
+	}
+	// Re-check even when only root_directory moved: the context must stay in the repo
+	currentBC.BuildContext, err = normalizeBuildContext(currentBC.RootDirectory, currentBC.BuildContext)
+	if err != nil {
+		return nil, err
+	}
+	if input.SPAFallback != nil {
+		currentBC.SPAFallback = input.SPAFallback
+	}
+	if input.NotFoundPage != nil {
+		currentBC.NotFoundPage = *input.NotFoundPage
+	}
+	if input.Redirects != nil {
+		currentBC.Redirects = *input.Redirects
+	}
+	if input.Headers != nil {
+		currentBC.Headers = *input.Headers
+	}
+	currentBC.StaticSiteConfig, err = normalizeStaticSite(currentBC.StaticSiteConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDockerfileOptions(buildPack, currentBC); err != nil {
+		return nil, err
+	}
 	buildConfigJSON, _ := json.Marshal(currentBC)
 
 	// Merge env vars
@@ -334,20 +531,176 @@ func (s *Service) UpdateService(ctx context.Context, input UpdateServiceInput) (
 		envVarsJSON, _ = json.Marshal(*input.EnvVars)
 	}
 
-	_, err = s.servicesQ.UpdateServiceConfig(ctx, services.UpdateServiceConfigParams{
-		ID:          svc.ID,
-		Repo:        repo,
-		Branch:      branch,
-		GitProvider: gitProvider,
-		Port:        port,
-		EnvVars:     envVarsJSON,
-		BuildPack:   buildPack,
-		BuildConfig: buildConfigJSON,
-		Memory:      memory,
-		Vcpus:       vcpus,
+	// Replace access policy wholesale; an empty policy makes the service public
+	accessPolicyJSON := svc.AccessPolicy
+	if input.Access != nil {
+		policy, err := buildAccessPolicy(*input.Access)
+		if err != nil {
+			return nil, err
+		}
+		accessPolicyJSON = nil
+		if !policy.IsPublic() {
+			accessPolicyJSON, _ = json.Marshal(policy)
+		}
+	}
+
+	sleepAfterIdle := svc.SleepAfterIdle
+	if input.SleepAfterIdle != nil {
+		sleepAfterIdle, err = parseSleepAfterIdle(*input.SleepAfterIdle)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	deployPolicy, deployDebounce := svc.DeployPolicy, svc.DeployDebounce
+	if input.DeployPolicy != nil || input.DeployDebounce != nil {
+		policy := svc.DeployPolicy
+		if input.DeployPolicy != nil {
+			policy = *input.DeployPolicy
+		}
+		debounce := ""
+		if input.DeployDebounce != nil {
+			debounce = *input.DeployDebounce
+		} else if policy == k8sdeployments.DeployPolicyDebounce && svc.DeployDebounce != nil {
+			debounce = FormatDeployDebounce(policy, svc.DeployDebounce)
+		}
+		deployPolicy, deployDebounce, err = parseDeployPolicy(policy, debounce)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tagPattern := svc.TagPattern
+	if input.TagPattern != nil {
+		tagPattern, err = normalizeTagPattern(*input.TagPattern, buildPack)
+		if err != nil {
+			return nil, err
+		}
+	} else if tagPattern != nil && buildPack == k8sdeployments.BuildPackImage {
+		return nil, fmt.Errorf("tag_pattern does not apply to build_pack=image")
+	}
+
+	imageChanged := input.Image != nil || input.RegistryUsername != nil || input.RegistryPassword != nil
+	if imageChanged && buildPack != k8sdeployments.BuildPackImage {
+		return nil, fmt.Errorf("image and registry credentials only apply to services with build_pack=image")
+	}
+	image, registryCreds := svc.Image, svc.RegistryCredentials
+	if buildPack != k8sdeployments.BuildPackImage {
+		image, registryCreds = nil, nil
+		if gitProvider == k8sdeployments.BuildPackImage {
+			gitProvider = "github"
+		}
+	} else if imageChanged || svc.BuildPack != buildPack {
+		imageRef := ""
+		if svc.Image != nil {
+			imageRef = *svc.Image
+		}
+		if input.Image != nil {
+			imageRef = *input.Image
+		}
+		// Credentials are replaced as a pair; otherwise the stored ones are reused
+		var creds *k8sdeployments.RegistryCredentials
+		if input.RegistryUsername != nil || input.RegistryPassword != nil {
+			var username, password string
+			if input.RegistryUsername != nil {
+				username = *input.RegistryUsername
+			}
+			if input.RegistryPassword != nil {
+				password = *input.RegistryPassword
+			}
+			creds, err = registryCredentialsInput(username, password)
+		} else if svc.RegistryCredentials != nil {
+			creds, err = k8sdeployments.DecryptRegistryCredentials(*svc.RegistryCredentials, s.authConfig.APIKeyEncryptionKey)
+		}
+		if err != nil {
+			return nil, err
+		}
+		cluster, ok := s.clusters[svc.Region]
+		if !ok {
+			return nil, fmt.Errorf("unknown region %q for service %s", svc.Region, svc.ID)
+		}
+		image, registryCreds, err = s.prepareImage(ctx, cluster.TaskQueue, imageRef, creds)
+		if err != nil {
+			return nil, err
+		}
+		repo, branch, gitProvider = "", "", k8sdeployments.BuildPackImage
+	}
+
+	// New ports are allocated and dropped ones released in the same
+	// transaction as the config update, so a failed update keeps the
+	// service's current ports and settings.
+	err = pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		q := services.New(tx)
+		portsJSON := svc.Ports
+		var stale []services.PortAllocation
+		if input.Ports != nil {
+			var err error
+			portsJSON, stale, err = s.reconcileServicePorts(ctx, q, svc, *input.Ports)
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, err := q.UpdateServiceConfig(ctx, services.UpdateServiceConfigParams{
+			ID:             svc.ID,
+			Repo:           repo,
+			Branch:         branch,
+			GitProvider:    gitProvider,
+			Port:           port,
+			EnvVars:        envVarsJSON,
+			BuildPack:      buildPack,
+			BuildConfig:    buildConfigJSON,
+			Memory:         memory,
+			Vcpus:          vcpus,
+			AccessPolicy:   accessPolicyJSON,
+			Ports:          portsJSON,
+			SleepAfterIdle: sleepAfterIdle,
+		}); err != nil {
+			return fmt.Errorf("failed to update service: %w", err)
+		}
+		if err := releaseServicePorts(ctx, q, svc.ID, stale); err != nil {
+			return err
+		}
+
+		if imageChanged || svc.BuildPack != buildPack {
+			if err := q.SetServiceImage(ctx, services.SetServiceImageParams{
+				ID:                  svc.ID,
+				Image:               image,
+				RegistryCredentials: registryCreds,
+			}); err != nil {
+				return fmt.Errorf("failed to save image: %w", err)
+			}
+		}
+
+		if input.DeployPolicy != nil || input.DeployDebounce != nil {
+			if err := q.SetServiceDeployPolicy(ctx, services.SetServiceDeployPolicyParams{
+				ID:             svc.ID,
+				DeployPolicy:   deployPolicy,
+				DeployDebounce: deployDebounce,
+			}); err != nil {
+				return fmt.Errorf("failed to save deploy_policy: %w", err)
+			}
+		}
+
+		if input.TagPattern != nil {
+			if err := q.SetServiceTagPattern(ctx, services.SetServiceTagPatternParams{
+				ID:         svc.ID,
+				TagPattern: tagPattern,
+			}); err != nil {
+				return fmt.Errorf("failed to save tag_pattern: %w", err)
+			}
+		}
+		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update service: %w", err)
+		return nil, err
+	}
+
+	// Path routes carry the target service's access and wake middlewares
+	if input.Access != nil || input.SleepAfterIdle != nil {
+		if err := s.syncProjectRoutes(ctx, svc.ProjectID); err != nil {
+			s.logger.Warn("failed to sync project routes", "serviceID", svc.ID, "error", err)
+		}
 	}
 
 	workflowID, err := s.RedeployService(ctx, svc.ID)
@@ -392,6 +745,11 @@ func (s *Service) GetProjectByRef(ctx context.Context, userID, ref string) (*pro
 	return s.getOrCreateProject(ctx, userID, ref)
 }
 
+// EnsureProject returns the user's project with ref, creating it if needed.
+func (s *Service) EnsureProject(ctx context.Context, userID, ref string) (*projects.Project, error) {
+	return s.getOrCreateProject(ctx, userID, ref)
+}
+
 func (s *Service) getOrCreateProject(ctx context.Context, userID, ref string) (*projects.Project, error) {
 	project, err := s.projectsQ.GetProjectByRef(ctx, projects.GetProjectByRefParams{
 		UserID: userID,
@@ -471,8 +829,16 @@ func (s *Service) GetLatestDeployment(ctx context.Context, serviceID string) (*d
 	return &dep, nil
 }
 
+func (s *Service) GetDeployment(ctx context.Context, deploymentID string) (*deploymentsdb.Deployment, error) {
+	dep, err := s.deploymentsQ.GetDeploymentByID(ctx, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	return &dep, nil
+}
+
 func (s *Service) RedeployService(ctx context.Context, svcID string) (string, error) {
-	return s.redeployWithTrigger(ctx, svcID, "manual", "")
+	return s.redeployWithTrigger(ctx, svcID, "manual", "", "")
 }
 
 func (s *Service) RedeployFromGitHubPush(ctx context.Context, svcID, afterSHA, deliveryID string) (string, error) {
@@ -480,7 +846,7 @@ func (s *Service) RedeployFromGitHubPush(ctx context.Context, svcID, afterSHA, d
 	if triggerRef == "" || triggerRef == "0000000000000000000000000000000000000000" {
 		triggerRef = strings.TrimSpace(deliveryID)
 	}
-	return s.redeployWithTrigger(ctx, svcID, "git_push", triggerRef)
+	return s.redeployWithTrigger(ctx, svcID, "git_push", triggerRef, "")
 }
 
 func (s *Service) RedeployFromInternalGitPush(ctx context.Context, svcID, afterSHA string) (string, error) {
@@ -488,10 +854,12 @@ func (s *Service) RedeployFromInternalGitPush(ctx context.Context, svcID, afterS
 	if triggerRef == "" || triggerRef == "0000000000000000000000000000000000000000" {
 		triggerRef = ""
 	}
-	return s.redeployWithTrigger(ctx, svcID, "git_push", triggerRef)
+	return s.redeployWithTrigger(ctx, svcID, "git_push", triggerRef, "")
 }
 
-func (s *Service) redeployWithTrigger(ctx context.Context, svcID, trigger, triggerRef string) (string, error) {
+// redeployWithTrigger records a deployment and hands it to the deploy
+// coordinator. gitTag is the release tag for git_tag triggers.
+func (s *Service) redeployWithTrigger(ctx context.Context, svcID, trigger, triggerRef, gitTag string) (string, error) {
 	svc, err := s.servicesQ.GetServiceByID(ctx, svcID)
 	if err != nil {
 		return "", fmt.Errorf("service not found: %w", err)
@@ -502,22 +870,34 @@ func (s *Service) redeployWithTrigger(ctx context.Context, svcID, trigger, trigg
 		return "", fmt.Errorf("unknown region %q for service %s", svc.Region, svcID)
 	}
 
-	deploymentID := shortuuid.New()
-	workflowID := fmt.Sprintf("deploy-%s", deploymentID)
-
-	cancelledWorkflows, err := s.deploymentsQ.CancelInFlightDeployments(ctx, deploymentsdb.CancelInFlightDeploymentsParams{
-		ServiceID: svcID,
-		ID:        deploymentID,
-	})
-	if err != nil {
-		s.logger.Warn("failed to cancel in-flight deployments", "serviceID", svcID, "error", err)
-	}
-	for _, wfID := range cancelledWorkflows {
-		if cancelErr := s.temporalClient.CancelWorkflow(ctx, wfID, ""); cancelErr != nil {
-			s.logger.Warn("failed to cancel Temporal workflow", "workflowID", wfID, "error", cancelErr)
+	var ref string
+	switch trigger {
+	case "manual_ref":
+		if svc.BuildPack == k8sdeployments.BuildPackImage {
+			return "", fmt.Errorf("ref is not supported for build_pack=image; update the image instead")
+		}
+		ref = triggerRef
+	case "git_tag":
+		ref = triggerRef
+		if !isCommitSHA(ref) {
+			ref = "refs/tags/" + gitTag
+		}
+	case "manual":
+		// A release service redeploys the tag it is running, not branch HEAD
+		if svc.TagPattern != nil && svc.CurrentDeploymentID != nil {
+			if current, err := s.deploymentsQ.GetDeploymentByID(ctx, *svc.CurrentDeploymentID); err == nil &&
+				current.GitTag != nil && current.CommitHash != nil {
+				gitTag = *current.GitTag
+				ref = *current.CommitHash
+			}
 		}
 	}
 
+	// The service's deploy coordinator applies deploy_policy to deploys
+	// already in flight
+	deploymentID := shortuuid.New()
+	workflowID := k8sdeployments.DeployWorkflowID(deploymentID)
+
 	envVarsSnapshot := svc.EnvVars
 	if len(envVarsSnapshot) == 0 {
 		envVarsSnapshot = []byte("[]")
@@ -531,9 +911,21 @@ func (s *Service) redeployWithTrigger(ctx context.Context, svcID, trigger, trigg
 	if triggerRef != "" {
 		triggerRefPtr = &triggerRef
 	}
+	// Pushes and pinned SHAs know their commit up front; a tag or branch
+	// is resolved when the source is fetched
+	commitSHA := ""
+	if trigger == "git_push" {
+		commitSHA = triggerRef
+	} else if isCommitSHA(ref) {
+		commitSHA = ref
+	}
 	var commitHashPtr *string
-	if triggerRef != "" && trigger == "git_push" {
-		commitHashPtr = &triggerRef
+	if commitSHA != "" {
+		commitHashPtr = &commitSHA
+	}
+	var gitTagPtr *string
+	if gitTag != "" {
+		gitTagPtr = &gitTag
 	}
 
 	_, err = s.deploymentsQ.CreateDeployment(ctx, deploymentsdb.CreateDeploymentParams{
@@ -549,6 +941,7 @@ func (s *Service) redeployWithTrigger(ctx context.Context, svcID, trigger, trigg
 		Trigger:         trigger,
 		TriggerRef:      triggerRefPtr,
 		CommitHash:      commitHashPtr,
+		GitTag:          gitTagPtr,
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to create deployment record: %w", err)
@@ -562,17 +955,7 @@ func (s *Service) redeployWithTrigger(ctx context.Context, svcID, trigger, trigg
 		}
 	}
 
-	commitSHA := ""
-	if triggerRef != "" && trigger == "git_push" {
-		commitSHA = triggerRef
-	}
-
-	workflowOptions := client.StartWorkflowOptions{
-		ID:        workflowID,
-		TaskQueue: cluster.TaskQueue,
-	}
-
-	we, err := s.temporalClient.ExecuteWorkflow(ctx, workflowOptions, k8sdeployments.RedeployServiceWorkflow, k8sdeployments.RedeployServiceWorkflowInput{
+	_, err = s.enqueueDeploy(ctx, cluster.TaskQueue, svc.DeployPolicy, svc.DeployDebounce, false, k8sdeployments.RedeployServiceWorkflowInput{
 		ServiceID:      svcID,
 		DeploymentID:   deploymentID,
 		Repo:           svc.Repo,
@@ -580,7 +963,9 @@ func (s *Service) redeployWithTrigger(ctx context.Context, svcID, trigger, trigg
 		GitProvider:    svc.GitProvider,
 		InstallationID: installationID,
 		CommitSHA:      commitSHA,
+		Ref:            ref,
 		AppsDomain:     cluster.AppsDomain,
+		BuildPack:      svc.BuildPack,
 	})
 	if err != nil {
 		s.logger.Error("failed to start redeploy workflow",
@@ -589,19 +974,7 @@ func (s *Service) redeployWithTrigger(ctx context.Context, svcID, trigger, trigg
 		return "", fmt.Errorf("failed to start redeploy workflow: %w", err)
 	}
 
-	runID := we.GetRunID()
-	if err := s.deploymentsQ.UpdateDeploymentWorkflowRunID(ctx, deploymentsdb.UpdateDeploymentWorkflowRunIDParams{
-		ID:            deploymentID,
-		WorkflowRunID: &runID,
-	}); err != nil {
-		s.logger.Warn("failed to persist workflow run id", "deploymentID", deploymentID, "error", err)
-	}
-
-	s.logger.Info("started redeploy workflow",
-		"workflowID", workflowID,
-		"runID", we.GetRunID())
-
-	return we.GetID(), nil
+	return workflowID, nil
 }
 
 type DeleteServiceParams struct {
@@ -654,6 +1027,24 @@ func (s *Service) DeleteService(ctx context.Context, params DeleteServiceParams)
 	namespace := k8sdeployments.NamespaceName(user.ID, proj.Ref)
 	serviceName := k8sdeployments.ServiceName(name)
 
+	// Stop running deploys so they don't re-apply resources after the delete;
+	// the delete workflow waits for them to close. An empty ID matches every
+	// in-flight deployment.
+	cancelledWorkflows, err := s.deploymentsQ.CancelInFlightDeployments(ctx, deploymentsdb.CancelInFlightDeploymentsParams{
+		ServiceID: svc.ID,
+	})
+	if err != nil {
+		s.logger.Warn("failed to cancel in-flight deployments", "service_id", svc.ID, "error", err)
+	}
+	// The coordinator would otherwise start deploys still queued behind them
+	cancelledWorkflows = append(cancelledWorkflows, k8sdeployments.DeployCoordinatorWorkflowID(svc.ID))
+	for _, wfID := range cancelledWorkflows {
+		var notFound *serviceerror.NotFound
+		if cancelErr := s.temporalClient.CancelWorkflow(ctx, wfID, ""); cancelErr != nil && !errors.As(cancelErr, &notFound) {
+			s.logger.Warn("failed to cancel Temporal workflow", "workflow_id", wfID, "error", cancelErr)
+		}
+	}
+
 	workflowID := fmt.Sprintf("delete-svc-%s", svc.ID)
 
 	workflowOptions := client.StartWorkflowOptions{
@@ -662,9 +1053,10 @@ func (s *Service) DeleteService(ctx context.Context, params DeleteServiceParams)
 	}
 
 	input := k8sdeployments.DeleteServiceWorkflowInput{
-		ServiceID: svc.ID,
-		Namespace: namespace,
-		Name:      serviceName,
+		ServiceID:           svc.ID,
+		Namespace:           namespace,
+		Name:                serviceName,
+		InFlightWorkflowIDs: cancelledWorkflows,
 	}
 
 	run, err := s.temporalClient.ExecuteWorkflow(ctx, workflowOptions, k8sdeployments.DeleteServiceWorkflow, input)
@@ -672,6 +1064,14 @@ func (s *Service) DeleteService(ctx context.Context, params DeleteServiceParams)
 		return nil, fmt.Errorf("failed to start delete workflow: %w", err)
 	}
 
+	if removed, err := s.routesQ.DeleteRoutesByServiceID(ctx, svc.ID); err != nil {
+		s.logger.Warn("failed to delete routes for service", "service_id", svc.ID, "error", err)
+	} else if len(removed) > 0 {
+		if err := s.syncProjectRoutes(ctx, svc.ProjectID); err != nil {
+			s.logger.Warn("failed to sync project routes", "service_id", svc.ID, "error", err)
+		}
+	}
+
 	s.logger.Info("started delete service workflow",
 		"service_id", svc.ID,
 		"name", name,
@@ -683,4 +1083,3 @@ func (s *Service) DeleteService(ctx context.Context, params DeleteServiceParams)
 		WorkflowID: run.GetID(),
 	}, nil
 }
-
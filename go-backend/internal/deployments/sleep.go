@@ -0,0 +1,56 @@
+package deployments
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	minSleepAfterIdle = 5 * time.Minute
+	maxSleepAfterIdle = 7 * 24 * time.Hour
+)
+
+// parseSleepAfterIdle converts a duration such as "30m" into the seconds
+// stored in services.sleep_after_idle. An empty string or "0" disables
+// sleeping and yields nil.
+func parseSleepAfterIdle(s string) (*int32, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "0" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sleep_after_idle %q: use a duration like 30m or 2h", s)
+	}
+	if d < minSleepAfterIdle || d > maxSleepAfterIdle {
+		return nil, fmt.Errorf("sleep_after_idle must be between %s and %s", minSleepAfterIdle, maxSleepAfterIdle)
+	}
+	seconds := int32(d / time.Second)
+	return &seconds, nil
+}
+
+// FormatSleepAfterIdle renders a stored sleep_after_idle for display; nil
+// means the service never sleeps.
+func FormatSleepAfterIdle(seconds *int32) string {
+	if seconds == nil {
+		return ""
+	}
+	return (time.Duration(*seconds) * time.Second).String()
+}
+
+// ServiceStatus is the status shown to users. It is the latest deployment's
+// status, except that an active deployment scaled to zero reports "stopped"
+// when a user stopped it and "sleeping" when it went idle.
+func ServiceStatus(deploymentStatus string, sleeping, stopped bool) string {
+	if deploymentStatus != "active" {
+		return deploymentStatus
+	}
+	switch {
+	case stopped:
+		return "stopped"
+	case sleeping:
+		return "sleeping"
+	}
+	return deploymentStatus
+}
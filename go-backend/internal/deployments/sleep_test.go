@@ -0,0 +1,66 @@
+package deployments
+
+import "testing"
+
+func TestParseSleepAfterIdle(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int32 // 0 means nil
+		wantErr bool
+	}{
+		{in: "", want: 0},
+		{in: "0", want: 0},
+		{in: "30m", want: 1800},
+		{in: " 2h ", want: 7200},
+		{in: "5m", want: 300},
+		{in: "1m", wantErr: true},
+		{in: "200h", wantErr: true},
+		{in: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseSleepAfterIdle(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseSleepAfterIdle(%q) expected error", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSleepAfterIdle(%q) unexpected error: %v", tt.in, err)
+			}
+			if tt.want == 0 {
+				if got != nil {
+					t.Fatalf("parseSleepAfterIdle(%q) = %d, want nil", tt.in, *got)
+				}
+				return
+			}
+			if got == nil || *got != tt.want {
+				t.Fatalf("parseSleepAfterIdle(%q) = %v, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceStatus(t *testing.T) {
+	if got := ServiceStatus("active", true, false); got != "sleeping" {
+		t.Errorf("ServiceStatus(active, sleeping) = %q, want sleeping", got)
+	}
+	if got := ServiceStatus("active", false, false); got != "active" {
+		t.Errorf("ServiceStatus(active, awake) = %q, want active", got)
+	}
+	// A rollout in progress wins over a stale sleeping flag
+	if got := ServiceStatus("building", true, false); got != "building" {
+		t.Errorf("ServiceStatus(building, sleeping) = %q, want building", got)
+	}
+	if got := ServiceStatus("active", false, true); got != "stopped" {
+		t.Errorf("ServiceStatus(active, stopped) = %q, want stopped", got)
+	}
+	if got := ServiceStatus("active", true, true); got != "stopped" {
+		t.Errorf("ServiceStatus(active, sleeping, stopped) = %q, want stopped", got)
+	}
+	if got := ServiceStatus("deploying", false, true); got != "deploying" {
+		t.Errorf("ServiceStatus(deploying, stopped) = %q, want deploying", got)
+	}
+}
@@ -0,0 +1,49 @@
+package deployments
+
+import (
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+)
+
+// normalizeStaticSite validates the static site rules stored in
+// build_config. Pages and redirect sources get a leading "/" when missing.
+func normalizeStaticSite(c k8sdeployments.StaticSiteConfig) (k8sdeployments.StaticSiteConfig, error) {
+	c.NotFoundPage = sitePath(c.NotFoundPage)
+	redirects := make([]k8sdeployments.StaticRedirect, 0, len(c.Redirects))
+	for _, r := range c.Redirects {
+		r.From = sitePath(r.From)
+		r.To = strings.TrimSpace(r.To)
+		if r.Status == 0 {
+			r.Status = 301
+		}
+		redirects = append(redirects, r)
+	}
+	c.Redirects = nil
+	if len(redirects) > 0 {
+		c.Redirects = redirects
+	}
+	headers := make([]k8sdeployments.StaticHeader, 0, len(c.Headers))
+	for _, h := range c.Headers {
+		h.Path = sitePath(h.Path)
+		h.Name = strings.TrimSpace(h.Name)
+		h.Value = strings.TrimSpace(h.Value)
+		headers = append(headers, h)
+	}
+	c.Headers = nil
+	if len(headers) > 0 {
+		c.Headers = headers
+	}
+	if err := k8sdeployments.ValidateStaticSiteConfig(c); err != nil {
+		return k8sdeployments.StaticSiteConfig{}, err
+	}
+	return c, nil
+}
+
+func sitePath(p string) string {
+	p = strings.TrimSpace(p)
+	if p == "" || strings.HasPrefix(p, "/") {
+		return p
+	}
+	return "/" + p
+}
@@ -0,0 +1,56 @@
+package deployments
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+)
+
+func TestNormalizeStaticSite(t *testing.T) {
+	off := false
+	tests := []struct {
+		name    string
+		cfg     k8sdeployments.StaticSiteConfig
+		want    k8sdeployments.StaticSiteConfig
+		wantErr bool
+	}{
+		{name: "empty", cfg: k8sdeployments.StaticSiteConfig{}, want: k8sdeployments.StaticSiteConfig{}},
+		{
+			name: "adds leading slashes and default status",
+			cfg: k8sdeployments.StaticSiteConfig{
+				SPAFallback:  &off,
+				NotFoundPage: "404.html",
+				Redirects:    []k8sdeployments.StaticRedirect{{From: "old", To: " /new "}},
+				Headers:      []k8sdeployments.StaticHeader{{Path: "assets/*", Name: " Cache-Control ", Value: "immutable"}},
+			},
+			want: k8sdeployments.StaticSiteConfig{
+				SPAFallback:  &off,
+				NotFoundPage: "/404.html",
+				Redirects:    []k8sdeployments.StaticRedirect{{From: "/old", To: "/new", Status: 301}},
+				Headers:      []k8sdeployments.StaticHeader{{Path: "/assets/*", Name: "Cache-Control", Value: "immutable"}},
+			},
+		},
+		{name: "bad status", cfg: k8sdeployments.StaticSiteConfig{Redirects: []k8sdeployments.StaticRedirect{{From: "/a", To: "/b", Status: 307}}}, wantErr: true},
+		{name: "bad header name", cfg: k8sdeployments.StaticSiteConfig{Headers: []k8sdeployments.StaticHeader{{Path: "/*", Name: "X Bad", Value: "1"}}}, wantErr: true},
+		{name: "not found page outside site", cfg: k8sdeployments.StaticSiteConfig{NotFoundPage: "../etc/passwd"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeStaticSite(tt.cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeStaticSite(%+v) expected error", tt.cfg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeStaticSite(%+v) unexpected error: %v", tt.cfg, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeStaticSite = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
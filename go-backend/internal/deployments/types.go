@@ -5,3 +5,27 @@ type EnvVar struct {
 	Value       string `json:"value"`
 	IsBuildTime bool   `json:"is_build_time,omitempty"`
 }
+
+// AccessPolicyInput is the caller-facing access policy. Passwords are
+// plaintext here and hashed before being persisted.
+type AccessPolicyInput struct {
+	BasicAuth   []BasicAuthCredential
+	IPAllowList []string
+}
+
+type BasicAuthCredential struct {
+	Username string
+	Password string
+}
+
+type PortInput struct {
+	Port     int32
+	Protocol string
+}
+
+// PublicPort is an exposed TCP/UDP port with the address clients dial.
+type PublicPort struct {
+	Port     int32
+	Protocol string
+	Address  string
+}
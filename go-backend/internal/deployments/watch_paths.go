@@ -0,0 +1,108 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/lithammer/shortuuid/v4"
+)
+
+const maxWatchPaths = 20
+
+// normalizeWatchPaths trims and validates watch_paths globs. Paths are
+// relative to the repo root; a leading "/" or "./" is dropped.
+func normalizeWatchPaths(patterns []string) ([]string, error) {
+	if len(patterns) > maxWatchPaths {
+		return nil, fmt.Errorf("at most %d watch_paths are allowed", maxWatchPaths)
+	}
+	var out []string
+	for _, p := range patterns {
+		p = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(p), "./"), "/")
+		if p == "" {
+			continue
+		}
+		if !doublestar.ValidatePattern(p) {
+			return nil, fmt.Errorf("invalid watch_paths glob %q", p)
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// WatchPaths returns the globs a push must touch to redeploy the service:
+// the explicit watch_paths, else everything under root_directory. Nil means
+// every push redeploys.
+func WatchPaths(bc k8sdeployments.BuildConfig) []string {
+	if len(bc.WatchPaths) > 0 {
+		return bc.WatchPaths
+	}
+	root := strings.Trim(path.Clean("/"+bc.RootDirectory), "/")
+	if root == "" {
+		return nil
+	}
+	return []string{root + "/**"}
+}
+
+// ServiceWatchPaths returns WatchPaths for a stored service.
+func ServiceWatchPaths(svc services.Service) []string {
+	var bc k8sdeployments.BuildConfig
+	if len(svc.BuildConfig) > 0 {
+		_ = json.Unmarshal(svc.BuildConfig, &bc)
+	}
+	return WatchPaths(bc)
+}
+
+// changesMatchWatchPaths reports whether any changed file matches one of
+// the globs. A nil file list means the changes are unknown (new branch,
+// truncated payload) and always matches.
+func changesMatchWatchPaths(patterns, changed []string) bool {
+	if len(patterns) == 0 || changed == nil {
+		return true
+	}
+	for _, file := range changed {
+		for _, pattern := range patterns {
+			if ok, _ := doublestar.Match(pattern, file); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SkipUnwatchedPush reports whether a push that changed the given files
+// leaves the service alone, and records a skipped trigger event if so.
+func (s *Service) SkipUnwatchedPush(ctx context.Context, svc services.Service, commitSHA string, changed []string) bool {
+	patterns := ServiceWatchPaths(svc)
+	if changesMatchWatchPaths(patterns, changed) {
+		return false
+	}
+
+	reason := fmt.Sprintf("no changes in watch_paths (%s)", strings.Join(patterns, ", "))
+	var triggerRef *string
+	if commitSHA != "" {
+		triggerRef = &commitSHA
+	}
+	if err := s.deploymentsQ.CreateTriggerEvent(ctx, deploymentsdb.CreateTriggerEventParams{
+		ID:         shortuuid.New(),
+		ServiceID:  svc.ID,
+		Trigger:    "git_push",
+		TriggerRef: triggerRef,
+		Status:     "skipped",
+		Reason:     &reason,
+	}); err != nil {
+		s.logger.Warn("failed to record skipped trigger", "service_id", svc.ID, "error", err)
+	}
+
+	s.logger.Info("skipped redeploy: push outside watch_paths",
+		"service_id", svc.ID,
+		"commit_sha", commitSHA,
+		"changed_files", len(changed))
+	return true
+}
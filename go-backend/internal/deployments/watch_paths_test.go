@@ -0,0 +1,86 @@
+package deployments
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+)
+
+func TestNormalizeWatchPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		want     []string
+		wantErr  bool
+	}{
+		{name: "empty", patterns: nil, want: nil},
+		{name: "trims prefixes", patterns: []string{" ./services/api/** ", "/libs/shared/**"}, want: []string{"services/api/**", "libs/shared/**"}},
+		{name: "drops blanks", patterns: []string{"", "  ", "go.mod"}, want: []string{"go.mod"}},
+		{name: "invalid glob", patterns: []string{"services/[api"}, wantErr: true},
+		{name: "too many", patterns: make([]string, maxWatchPaths+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeWatchPaths(tt.patterns)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("normalizeWatchPaths(%q) expected error", tt.patterns)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeWatchPaths(%q) unexpected error: %v", tt.patterns, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeWatchPaths(%q) = %q, want %q", tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWatchPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		bc   k8sdeployments.BuildConfig
+		want []string
+	}{
+		{name: "repo root", bc: k8sdeployments.BuildConfig{}, want: nil},
+		{name: "root directory", bc: k8sdeployments.BuildConfig{RootDirectory: "./services/api/"}, want: []string{"services/api/**"}},
+		{name: "explicit", bc: k8sdeployments.BuildConfig{RootDirectory: "services/api", WatchPaths: []string{"services/api/**", "libs/**"}}, want: []string{"services/api/**", "libs/**"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WatchPaths(tt.bc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WatchPaths = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangesMatchWatchPaths(t *testing.T) {
+	patterns := []string{"services/api/**", "go.mod"}
+	tests := []struct {
+		name     string
+		patterns []string
+		changed  []string
+		want     bool
+	}{
+		{name: "no patterns", changed: []string{"README.md"}, want: true},
+		{name: "unknown changes", patterns: patterns, want: true},
+		{name: "nested match", patterns: patterns, changed: []string{"docs/a.md", "services/api/cmd/main.go"}, want: true},
+		{name: "exact file", patterns: patterns, changed: []string{"go.mod"}, want: true},
+		{name: "sibling service", patterns: patterns, changed: []string{"services/web/index.ts"}, want: false},
+		{name: "empty diff", patterns: patterns, changed: []string{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changesMatchWatchPaths(tt.patterns, tt.changed); got != tt.want {
+				t.Errorf("changesMatchWatchPaths(%q, %q) = %v, want %v", tt.patterns, tt.changed, got, tt.want)
+			}
+		})
+	}
+}
@@ -94,6 +94,7 @@ func (a *Activities) ApplySubdomainIngress(ctx context.Context, input ApplySubdo
 		input.ServiceName,
 		input.FQDN,
 		input.ServicePort,
+		input.Middlewares,
 	)
 
 	data, err := json.Marshal(ingressRoute)
@@ -181,9 +182,20 @@ func buildRedirectMiddleware(namespace string) *unstructured.Unstructured {
 	}
 }
 
-func buildSubdomainIngressRoute(namespace, serviceName, fqdn string, port int32) *unstructured.Unstructured {
+// buildSubdomainIngressRoute routes fqdn to the service. Extra middlewares
+// (e.g. the service's access policy) run after the HTTPS redirect.
+func buildSubdomainIngressRoute(namespace, serviceName, fqdn string, port int32, middlewares []string) *unstructured.Unstructured {
 	ingressName := serviceName + "-dz"
 
+	chain := []any{
+		map[string]any{
+			"name": "redirect-https",
+		},
+	}
+	for _, mw := range middlewares {
+		chain = append(chain, map[string]any{"name": mw})
+	}
+
 	return &unstructured.Unstructured{
 		Object: map[string]any{
 			"apiVersion": "traefik.io/v1alpha1",
@@ -196,13 +208,9 @@ func buildSubdomainIngressRoute(namespace, serviceName, fqdn string, port int32)
 				"entryPoints": []any{"web", "websecure"},
 				"routes": []any{
 					map[string]any{
-						"match": fmt.Sprintf("Host(`%s`)", fqdn),
-						"kind":  "Rule",
-						"middlewares": []any{
-							map[string]any{
-								"name": "redirect-https",
-							},
-						},
+						"match":       fmt.Sprintf("Host(`%s`)", fqdn),
+						"kind":        "Rule",
+						"middlewares": chain,
 						"services": []any{
 							map[string]any{
 								"name": serviceName,
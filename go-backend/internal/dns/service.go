@@ -14,6 +14,7 @@ import (
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/users"
+	"github.com/jackc/pgx/v5"
 	"github.com/lithammer/shortuuid/v4"
 	"go.temporal.io/sdk/client"
 )
@@ -447,6 +448,11 @@ func (s *Service) AddCustomDomain(ctx context.Context, params AddCustomDomainPar
 	namespace := k8sdeployments.NamespaceName(user.ID, proj.Ref)
 	serviceName := k8sdeployments.ServiceName(*svc.Name)
 	port := k8sdeployments.EffectivePort(svc.BuildPack, svc.Port, svc.BuildConfig)
+	policy, err := k8sdeployments.ParseAccessPolicy(svc.AccessPolicy)
+	if err != nil {
+		return nil, err
+	}
+	middlewares := k8sdeployments.AccessMiddlewares(serviceName, policy)
 
 	workflowID := fmt.Sprintf("attach-dz-%s", dr.ID)
 	_, err = s.temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
@@ -460,6 +466,7 @@ func (s *Service) AddCustomDomain(ctx context.Context, params AddCustomDomainPar
 		Namespace:    namespace,
 		ServiceName:  serviceName,
 		ServicePort:  port,
+		Middlewares:  middlewares,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to start subdomain attach workflow: %w", err)
@@ -541,8 +548,11 @@ func (s *Service) RemoveCustomDomain(ctx context.Context, params RemoveCustomDom
 
 func (s *Service) GetCustomDomainForService(ctx context.Context, serviceID string) (*dnsdb.DnsRecord, *dnsdb.HostedZone, error) {
 	records, err := s.dnsQ.ListDnsRecordsByServiceID(ctx, &serviceID)
-	if err != nil || len(records) == 0 {
-		return nil, nil, fmt.Errorf("no custom domain")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list dns records: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("no custom domain: %w", pgx.ErrNoRows)
 	}
 	dr := records[0]
 	hz, err := s.dnsQ.GetHostedZoneByID(ctx, dr.ZoneID)
@@ -33,6 +33,7 @@ type AttachSubdomainInput struct {
 	Namespace    string
 	ServiceName  string
 	ServicePort  int32
+	Middlewares  []string
 }
 
 type AttachSubdomainResult struct {
@@ -104,6 +105,7 @@ type ApplySubdomainIngressInput struct {
 	ServiceName string
 	FQDN        string
 	ServicePort int32
+	Middlewares []string
 }
 
 type DeleteIngressInput struct {
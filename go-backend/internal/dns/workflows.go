@@ -169,6 +169,7 @@ func AttachSubdomainWorkflow(ctx workflow.Context, input AttachSubdomainInput) (
 		ServiceName: input.ServiceName,
 		FQDN:        fqdn,
 		ServicePort: input.ServicePort,
+		Middlewares: input.Middlewares,
 	}).Get(ctx, nil); err != nil {
 		return AttachSubdomainResult{
 			Status:       "failed",
@@ -6,6 +6,9 @@ import (
 	"crypto/subtle"
 	"encoding/hex"
 	"net/http"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/gittokens"
 )
 
 type AuthResult struct {
@@ -43,6 +46,10 @@ func (s *Server) authenticateRequest(r *http.Request) *AuthResult {
 		s.logger.Debug("token lookup failed", "error", err)
 		return nil
 	}
+	if reason := tokenRejection(token, time.Now()); reason != "" {
+		s.logger.Info("git token rejected", "tokenID", token.ID, "reason", reason)
+		return nil
+	}
 
 	go func() {
 		if err := s.gitTokensQ.UpdateLastUsed(context.Background(), token.ID); err != nil {
@@ -60,6 +67,18 @@ func (s *Server) authenticateRequest(r *http.Request) *AuthResult {
 	}
 }
 
+// tokenRejection returns why a stored token can't be used, or "" if it
+// can. The lookup doesn't filter on these so the reason can be logged.
+func tokenRejection(token gittokens.GetTokenByHashRow, now time.Time) string {
+	if token.RevokedAt.Valid {
+		return "revoked"
+	}
+	if token.ExpiresAt.Valid && !now.Before(token.ExpiresAt.Time) {
+		return "expired"
+	}
+	return ""
+}
+
 // isExternalRequest returns true if the request came through the external
 // IngressRoute (i.e., from the internet). Internal K8s Service traffic
 // doesn't have X-Forwarded-For headers.
@@ -0,0 +1,60 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/gittokens"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type fakeGitTokensQ struct {
+	gittokens.Querier
+	tokens map[string]gittokens.GetTokenByHashRow
+}
+
+func (f fakeGitTokensQ) GetTokenByHash(_ context.Context, hash string) (gittokens.GetTokenByHashRow, error) {
+	token, ok := f.tokens[hash]
+	if !ok {
+		return gittokens.GetTokenByHashRow{}, pgx.ErrNoRows
+	}
+	return token, nil
+}
+
+func (fakeGitTokensQ) UpdateLastUsed(context.Context, string) error {
+	return nil
+}
+
+func TestAuthenticateRequest_TokenState(t *testing.T) {
+	now := time.Now()
+	at := func(t time.Time) pgtype.Timestamptz { return pgtype.Timestamptz{Time: t, Valid: true} }
+	tests := []struct {
+		name  string
+		token gittokens.GetTokenByHashRow
+		ok    bool
+	}{
+		{"no expiry", gittokens.GetTokenByHashRow{}, true},
+		{"not yet expired", gittokens.GetTokenByHashRow{ExpiresAt: at(now.Add(time.Hour))}, true},
+		{"expired", gittokens.GetTokenByHashRow{ExpiresAt: at(now.Add(-time.Minute))}, false},
+		{"revoked", gittokens.GetTokenByHashRow{RevokedAt: at(now.Add(-time.Minute))}, false},
+		{"revoked before expiry", gittokens.GetTokenByHashRow{ExpiresAt: at(now.Add(time.Hour)), RevokedAt: at(now)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.token.ID, tt.token.UserID, tt.token.Scopes = "tok", "user", []string{"pull"}
+			q := fakeGitTokensQ{tokens: map[string]gittokens.GetTokenByHashRow{hashToken("mlg_test"): tt.token}}
+			s := &Server{gitTokensQ: q, logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+			r := httptest.NewRequest("GET", "/alice/app.git/info/refs", nil)
+			r.SetBasicAuth("x-git-token", "mlg_test")
+			if auth := s.authenticateRequest(r); (auth != nil) != tt.ok {
+				t.Errorf("authenticated = %v, want %v", auth != nil, tt.ok)
+			}
+		})
+	}
+}
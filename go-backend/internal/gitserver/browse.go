@@ -0,0 +1,388 @@
+package gitserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/go-chi/chi/v5"
+)
+
+// statusError carries the HTTP status a request fails with.
+type statusError struct {
+	status int
+	msg    string
+}
+
+func (e *statusError) Error() string { return e.msg }
+
+func notFound(format string, args ...any) error {
+	return &statusError{status: http.StatusNotFound, msg: fmt.Sprintf(format, args...)}
+}
+
+func badRequest(format string, args ...any) error {
+	return &statusError{status: http.StatusBadRequest, msg: fmt.Sprintf(format, args...)}
+}
+
+// browseRepo authenticates a read-only browse request and returns the
+// bare repo path. Unlike fetches it never creates the repo.
+func (s *Server) browseRepo(w http.ResponseWriter, r *http.Request) (string, bool) {
+	owner := chi.URLParam(r, "owner")
+	repo := chi.URLParam(r, "repo")
+	if s.requireRepoAuth(w, r, owner+"/"+repo, "pull") == nil {
+		return "", false
+	}
+	repoPath := barePath(s.config.ReposRoot, owner, repo)
+	if _, err := os.Stat(filepath.Join(repoPath, "HEAD")); err != nil {
+		http.Error(w, "repository not found", http.StatusNotFound)
+		return "", false
+	}
+	return repoPath, true
+}
+
+func (s *Server) writeJSONResult(w http.ResponseWriter, r *http.Request, v any, err error) {
+	if err != nil {
+		var se *statusError
+		if errors.As(err, &se) {
+			http.Error(w, se.msg, se.status)
+			return
+		}
+		s.logger.Error("git server request failed", "path", r.URL.Path, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	json.NewEncoder(w).Encode(v)
+}
+
+// handleBrowseRefs handles GET /{owner}/{repo}.git/browse/refs
+func (s *Server) handleBrowseRefs(w http.ResponseWriter, r *http.Request) {
+	repoPath, ok := s.browseRepo(w, r)
+	if !ok {
+		return
+	}
+	refs, err := listRefs(r.Context(), repoPath)
+	s.writeJSONResult(w, r, refs, err)
+}
+
+// handleBrowseLog handles GET /{owner}/{repo}.git/browse/log?ref=&path=&limit=
+func (s *Server) handleBrowseLog(w http.ResponseWriter, r *http.Request) {
+	repoPath, ok := s.browseRepo(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	limit := internalgit.DefaultRepoLogLimit
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			http.Error(w, "limit must be a positive number", http.StatusBadRequest)
+			return
+		}
+		limit = min(n, internalgit.MaxRepoLogLimit)
+	}
+	log, err := commitLog(r.Context(), repoPath, q.Get("ref"), q.Get("path"), limit)
+	s.writeJSONResult(w, r, log, err)
+}
+
+// handleBrowseTree handles GET /{owner}/{repo}.git/browse/tree?ref=&path=
+func (s *Server) handleBrowseTree(w http.ResponseWriter, r *http.Request) {
+	repoPath, ok := s.browseRepo(w, r)
+	if !ok {
+		return
+	}
+	tree, err := listTree(r.Context(), repoPath, r.URL.Query().Get("ref"), r.URL.Query().Get("path"))
+	s.writeJSONResult(w, r, tree, err)
+}
+
+// handleBrowseBlob handles GET /{owner}/{repo}.git/browse/blob?ref=&path=
+func (s *Server) handleBrowseBlob(w http.ResponseWriter, r *http.Request) {
+	repoPath, ok := s.browseRepo(w, r)
+	if !ok {
+		return
+	}
+	file, err := readFile(r.Context(), repoPath, r.URL.Query().Get("ref"), r.URL.Query().Get("path"))
+	s.writeJSONResult(w, r, file, err)
+}
+
+func gitRead(ctx context.Context, repoPath string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"--git-dir", repoPath}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+func listRefs(ctx context.Context, repoPath string) (*internalgit.RepoRefs, error) {
+	refs := &internalgit.RepoRefs{Branches: []internalgit.RepoRef{}, Tags: []internalgit.RepoRef{}}
+	if out, err := gitRead(ctx, repoPath, "symbolic-ref", "--short", "HEAD"); err == nil {
+		refs.DefaultBranch = strings.TrimSpace(string(out))
+	}
+	for _, kind := range []struct {
+		pattern string
+		dst     *[]internalgit.RepoRef
+	}{
+		{"refs/heads", &refs.Branches},
+		{"refs/tags", &refs.Tags},
+	} {
+		out, err := gitRead(ctx, repoPath, "for-each-ref",
+			"--sort=-creatordate", "--count="+strconv.Itoa(internalgit.MaxRepoRefs+1),
+			"--format=%(refname:strip=2) %(objectname) %(*objectname)", kind.pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+			parts := strings.Fields(line)
+			if len(parts) < 2 {
+				continue
+			}
+			if len(*kind.dst) == internalgit.MaxRepoRefs {
+				refs.Truncated = true
+				break
+			}
+			// Annotated tags list the commit they point at
+			ref := internalgit.RepoRef{Name: parts[0], CommitSHA: parts[len(parts)-1]}
+			*kind.dst = append(*kind.dst, ref)
+		}
+	}
+	if refs.DefaultBranch != "" && !hasRef(refs.Branches, refs.DefaultBranch) {
+		// HEAD of a repo nobody pushed its default branch to yet
+		refs.DefaultBranch = ""
+	}
+	return refs, nil
+}
+
+func hasRef(refs []internalgit.RepoRef, name string) bool {
+	for _, r := range refs {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveCommit resolves a branch, tag or commit SHA to a commit SHA. An
+// empty ref is HEAD.
+func resolveCommit(ctx context.Context, repoPath, ref string) (string, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+	if strings.HasPrefix(ref, "-") || strings.ContainsAny(ref, ": \t\n") {
+		return "", badRequest("invalid ref %q", ref)
+	}
+	out, err := gitRead(ctx, repoPath, "rev-parse", "--verify", "--quiet", "--end-of-options", ref+"^{commit}")
+	if err != nil {
+		if ref == "HEAD" {
+			return "", notFound("the repository is empty")
+		}
+		return "", notFound("ref %q not found", ref)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// cleanRepoPath normalizes a path inside the repo; "" is the root.
+func cleanRepoPath(p string) (string, error) {
+	p = strings.Trim(strings.TrimSpace(p), "/")
+	if p == "" || p == "." {
+		return "", nil
+	}
+	clean := path.Clean(p)
+	if clean == ".." || strings.HasPrefix(clean, "../") || strings.ContainsRune(clean, 0) {
+		return "", badRequest("invalid path %q", p)
+	}
+	return clean, nil
+}
+
+// objectType returns the type of the object at p in commit, or a not
+// found error.
+func objectType(ctx context.Context, repoPath, commit, p string) (string, error) {
+	out, err := gitRead(ctx, repoPath, "cat-file", "-t", commit+":"+p)
+	if err != nil {
+		return "", notFound("path %q not found", p)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func commitLog(ctx context.Context, repoPath, ref, p string, limit int) (*internalgit.RepoLog, error) {
+	commit, err := resolveCommit(ctx, repoPath, ref)
+	if err != nil {
+		return nil, err
+	}
+	p, err = cleanRepoPath(p)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{"log", "--format=%H%x1f%an%x1f%ae%x1f%aI%x1f%s%x1e", "-n", strconv.Itoa(limit), commit}
+	if p != "" {
+		args = append(args, "--", p)
+	}
+	out, err := gitRead(ctx, repoPath, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	log := &internalgit.RepoLog{Ref: refOrHead(ref), Commits: []internalgit.RepoCommit{}}
+	for _, rec := range strings.Split(string(out), "\x1e") {
+		fields := strings.Split(strings.TrimSpace(rec), "\x1f")
+		if len(fields) != 5 {
+			continue
+		}
+		at, _ := time.Parse(time.RFC3339, fields[3])
+		log.Commits = append(log.Commits, internalgit.RepoCommit{
+			SHA:         fields[0],
+			AuthorName:  fields[1],
+			AuthorEmail: fields[2],
+			AuthoredAt:  at,
+			Subject:     fields[4],
+		})
+	}
+	return log, nil
+}
+
+func listTree(ctx context.Context, repoPath, ref, p string) (*internalgit.RepoTree, error) {
+	commit, err := resolveCommit(ctx, repoPath, ref)
+	if err != nil {
+		return nil, err
+	}
+	p, err = cleanRepoPath(p)
+	if err != nil {
+		return nil, err
+	}
+	if p != "" {
+		typ, err := objectType(ctx, repoPath, commit, p)
+		if err != nil {
+			return nil, err
+		}
+		if typ != "tree" {
+			return nil, badRequest("%q is a file, not a directory", p)
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", repoPath, "ls-tree", "-l", "-z", commit+":"+p)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	defer cmd.Wait()
+	// Stop reading once the limit is hit; the deferred Wait reaps git
+	// after the cancelled context or closed pipe ends it.
+	defer cmd.Process.Kill()
+
+	tree := &internalgit.RepoTree{Ref: refOrHead(ref), CommitSHA: commit, Path: p, Entries: []internalgit.RepoTreeEntry{}}
+	sc := bufio.NewScanner(stdout)
+	sc.Buffer(make([]byte, 64<<10), 64<<10)
+	sc.Split(splitNUL)
+	for sc.Scan() {
+		// <mode> SP <type> SP <object> SP+ <size> TAB <name>
+		meta, name, ok := strings.Cut(sc.Text(), "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) != 4 {
+			continue
+		}
+		if len(tree.Entries) == internalgit.MaxRepoTreeEntries {
+			tree.Truncated = true
+			break
+		}
+		entry := internalgit.RepoTreeEntry{Name: name, Path: path.Join(p, name), Type: treeEntryTypes[fields[1]]}
+		if size, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+			entry.Size = &size
+		}
+		tree.Entries = append(tree.Entries, entry)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read tree: %w", err)
+	}
+	return tree, nil
+}
+
+var treeEntryTypes = map[string]string{
+	"blob":   "file",
+	"tree":   "dir",
+	"commit": "submodule",
+}
+
+func splitNUL(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+func readFile(ctx context.Context, repoPath, ref, p string) (*internalgit.RepoFile, error) {
+	commit, err := resolveCommit(ctx, repoPath, ref)
+	if err != nil {
+		return nil, err
+	}
+	p, err = cleanRepoPath(p)
+	if err != nil {
+		return nil, err
+	}
+	if p == "" {
+		return nil, badRequest("path is required")
+	}
+	typ, err := objectType(ctx, repoPath, commit, p)
+	if err != nil {
+		return nil, err
+	}
+	if typ != "blob" {
+		return nil, badRequest("%q is a directory, not a file", p)
+	}
+
+	object := commit + ":" + p
+	out, err := gitRead(ctx, repoPath, "cat-file", "-s", object)
+	if err != nil {
+		return nil, err
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse blob size: %w", err)
+	}
+	if size > internalgit.MaxRepoFileSize {
+		return nil, &statusError{
+			status: http.StatusRequestEntityTooLarge,
+			msg:    fmt.Sprintf("%q is %d bytes, over the %d byte limit", p, size, internalgit.MaxRepoFileSize),
+		}
+	}
+
+	data, err := gitRead(ctx, repoPath, "cat-file", "blob", object)
+	if err != nil {
+		return nil, err
+	}
+	file := &internalgit.RepoFile{Ref: refOrHead(ref), CommitSHA: commit, Path: p, Size: size}
+	if bytes.IndexByte(data, 0) >= 0 || !utf8.Valid(data) {
+		file.Binary = true
+	} else {
+		file.Content = string(data)
+	}
+	return file, nil
+}
+
+func refOrHead(ref string) string {
+	if ref == "" {
+		return "HEAD"
+	}
+	return ref
+}
@@ -0,0 +1,236 @@
+package gitserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/internalgit"
+)
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// testRepo returns a bare repo with two commits on main, a v1 tag on the
+// first and a feature branch.
+func testRepo(t *testing.T) (bare, first, second string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	work := t.TempDir()
+	git(t, work, "init", "--quiet", "--initial-branch", "main")
+	write := func(name, content string) {
+		p := filepath.Join(work, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("README.md", "hello\n")
+	write("src/main.go", "package main\n")
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "first")
+	first = git(t, work, "rev-parse", "HEAD")
+	git(t, work, "tag", "-a", "v1", "-m", "v1")
+	git(t, work, "branch", "feature")
+
+	write("README.md", "hello again\n")
+	write("logo.png", "\x89PNG\x00\x01")
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "second")
+	second = git(t, work, "rev-parse", "HEAD")
+
+	bare = filepath.Join(t.TempDir(), "repo.git")
+	git(t, work, "clone", "--quiet", "--bare", work, bare)
+	return bare, first, second
+}
+
+func errStatus(err error) int {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.status
+	}
+	return 0
+}
+
+func TestListRefs(t *testing.T) {
+	bare, first, second := testRepo(t)
+	refs, err := listRefs(context.Background(), bare)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refs.DefaultBranch != "main" {
+		t.Errorf("DefaultBranch = %q, want main", refs.DefaultBranch)
+	}
+	branches := map[string]string{}
+	for _, b := range refs.Branches {
+		branches[b.Name] = b.CommitSHA
+	}
+	if want := map[string]string{"main": second, "feature": first}; !reflect.DeepEqual(branches, want) {
+		t.Errorf("branches = %v, want %v", branches, want)
+	}
+	if want := []internalgit.RepoRef{{Name: "v1", CommitSHA: first}}; !reflect.DeepEqual(refs.Tags, want) {
+		t.Errorf("tags = %v, want the peeled v1 tag %v", refs.Tags, want)
+	}
+}
+
+func TestCommitLog(t *testing.T) {
+	bare, first, second := testRepo(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		ref, path string
+		limit     int
+		want      []string
+	}{
+		{limit: 10, want: []string{second, first}},
+		{limit: 1, want: []string{second}},
+		{ref: "v1", limit: 10, want: []string{first}},
+		{path: "src", limit: 10, want: []string{first}},
+		{path: "logo.png", limit: 10, want: []string{second}},
+	}
+	for _, tt := range tests {
+		log, err := commitLog(ctx, bare, tt.ref, tt.path, tt.limit)
+		if err != nil {
+			t.Fatalf("commitLog(%q, %q) error = %v", tt.ref, tt.path, err)
+		}
+		var got []string
+		for _, c := range log.Commits {
+			got = append(got, c.SHA)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("commitLog(%q, %q, %d) = %v, want %v", tt.ref, tt.path, tt.limit, got, tt.want)
+		}
+	}
+
+	log, _ := commitLog(ctx, bare, "", "", 1)
+	if c := log.Commits[0]; c.Subject != "second" || c.AuthorEmail != "test@example.com" || c.AuthoredAt.IsZero() {
+		t.Errorf("commit = %+v", c)
+	}
+
+	for _, ref := range []string{"nope", "--all", "main:README.md"} {
+		if _, err := commitLog(ctx, bare, ref, "", 1); err == nil {
+			t.Errorf("commitLog(%q) succeeded, want an error", ref)
+		}
+	}
+}
+
+func TestListTree(t *testing.T) {
+	bare, first, _ := testRepo(t)
+	ctx := context.Background()
+
+	tree, err := listTree(ctx, bare, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range tree.Entries {
+		names = append(names, e.Type+" "+e.Path)
+	}
+	if want := []string{"file README.md", "file logo.png", "dir src"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("root entries = %v, want %v", names, want)
+	}
+	if e := tree.Entries[0]; e.Size == nil || *e.Size != int64(len("hello again\n")) {
+		t.Errorf("README.md size = %v", e.Size)
+	}
+	if tree.Entries[2].Size != nil {
+		t.Error("directories should have no size")
+	}
+
+	tree, err = listTree(ctx, bare, "v1", "/src/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.CommitSHA != first || len(tree.Entries) != 1 || tree.Entries[0].Path != "src/main.go" {
+		t.Errorf("src at v1 = %+v", tree)
+	}
+
+	for _, tt := range []struct {
+		path   string
+		status int
+	}{
+		{"README.md", http.StatusBadRequest},
+		{"missing", http.StatusNotFound},
+		{"../etc", http.StatusBadRequest},
+	} {
+		if _, err := listTree(ctx, bare, "", tt.path); errStatus(err) != tt.status {
+			t.Errorf("listTree(%q) error = %v, want status %d", tt.path, err, tt.status)
+		}
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	bare, _, _ := testRepo(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		ref, path string
+		content   string
+		binary    bool
+		status    int
+	}{
+		{path: "README.md", content: "hello again\n"},
+		{ref: "v1", path: "README.md", content: "hello\n"},
+		{ref: "feature", path: "./src/main.go", content: "package main\n"},
+		{path: "logo.png", binary: true},
+		{path: "src", status: http.StatusBadRequest},
+		{path: "", status: http.StatusBadRequest},
+		{ref: "v1", path: "logo.png", status: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		file, err := readFile(ctx, bare, tt.ref, tt.path)
+		if tt.status != 0 {
+			if errStatus(err) != tt.status {
+				t.Errorf("readFile(%q, %q) error = %v, want status %d", tt.ref, tt.path, err, tt.status)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("readFile(%q, %q) error = %v", tt.ref, tt.path, err)
+		}
+		if file.Content != tt.content || file.Binary != tt.binary {
+			t.Errorf("readFile(%q, %q) = %q (binary %v), want %q (binary %v)", tt.ref, tt.path, file.Content, file.Binary, tt.content, tt.binary)
+		}
+	}
+}
+
+func TestBrowseEmptyRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	root := t.TempDir()
+	bare, err := ensureBareRepo(root, "alice", "empty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refs, err := listRefs(context.Background(), bare)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refs.DefaultBranch != "" || len(refs.Branches) != 0 {
+		t.Errorf("refs = %+v, want none", refs)
+	}
+	if _, err := listTree(context.Background(), bare, "", ""); errStatus(err) != http.StatusNotFound {
+		t.Errorf("listTree() error = %v, want not found", err)
+	}
+}
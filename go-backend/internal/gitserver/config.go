@@ -4,4 +4,24 @@ type Config struct {
 	Port       string
 	ReposRoot  string
 	AdminToken string
+	// UploadSigningKey verifies source upload URLs; it must match
+	// internalgit's. Uploads are disabled when empty.
+	UploadSigningKey string
+	// Storage limits; zero disables a limit. Repo and user quotas are
+	// checked against the size on disk before a push is accepted.
+	MaxRepoSizeMB int64
+	MaxUserSizeMB int64
+	MaxFileSizeMB int64
+	// SecretScanning rejects pushes that add files containing secrets,
+	// unless the repo has secret_scan_bypass set.
+	SecretScanning bool
+	// DashboardURL is linked from push output so a deploy can be
+	// followed at {DashboardURL}/deployments/{id}. Omitted when empty.
+	DashboardURL string
+	// SSHPort serves git over SSH, authenticated by the users' uploaded
+	// public keys. Disabled when empty.
+	SSHPort string
+	// SSHHostKeyPath holds the SSH host key, generated on first start.
+	// Defaults to {ReposRoot}/.ssh_host_ed25519_key.
+	SSHHostKeyPath string
 }
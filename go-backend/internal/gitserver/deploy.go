@@ -6,20 +6,50 @@ import (
 	"log/slog"
 
 	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/augustdev/autoclip/internal/helpers"
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
 )
 
+// triggeredDeploy is a deploy started by a push, reported back to the
+// pusher.
+type triggeredDeploy struct {
+	ServiceID    string
+	Service      string
+	Ref          string
+	DeploymentID string
+	URL          string
+}
+
+func newTriggeredDeploy(svc services.Service, ref, workflowID string) triggeredDeploy {
+	return triggeredDeploy{
+		ServiceID:    svc.ID,
+		Service:      helpers.Deref(svc.Name),
+		Ref:          ref,
+		DeploymentID: k8sdeployments.DeploymentIDFromWorkflowID(workflowID),
+		URL:          helpers.Deref(svc.Fqdn),
+	}
+}
+
 // triggerDeploys finds services matching the given repo+branch with
-// git_provider='internal' and starts redeploy workflows for each.
+// git_provider='internal' and starts redeploy workflows for each whose
+// watch_paths the push touched. Tag changes go to release services.
 func triggerDeploys(
 	ctx context.Context,
 	logger *slog.Logger,
 	servicesQ services.Querier,
 	deployService *deployments.Service,
+	repoPath string,
 	repoFullName string,
 	changes []ChangedRef,
-) {
+) []triggeredDeploy {
+	var triggered []triggeredDeploy
 	for _, change := range changes {
+		if change.Tag != "" {
+			triggered = append(triggered, triggerTagDeploys(ctx, logger, servicesQ, deployService, repoFullName, change)...)
+			continue
+		}
+
 		matchingServices, err := servicesQ.GetServicesByRepoBranchProvider(ctx, services.GetServicesByRepoBranchProviderParams{
 			Repo:        repoFullName,
 			Branch:      change.Branch,
@@ -33,7 +63,25 @@ func triggerDeploys(
 			continue
 		}
 
+		if len(matchingServices) == 0 {
+			continue
+		}
+
+		// On failure every service redeploys, as before watch_paths existed
+		changed, err := changedFiles(repoPath, change.OldSHA, change.NewSHA)
+		if err != nil {
+			logger.Warn("failed to list changed files",
+				"repo", repoFullName,
+				"branch", change.Branch,
+				"error", err)
+			changed = nil
+		}
+
 		for _, svc := range matchingServices {
+			if deployService.SkipUnwatchedPush(ctx, svc, change.NewSHA, changed) {
+				continue
+			}
+
 			workflowID, err := deployService.RedeployFromInternalGitPush(ctx, svc.ID, change.NewSHA)
 			if err != nil {
 				logger.Error("failed to start redeploy workflow",
@@ -49,21 +97,73 @@ func triggerDeploys(
 				"repo", repoFullName,
 				"branch", change.Branch,
 				"commitSHA", change.NewSHA)
+			triggered = append(triggered, newTriggeredDeploy(svc, change.Branch, workflowID))
+		}
+	}
+	return triggered
+}
+
+// triggerTagDeploys starts release deploys for services whose tag_pattern
+// matches a pushed tag. Releases ignore watch_paths.
+func triggerTagDeploys(
+	ctx context.Context,
+	logger *slog.Logger,
+	servicesQ services.Querier,
+	deployService *deployments.Service,
+	repoFullName string,
+	change ChangedRef,
+) []triggeredDeploy {
+	tagServices, err := servicesQ.GetTagServicesByRepoProvider(ctx, services.GetTagServicesByRepoProviderParams{
+		Repo:        repoFullName,
+		GitProvider: "internal",
+	})
+	if err != nil {
+		logger.Error("failed to query release services for tag",
+			"repo", repoFullName,
+			"tag", change.Tag,
+			"error", err)
+		return nil
+	}
+
+	var triggered []triggeredDeploy
+
+	for _, svc := range tagServices {
+		if svc.TagPattern == nil || !deployments.MatchesTagPattern(*svc.TagPattern, change.Tag) {
+			continue
+		}
+
+		workflowID, err := deployService.RedeployFromTag(ctx, svc.ID, change.Tag, change.NewSHA)
+		if err != nil {
+			logger.Error("failed to start release deploy",
+				"serviceID", svc.ID,
+				"repo", repoFullName,
+				"tag", change.Tag,
+				"error", err)
+			continue
 		}
+		logger.Info("triggered release deploy",
+			"serviceID", svc.ID,
+			"workflowID", workflowID,
+			"repo", repoFullName,
+			"tag", change.Tag,
+			"commitSHA", change.NewSHA)
+		triggered = append(triggered, newTriggeredDeploy(svc, change.Tag, workflowID))
 	}
+	return triggered
 }
 
 // triggerDeploysForPush is called after a successful git receive-pack.
-// It diffs refs before/after and triggers deploys for changed branches.
-func (s *Server) triggerDeploysForPush(ctx context.Context, repoFullName string, before, after RefSnapshot) {
+// It diffs refs before/after and triggers deploys for changed branches and
+// new or moved tags.
+func (s *Server) triggerDeploysForPush(ctx context.Context, repoPath, repoFullName string, before, after RefSnapshot) []triggeredDeploy {
 	changes := diffRefs(before, after)
 	if len(changes) == 0 {
-		return
+		return nil
 	}
 
 	s.logger.Info("detected ref changes after push",
 		"repo", repoFullName,
-		"changes", fmt.Sprintf("%d branch(es)", len(changes)))
+		"changes", fmt.Sprintf("%d ref(s)", len(changes)))
 
-	triggerDeploys(ctx, s.logger, s.servicesQ, s.deployService, repoFullName, changes)
+	return triggerDeploys(ctx, s.logger, s.servicesQ, s.deployService, repoPath, repoFullName, changes)
 }
@@ -0,0 +1,168 @@
+package gitserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/helpers"
+	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
+)
+
+// pushWaitOption (git push -o wait) keeps the push open and streams the
+// status of the deploys it triggered until they finish.
+const pushWaitOption = "wait"
+
+const (
+	pushWaitTimeout  = 20 * time.Minute
+	pushWaitInterval = 3 * time.Second
+)
+
+// feedbackWriter passes receive-pack's response through but holds back its
+// last four bytes. When those are the final flush packet, messages can
+// still be sent on the progress channel before it.
+type feedbackWriter struct {
+	w    io.Writer
+	max  int
+	tail []byte
+}
+
+func newFeedbackWriter(w io.Writer, caps []string) *feedbackWriter {
+	return &feedbackWriter{w: w, max: sidebandMax(caps)}
+}
+
+func (f *feedbackWriter) Write(p []byte) (int, error) {
+	buf := append(f.tail, p...)
+	n := max(len(buf)-4, 0)
+	if n > 0 {
+		if _, err := f.w.Write(buf[:n]); err != nil {
+			return 0, err
+		}
+	}
+	f.tail = append([]byte(nil), buf[n:]...)
+	return len(p), nil
+}
+
+// canSend reports whether messages can still go to the client.
+func (f *feedbackWriter) canSend() bool {
+	return f.max > 0 && bytes.Equal(f.tail, []byte("0000"))
+}
+
+// Printf sends a line shown by git as "remote: ...".
+func (f *feedbackWriter) Printf(format string, args ...any) {
+	if !f.canSend() {
+		return
+	}
+	writeSideband(f.w, sidebandProgress, []byte(fmt.Sprintf(format, args...)+"\n"), f.max)
+	if flusher, ok := f.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Close writes the held back end of the response.
+func (f *feedbackWriter) Close() error {
+	_, err := f.w.Write(f.tail)
+	f.tail = nil
+	return err
+}
+
+// pushPrinter prints a line of push output for the pusher to see.
+type pushPrinter interface {
+	Printf(format string, args ...any)
+}
+
+// reportDeploys tells the pusher which deploys the push started and where
+// to follow them.
+func (s *Server) reportDeploys(out pushPrinter, deploys []triggeredDeploy, wait bool) {
+	if len(deploys) == 0 {
+		return
+	}
+	out.Printf("")
+	out.Printf("Deploying %d service(s):", len(deploys))
+	for _, d := range deploys {
+		out.Printf("  %s (%s) deployment %s", d.Service, d.Ref, d.DeploymentID)
+		if d.URL != "" {
+			out.Printf("    url:   %s", d.URL)
+		}
+		if s.config.DashboardURL != "" {
+			out.Printf("    watch: %s/deployments/%s", strings.TrimSuffix(s.config.DashboardURL, "/"), d.DeploymentID)
+		}
+	}
+	if !wait {
+		out.Printf("Push with -o %s to follow the deploy here.", pushWaitOption)
+	}
+	out.Printf("")
+}
+
+// followDeploys prints each deploy's status as it changes until all of
+// them are done, the timeout passes or the client goes away.
+func followDeploys(ctx context.Context, out pushPrinter, deploys []triggeredDeploy, getDeployment func(context.Context, string) (*deploymentsdb.Deployment, error), serviceURL func(context.Context, string) string, interval, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := make(map[string]string, len(deploys))
+	pending := len(deploys)
+	for {
+		for _, d := range deploys {
+			if isFinalDeployStatus(last[d.DeploymentID]) {
+				continue
+			}
+			dep, err := getDeployment(ctx, d.DeploymentID)
+			if err != nil || dep.Status == last[d.DeploymentID] {
+				continue
+			}
+			last[d.DeploymentID] = dep.Status
+
+			switch {
+			case dep.Status == "active":
+				if url := serviceURL(ctx, d.ServiceID); url != "" {
+					out.Printf("%s: active at %s", d.Service, url)
+				} else {
+					out.Printf("%s: active", d.Service)
+				}
+			case dep.ErrorMessage != nil && *dep.ErrorMessage != "":
+				out.Printf("%s: %s: %s", d.Service, dep.Status, *dep.ErrorMessage)
+			default:
+				out.Printf("%s: %s", d.Service, dep.Status)
+			}
+			if isFinalDeployStatus(dep.Status) {
+				pending--
+			}
+		}
+		if pending == 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				out.Printf("Stopped waiting after %s; the deploy continues in the background.", timeout)
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// serviceURL returns a service's URL once its first deploy has set one.
+func (s *Server) serviceURL(ctx context.Context, serviceID string) string {
+	svc, err := s.servicesQ.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		return ""
+	}
+	return helpers.Deref(svc.Fqdn)
+}
+
+func isFinalDeployStatus(status string) bool {
+	switch status {
+	case "", "queued", "building", "deploying":
+		return false
+	}
+	return true
+}
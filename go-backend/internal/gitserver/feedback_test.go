@@ -0,0 +1,148 @@
+package gitserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+)
+
+type fakeServicesQ struct {
+	services.Querier
+}
+
+func (fakeServicesQ) GetServicesByRepoBranchProvider(context.Context, services.GetServicesByRepoBranchProviderParams) ([]services.Service, error) {
+	return nil, nil
+}
+
+// sidebandText returns the progress messages in a receive-pack response.
+func sidebandText(t *testing.T, resp []byte) string {
+	t.Helper()
+	var text strings.Builder
+	r := bytes.NewReader(resp)
+	for {
+		data, flush, err := readPktLine(r)
+		if err != nil {
+			t.Fatalf("response %q: %v", resp, err)
+		}
+		if flush {
+			if r.Len() != 0 {
+				t.Errorf("data after the final flush: %q", resp)
+			}
+			return text.String()
+		}
+		if data[0] == sidebandProgress {
+			text.Write(data[1:])
+		}
+	}
+}
+
+func TestFeedbackWriter(t *testing.T) {
+	var report, resp bytes.Buffer
+	writePktLine(&report, "unpack ok\n")
+	writePktFlush(&report)
+	writeSideband(&resp, sidebandData, report.Bytes(), 65515)
+	writePktFlush(&resp)
+
+	var got bytes.Buffer
+	out := newFeedbackWriter(&got, []string{"report-status", "side-band-64k"})
+	// receive-pack's output arrives in arbitrary pieces
+	for _, b := range resp.Bytes() {
+		out.Write([]byte{b})
+	}
+	out.Printf("Deploying %d service(s):", 1)
+	out.Close()
+
+	if text := sidebandText(t, got.Bytes()); text != "Deploying 1 service(s):\n" {
+		t.Errorf("messages = %q", text)
+	}
+	if !bytes.HasPrefix(got.Bytes(), resp.Bytes()[:resp.Len()-4]) {
+		t.Errorf("response = %q, want receive-pack's output first", got.Bytes())
+	}
+
+	// Without side-band there is nowhere to put messages
+	got.Reset()
+	out = newFeedbackWriter(&got, []string{"report-status"})
+	out.Write(report.Bytes())
+	out.Printf("dropped")
+	out.Close()
+	if !bytes.Equal(got.Bytes(), report.Bytes()) {
+		t.Errorf("response = %q, want %q", got.Bytes(), report.Bytes())
+	}
+}
+
+func TestFollowDeploys(t *testing.T) {
+	failure := "build failed: exit status 1"
+	statuses := map[string][]deploymentsdb.Deployment{
+		"d1": {{Status: "queued"}, {Status: "building"}, {Status: "building"}, {Status: "active"}},
+		"d2": {{Status: "building"}, {Status: "failed", ErrorMessage: &failure}},
+	}
+	getDeployment := func(_ context.Context, id string) (*deploymentsdb.Deployment, error) {
+		dep := statuses[id][0]
+		if len(statuses[id]) > 1 {
+			statuses[id] = statuses[id][1:]
+		}
+		return &dep, nil
+	}
+	serviceURL := func(_ context.Context, serviceID string) string {
+		return "https://" + serviceID + ".ml.ink"
+	}
+
+	var got bytes.Buffer
+	out := newFeedbackWriter(&got, []string{"side-band-64k"})
+	out.Write([]byte("0000"))
+	deploys := []triggeredDeploy{
+		{ServiceID: "svc1", Service: "web", DeploymentID: "d1"},
+		{ServiceID: "svc2", Service: "api", DeploymentID: "d2"},
+	}
+	followDeploys(context.Background(), out, deploys, getDeployment, serviceURL, time.Millisecond, time.Minute)
+	out.Close()
+
+	want := "web: queued\napi: building\nweb: building\napi: failed: build failed: exit status 1\nweb: active at https://svc1.ml.ink\n"
+	if text := sidebandText(t, got.Bytes()); text != want {
+		t.Errorf("messages = %q, want %q", text, want)
+	}
+}
+
+// TestPushWithOptions checks that push options reach the handler intact
+// and receive-pack still accepts the push.
+func TestPushWithOptions(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	root := t.TempDir()
+	s, err := NewServer(Config{ReposRoot: root, AdminToken: "secret"}, nil, nil, fakeServicesQ{}, fakeInternalReposQ{}, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	work := t.TempDir()
+	git(t, work, "init", "--quiet", "--initial-branch", "main")
+	if err := os.WriteFile(filepath.Join(work, "index.html"), []byte("<h1>hi</h1>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "hi")
+
+	remote := strings.Replace(srv.URL, "http://", "http://x-admin-token:secret@", 1) + "/alice/app.git"
+	cmd := exec.Command("git", "push", "-o", pushWaitOption, remote, "main")
+	cmd.Dir = work
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("push with options failed: %v\n%s", err, out)
+	}
+	if head := git(t, filepath.Join(root, "alice", "app.git"), "rev-parse", "main"); head != git(t, work, "rev-parse", "HEAD") {
+		t.Errorf("main = %s after push", head)
+	}
+}
@@ -1,10 +1,14 @@
 package gitserver
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"os/exec"
+	"slices"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
@@ -48,7 +52,7 @@ func (s *Server) handleInfoRefs(w http.ResponseWriter, r *http.Request) {
 
 	// service is "git-receive-pack" or "git-upload-pack"; git expects "receive-pack"/"upload-pack"
 	gitCmd := strings.TrimPrefix(service, "git-")
-	cmd := exec.CommandContext(r.Context(), "git", gitCmd, "--stateless-rpc", "--advertise-refs", repoPath)
+	cmd := exec.CommandContext(r.Context(), "git", append(gitConfigArgs(gitCmd), gitCmd, "--stateless-rpc", "--advertise-refs", repoPath)...)
 	out, err := cmd.Output()
 	if err != nil {
 		s.logger.Error("git advertise-refs failed", "service", service, "error", err)
@@ -69,6 +73,45 @@ func (s *Server) handleInfoRefs(w http.ResponseWriter, r *http.Request) {
 	w.Write(out)
 }
 
+// gitConfigArgs returns the -c overrides for a git service. Deploys of a
+// pinned commit fetch it by SHA, which upload-pack refuses unless the
+// object is reachable from a ref and this is allowed. Pushes may pass
+// options such as -o wait.
+func gitConfigArgs(gitCmd string) []string {
+	switch gitCmd {
+	case "upload-pack":
+		return []string{"-c", "uploadpack.allowReachableSHA1InWant=true"}
+	case "receive-pack":
+		return []string{"-c", "receive.advertisePushOptions=true"}
+	}
+	return nil
+}
+
+// maxRejectedPushDrain bounds how much of a rejected push is read so the
+// client sees the rejection; past it the connection is dropped instead.
+const maxRejectedPushDrain = 1 << 30
+
+// receivePackCommand runs receive-pack with the pre-receive hook that
+// enforces the file size limit and scans for secrets. config adds -c
+// overrides; SSH sessions aren't stateless and run it without
+// --stateless-rpc.
+func (s *Server) receivePackCommand(ctx context.Context, repoPath string, hook hookConfig, stateless bool, config ...string) *exec.Cmd {
+	args := gitConfigArgs("receive-pack")
+	if s.hooksPath != "" {
+		args = append(args, "-c", "core.hooksPath="+s.hooksPath)
+	}
+	for _, c := range config {
+		args = append(args, "-c", c)
+	}
+	args = append(args, "receive-pack")
+	if stateless {
+		args = append(args, "--stateless-rpc")
+	}
+	cmd := exec.CommandContext(ctx, "git", append(args, repoPath)...)
+	cmd.Env = append(os.Environ(), hook.env()...)
+	return cmd
+}
+
 // handleUploadPack handles POST /{owner}/{repo}.git/git-upload-pack (clone/fetch)
 func (s *Server) handleUploadPack(w http.ResponseWriter, r *http.Request) {
 	owner := chi.URLParam(r, "owner")
@@ -85,7 +128,7 @@ func (s *Server) handleUploadPack(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/x-git-upload-pack-result")
 	w.Header().Set("Cache-Control", "no-cache")
 
-	cmd := exec.CommandContext(r.Context(), "git", "upload-pack", "--stateless-rpc", repoPath)
+	cmd := exec.CommandContext(r.Context(), "git", append(gitConfigArgs("upload-pack"), "upload-pack", "--stateless-rpc", repoPath)...)
 	cmd.Stdin = r.Body
 	cmd.Stdout = w
 	cmd.Stderr = io.Discard
@@ -115,6 +158,52 @@ func (s *Server) handleReceivePack(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The ref updates come first; read them so an over-quota push can be
+	// rejected before git sees the pack.
+	push, err := readPushRequest(r.Body)
+	if err != nil {
+		http.Error(w, "invalid push request", http.StatusBadRequest)
+		return
+	}
+	stdin := io.MultiReader(bytes.NewReader(push.Header), r.Body)
+
+	if pushHasPack(push.Commands) {
+		budget, limited, msg, err := s.pushBudget(owner, repoPath)
+		if err != nil {
+			s.logger.Error("failed to measure repo size", "repo", repoFullName, "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		if limited {
+			pack, err := os.CreateTemp("", "receive-pack-*")
+			if err != nil {
+				s.logger.Error("failed to create pack file", "error", err)
+				http.Error(w, "internal error", http.StatusInternalServerError)
+				return
+			}
+			defer os.Remove(pack.Name())
+			defer pack.Close()
+
+			n, err := io.Copy(pack, io.LimitReader(r.Body, budget+1))
+			if err != nil {
+				s.logger.Warn("failed to read pushed pack", "repo", repoFullName, "error", err)
+				return
+			}
+			if n > budget {
+				s.logger.Info("push rejected over quota", "repo", repoFullName, "budget", budget)
+				// git only reads the response once the whole request is sent
+				io.Copy(io.Discard, io.LimitReader(r.Body, maxRejectedPushDrain))
+				writePushRejection(w, push.Commands, push.Caps, "size limit exceeded", msg)
+				return
+			}
+			if _, err := pack.Seek(0, io.SeekStart); err != nil {
+				http.Error(w, "internal error", http.StatusInternalServerError)
+				return
+			}
+			stdin = io.MultiReader(bytes.NewReader(push.Header), pack)
+		}
+	}
+
 	// Snapshot refs before the push
 	before, err := snapshotRefs(repoPath)
 	if err != nil {
@@ -126,15 +215,44 @@ func (s *Server) handleReceivePack(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/x-git-receive-pack-result")
 	w.Header().Set("Cache-Control", "no-cache")
 
-	cmd := exec.CommandContext(r.Context(), "git", "receive-pack", "--stateless-rpc", repoPath)
-	cmd.Stdin = r.Body
-	cmd.Stdout = w
+	hook := hookConfig{
+		MaxFileSize: s.config.MaxFileSizeMB << 20,
+		SecretScan:  secretScanMode(r.Context(), s.config, s.internalReposQ, repoFullName),
+	}
+	if hook.SecretScan != "" && len(push.Commands) > 0 {
+		findings, err := os.CreateTemp("", "secret-findings-*")
+		if err != nil {
+			s.logger.Error("failed to create findings file", "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		findings.Close()
+		defer os.Remove(findings.Name())
+		hook.FindingsFile = findings.Name()
+	}
+
+	// Deploy messages go out before the end of receive-pack's response
+	out := newFeedbackWriter(w, push.Caps)
+	defer out.Close()
+
+	cmd := s.receivePackCommand(r.Context(), repoPath, hook, true)
+	cmd.Stdin = stdin
+	cmd.Stdout = out
 	cmd.Stderr = io.Discard
 
-	if err := cmd.Run(); err != nil {
+	err = cmd.Run()
+	if hook.FindingsFile != "" {
+		recordSecretFindings(r.Context(), s.internalReposQ, s.logger, repoFullName, auth.UserID, hook.FindingsFile, hook.SecretScan == secretScanAudit)
+	}
+	if err != nil {
 		s.logger.Error("git receive-pack failed", "repo", repoFullName, "error", err)
 		return
 	}
+	// Large pushes are preceded by a probe request with no commands
+	if len(push.Commands) > 0 {
+		go s.recordRepoSize(repoFullName, repoPath)
+		s.startMirror(r.Context(), repoFullName)
+	}
 
 	// Snapshot refs after the push and trigger deploys for changed branches
 	after, err := snapshotRefs(repoPath)
@@ -143,5 +261,10 @@ func (s *Server) handleReceivePack(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.triggerDeploysForPush(r.Context(), repoFullName, before, after)
+	deploys := s.triggerDeploysForPush(r.Context(), repoPath, repoFullName, before, after)
+	wait := slices.Contains(push.Options, pushWaitOption)
+	s.reportDeploys(out, deploys, wait)
+	if wait && len(deploys) > 0 && out.canSend() {
+		followDeploys(r.Context(), out, deploys, s.deployService.GetDeployment, s.serviceURL, pushWaitInterval, pushWaitTimeout)
+	}
 }
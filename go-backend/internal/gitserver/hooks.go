@@ -0,0 +1,231 @@
+package gitserver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Pushes run a pre-receive hook that re-executes the git server binary
+// with preReceiveArg, so the checks are written in Go rather than shell.
+// main calls RunHook before anything else. The server passes the hook its
+// settings through the environment.
+const (
+	preReceiveArg   = "pre-receive-hook"
+	maxFileSizeEnv  = "GITSERVER_MAX_FILE_SIZE"
+	secretScanEnv   = "GITSERVER_SECRET_SCAN"
+	findingsFileEnv = "GITSERVER_SECRET_FINDINGS"
+)
+
+// maxReportedFiles caps how many oversized files a rejection lists.
+const maxReportedFiles = 20
+
+type hookConfig struct {
+	MaxFileSize int64
+	SecretScan  string
+	// FindingsFile receives the secret scan findings as JSON, for the
+	// server to record once the push is done.
+	FindingsFile string
+}
+
+func hookConfigFromEnv() hookConfig {
+	maxFileSize, _ := strconv.ParseInt(os.Getenv(maxFileSizeEnv), 10, 64)
+	return hookConfig{
+		MaxFileSize:  maxFileSize,
+		SecretScan:   os.Getenv(secretScanEnv),
+		FindingsFile: os.Getenv(findingsFileEnv),
+	}
+}
+
+func (c hookConfig) env() []string {
+	return []string{
+		fmt.Sprintf("%s=%d", maxFileSizeEnv, c.MaxFileSize),
+		secretScanEnv + "=" + c.SecretScan,
+		findingsFileEnv + "=" + c.FindingsFile,
+	}
+}
+
+// RunHook runs the git hook the process was started as, if any, and
+// returns its exit code.
+func RunHook(args []string) (code int, ok bool) {
+	if len(args) < 2 || args[1] != preReceiveArg {
+		return 0, false
+	}
+	return preReceive(context.Background(), os.Stdin, os.Stderr, hookConfigFromEnv()), true
+}
+
+// installHooks writes the hook scripts into a new directory, to be used
+// as core.hooksPath for receive-pack.
+func installHooks() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("find executable: %w", err)
+	}
+	dir, err := os.MkdirTemp("", "git-hooks-")
+	if err != nil {
+		return "", err
+	}
+	script := fmt.Sprintf("#!/bin/sh\nexec %s %s\n", shellQuote(exe), preReceiveArg)
+	if err := os.WriteFile(filepath.Join(dir, "pre-receive"), []byte(script), 0o755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// preReceive checks the objects a push adds. It runs inside the bare
+// repo with git's quarantine environment, so the new objects are visible
+// but not yet referenced. Output reaches the pusher as "remote: ..." and
+// a non-zero exit rejects the whole push.
+func preReceive(ctx context.Context, in io.Reader, out io.Writer, cfg hookConfig) int {
+	var tips []string
+	sc := bufio.NewScanner(in)
+	for sc.Scan() {
+		f := strings.Fields(sc.Text())
+		if len(f) == 3 && !isZeroSHA(f[1]) {
+			tips = append(tips, f[1])
+		}
+	}
+	return checkNewObjects(ctx, "", tips, out, cfg)
+}
+
+// checkNewObjects enforces the file size limit and secret scan on the
+// blobs tips add to the repo, writing findings to cfg.FindingsFile. Refs
+// written without receive-pack (source uploads, imports) go through it
+// before they are updated. gitDir may be empty to use the environment's
+// repo. A non-zero result means the update must be rejected.
+func checkNewObjects(ctx context.Context, gitDir string, tips []string, out io.Writer, cfg hookConfig) int {
+	if len(tips) == 0 || (cfg.MaxFileSize <= 0 && cfg.SecretScan == "") {
+		return 0
+	}
+
+	blobs, err := newBlobs(ctx, gitDir, tips)
+	if err != nil {
+		fmt.Fprintf(out, "error: failed to check pushed files: %v\n", err)
+		return 1
+	}
+
+	if big := oversizedFiles(blobs, cfg.MaxFileSize); len(big) > 0 {
+		fmt.Fprintf(out, "error: push rejected: files over the %d MiB limit:\n", cfg.MaxFileSize>>20)
+		for _, f := range big {
+			fmt.Fprintf(out, "error:   %s (%s)\n", f.Path, formatMiB(f.Size))
+		}
+		fmt.Fprintln(out, "error: remove them from the pushed commits (e.g. with git filter-repo) and push again")
+		return 1
+	}
+
+	if cfg.SecretScan == "" {
+		return 0
+	}
+	findings, err := scanBlobs(ctx, gitDir, blobs)
+	if err != nil {
+		fmt.Fprintf(out, "error: failed to scan pushed files: %v\n", err)
+		return 1
+	}
+	if len(findings) == 0 {
+		return 0
+	}
+	if err := writeFindings(cfg.FindingsFile, findings); err != nil {
+		fmt.Fprintf(out, "error: failed to record secret scan findings: %v\n", err)
+		return 1
+	}
+	if cfg.SecretScan == secretScanAudit {
+		fmt.Fprintln(out, "warning: possible secrets found (secret scanning is bypassed for this repository):")
+		printFindings(out, "warning", findings)
+		return 0
+	}
+	fmt.Fprintln(out, "error: push rejected: possible secrets found:")
+	printFindings(out, "error", findings)
+	fmt.Fprintln(out, "error: remove them from the pushed commits and push again; use environment variables for secrets")
+	return 1
+}
+
+type pushedFile struct {
+	SHA  string
+	Path string
+	Size int64
+}
+
+// newBlobs lists the blobs reachable from tips but not from any existing
+// ref. The staging refs of uploads and imports don't count as existing.
+// gitDir may be empty to use the environment's repo.
+func newBlobs(ctx context.Context, gitDir string, tips []string) ([]pushedFile, error) {
+	args := append(append([]string{"rev-list", "--objects"}, tips...),
+		"--not", "--exclude="+uploadRefPrefix+"*", "--exclude="+importRefPrefix+"*", "--all")
+	revList := exec.CommandContext(ctx, "git", gitArgs(gitDir, args...)...)
+	catFile := exec.CommandContext(ctx, "git", gitArgs(gitDir, "cat-file", "--batch-check=%(objecttype) %(objectname) %(objectsize) %(rest)")...)
+
+	pipe, err := revList.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	catFile.Stdin = pipe
+	objects, err := catFile.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := revList.Start(); err != nil {
+		return nil, fmt.Errorf("git rev-list: %w", err)
+	}
+	if err := catFile.Start(); err != nil {
+		revList.Wait()
+		return nil, fmt.Errorf("git cat-file: %w", err)
+	}
+
+	var blobs []pushedFile
+	sc := bufio.NewScanner(objects)
+	for sc.Scan() {
+		f := strings.SplitN(sc.Text(), " ", 4)
+		if len(f) < 3 || f[0] != "blob" {
+			continue
+		}
+		size, err := strconv.ParseInt(f[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		blob := pushedFile{SHA: f[1], Size: size}
+		if len(f) == 4 {
+			blob.Path = f[3]
+		}
+		blobs = append(blobs, blob)
+	}
+	if err := revList.Wait(); err != nil {
+		catFile.Wait()
+		return nil, fmt.Errorf("git rev-list: %w", err)
+	}
+	if err := catFile.Wait(); err != nil {
+		return nil, fmt.Errorf("git cat-file: %w", err)
+	}
+	return blobs, nil
+}
+
+// oversizedFiles returns the blobs larger than limit. A limit of zero
+// allows any size.
+func oversizedFiles(blobs []pushedFile, limit int64) []pushedFile {
+	if limit <= 0 {
+		return nil
+	}
+	var files []pushedFile
+	for _, b := range blobs {
+		if b.Size > limit && len(files) < maxReportedFiles {
+			files = append(files, b)
+		}
+	}
+	return files
+}
+
+func gitArgs(gitDir string, args ...string) []string {
+	if gitDir == "" {
+		return args
+	}
+	return append([]string{"--git-dir", gitDir}, args...)
+}
@@ -0,0 +1,125 @@
+package gitserver
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The pre-receive hook re-executes the current binary, which in tests is
+// the test binary.
+func TestMain(m *testing.M) {
+	if code, ok := RunHook(os.Args); ok {
+		os.Exit(code)
+	}
+	os.Exit(m.Run())
+}
+
+func TestPreReceiveFileSizeLimit(t *testing.T) {
+	bare, _, _ := testRepo(t)
+	hooks, err := installHooks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(hooks)
+
+	work := t.TempDir()
+	git(t, work, "clone", "--quiet", bare, ".")
+	push := func() (string, error) {
+		cmd := exec.Command("git", "push", "--receive-pack=git -c core.hooksPath="+hooks+" receive-pack", "origin", "main")
+		cmd.Dir = work
+		cmd.Env = append(os.Environ(), maxFileSizeEnv+"=1024")
+		out, err := cmd.CombinedOutput()
+		return string(out), err
+	}
+
+	if err := os.WriteFile(filepath.Join(work, "small.txt"), []byte("ok\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "small")
+	if out, err := push(); err != nil {
+		t.Fatalf("small push rejected: %v\n%s", err, out)
+	}
+
+	if err := os.MkdirAll(filepath.Join(work, "assets"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(work, "assets", "big.bin"), []byte(strings.Repeat("x", 4096)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "big")
+	out, err := push()
+	if err == nil {
+		t.Fatalf("push with a large file succeeded:\n%s", out)
+	}
+	if !strings.Contains(out, "assets/big.bin (0.0 MiB)") || !strings.Contains(out, "pre-receive hook declined") {
+		t.Errorf("push output:\n%s", out)
+	}
+}
+
+func TestNewBlobs_OnlyNewObjects(t *testing.T) {
+	bare, _, second := testRepo(t)
+	// Everything is already reachable from a ref, so nothing is new
+	blobs, err := newBlobs(context.Background(), bare, []string{second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blobs) != 0 {
+		t.Errorf("blobs = %v, want none", blobs)
+	}
+}
+
+func TestPreReceiveSecretScan(t *testing.T) {
+	bare, _, _ := testRepo(t)
+	hooks, err := installHooks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(hooks)
+
+	work := t.TempDir()
+	git(t, work, "clone", "--quiet", bare, ".")
+	env := "AWS_REGION=us-east-1\nAWS_ACCESS_KEY_ID=AKIA" + "Q3EGRDJ5XJ7N2ZTB\n"
+	if err := os.WriteFile(filepath.Join(work, ".env"), []byte(env), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "add env")
+
+	findings := filepath.Join(t.TempDir(), "findings.json")
+	push := func(mode string) (string, error) {
+		cmd := exec.Command("git", "push", "--receive-pack=git -c core.hooksPath="+hooks+" receive-pack", "origin", "main")
+		cmd.Dir = work
+		cmd.Env = append(os.Environ(), hookConfig{SecretScan: mode, FindingsFile: findings}.env()...)
+		out, err := cmd.CombinedOutput()
+		return string(out), err
+	}
+
+	out, err := push(secretScanEnforce)
+	if err == nil {
+		t.Fatalf("push with a secret succeeded:\n%s", out)
+	}
+	if !strings.Contains(out, ".env:2 (aws-access-key-id)") || !strings.Contains(out, "pre-receive hook declined") {
+		t.Errorf("push output:\n%s", out)
+	}
+	data, err := os.ReadFile(findings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"path":".env","line":2`) {
+		t.Errorf("findings = %s", data)
+	}
+
+	out, err = push(secretScanAudit)
+	if err != nil {
+		t.Fatalf("push to a bypassed repo rejected: %v\n%s", err, out)
+	}
+	if !strings.Contains(out, "warning:   .env:2 (aws-access-key-id)") {
+		t.Errorf("push output:\n%s", out)
+	}
+}
@@ -0,0 +1,189 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/githubapp"
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/githubcreds"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// TaskQueue is served by the git server itself, since the bare repos
+// only exist on its volume.
+const TaskQueue = internalgit.GitServerTaskQueue
+
+type Activities struct {
+	config         Config
+	internalReposQ internalrepos.Querier
+	githubCredsQ   githubcreds.Querier
+	githubApp      *githubapp.Service
+	logger         *slog.Logger
+}
+
+func NewActivities(config Config, internalReposQ internalrepos.Querier, githubCredsQ githubcreds.Querier, githubApp *githubapp.Service, logger *slog.Logger) *Activities {
+	return &Activities{
+		config:         config,
+		internalReposQ: internalReposQ,
+		githubCredsQ:   githubCredsQ,
+		githubApp:      githubApp,
+		logger:         logger,
+	}
+}
+
+type MaintainReposInput struct{}
+
+type MaintainReposResult struct {
+	Repos          int
+	Failed         int
+	BytesReclaimed int64
+}
+
+type MaintainRepoResult struct {
+	SizeBefore int64
+	SizeAfter  int64
+}
+
+// MaintainReposWorkflow runs on a cron schedule and repacks every bare
+// repo with git gc, then records its size. A repo that fails is skipped
+// until the next run.
+func MaintainReposWorkflow(ctx workflow.Context, input MaintainReposInput) (MaintainReposResult, error) {
+	logger := workflow.GetLogger(ctx)
+
+	listCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	})
+	gcCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Minute,
+			BackoffCoefficient: 2.0,
+			MaximumAttempts:    2,
+		},
+	})
+
+	var activities *Activities
+	var repos []string
+	if err := workflow.ExecuteActivity(listCtx, activities.ListBareRepos).Get(ctx, &repos); err != nil {
+		return MaintainReposResult{}, err
+	}
+
+	var result MaintainReposResult
+	for _, repo := range repos {
+		var maintained MaintainRepoResult
+		if err := workflow.ExecuteActivity(gcCtx, activities.MaintainRepo, repo).Get(ctx, &maintained); err != nil {
+			logger.Warn("Failed to maintain repo", "repo", repo, "error", err)
+			result.Failed++
+			continue
+		}
+		result.Repos++
+		result.BytesReclaimed += maintained.SizeBefore - maintained.SizeAfter
+	}
+
+	workflow.GetMetricsHandler(ctx).Counter("git_maintenance_bytes_reclaimed").Inc(result.BytesReclaimed)
+	logger.Info("Git maintenance finished",
+		"repos", result.Repos,
+		"failed", result.Failed,
+		"bytesReclaimed", result.BytesReclaimed)
+	return result, nil
+}
+
+// ListBareRepos returns the full names (owner/repo) of the bare repos on
+// disk.
+func (a *Activities) ListBareRepos(ctx context.Context) ([]string, error) {
+	owners, err := os.ReadDir(a.config.ReposRoot)
+	if err != nil {
+		return nil, fmt.Errorf("read repos root: %w", err)
+	}
+	repos := []string{}
+	for _, owner := range owners {
+		if !owner.IsDir() {
+			continue
+		}
+		entries, err := os.ReadDir(filepath.Join(a.config.ReposRoot, owner.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			name, ok := strings.CutSuffix(e.Name(), ".git")
+			if ok && e.IsDir() {
+				repos = append(repos, owner.Name()+"/"+name)
+			}
+		}
+	}
+	return repos, nil
+}
+
+// MaintainRepo runs git gc on one repo and records its size afterwards.
+// gc is safe to run alongside pushes: it only prunes loose objects older
+// than two weeks.
+func (a *Activities) MaintainRepo(ctx context.Context, repoFullName string) (MaintainRepoResult, error) {
+	owner, repo, _ := strings.Cut(repoFullName, "/")
+	repoPath := barePath(a.config.ReposRoot, owner, repo)
+	if _, err := os.Stat(filepath.Join(repoPath, "HEAD")); err != nil {
+		return MaintainRepoResult{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("repo %s not found", repoFullName), "RepoNotFound", err)
+	}
+
+	before, err := dirSize(repoPath)
+	if err != nil {
+		return MaintainRepoResult{}, err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(20 * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				activity.RecordHeartbeat(ctx)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	if err := gcRepo(ctx, repoPath); err != nil {
+		return MaintainRepoResult{}, err
+	}
+
+	after, err := dirSize(repoPath)
+	if err != nil {
+		return MaintainRepoResult{}, err
+	}
+	if err := a.internalReposQ.UpdateInternalRepoSize(ctx, internalrepos.UpdateInternalRepoSizeParams{
+		FullName:  repoFullName,
+		SizeBytes: after,
+	}); err != nil {
+		a.logger.Warn("failed to record repo size", "repo", repoFullName, "error", err)
+	}
+
+	a.logger.Info("maintained repo", "repo", repoFullName, "sizeBefore", before, "sizeAfter", after)
+	return MaintainRepoResult{SizeBefore: before, SizeAfter: after}, nil
+}
+
+func gcRepo(ctx context.Context, repoPath string) error {
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", repoPath, "gc", "--quiet")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git gc: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
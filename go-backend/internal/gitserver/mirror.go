@@ -0,0 +1,363 @@
+package gitserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// mirrorRefspecs copies every branch and tag, and nothing else.
+var mirrorRefspecs = []string{"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"}
+
+// importRefPrefix holds imported branches and tags until they have been
+// scanned like a push.
+const importRefPrefix = "refs/imports/"
+
+var importRefspecs = []string{"+refs/heads/*:" + importRefPrefix + "heads/*", "+refs/tags/*:" + importRefPrefix + "tags/*"}
+
+// ImportRepoWorkflow fills a new internal repo with the branches and tags
+// of a GitHub repo.
+func ImportRepoWorkflow(ctx workflow.Context, input internalgit.ImportRepoInput) (internalgit.ImportRepoResult, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	})
+
+	var activities *Activities
+	var result internalgit.ImportRepoResult
+	err := workflow.ExecuteActivity(ctx, activities.ImportRepo, input).Get(ctx, &result)
+	return result, err
+}
+
+// MirrorRepoWorkflow pushes an internal repo to its GitHub mirror. Each
+// accepted push starts a new run, which replaces any run still retrying.
+func MirrorRepoWorkflow(ctx workflow.Context, input internalgit.MirrorRepoInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Minute,
+			MaximumAttempts:    8,
+		},
+	})
+
+	var activities *Activities
+	return workflow.ExecuteActivity(ctx, activities.MirrorRepo, input).Get(ctx, nil)
+}
+
+// ImportRepo fetches the branches and tags of the GitHub repo into the
+// internal one and points HEAD at GitHub's default branch. It refuses a
+// repo that already has refs, so a retry can't clobber a push.
+func (a *Activities) ImportRepo(ctx context.Context, input internalgit.ImportRepoInput) (internalgit.ImportRepoResult, error) {
+	owner, repo, _ := strings.Cut(input.RepoFullName, "/")
+	repoPath, err := ensureBareRepo(a.config.ReposRoot, owner, repo)
+	if err != nil {
+		return internalgit.ImportRepoResult{}, err
+	}
+	existing, err := bareRefs(ctx, repoPath)
+	if err != nil {
+		return internalgit.ImportRepoResult{}, err
+	}
+	for ref := range existing {
+		// Staging refs are left by an attempt that died; fetched again below
+		if !strings.HasPrefix(ref, importRefPrefix) {
+			return internalgit.ImportRepoResult{}, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("repo %s is not empty", input.RepoFullName), "RepoNotEmpty", nil)
+		}
+	}
+
+	remote, token, err := a.githubRemote(ctx, input.RepoFullName, input.GitHubRepo)
+	if err != nil {
+		return internalgit.ImportRepoResult{}, err
+	}
+	if err := fetchRefs(ctx, repoPath, remote); err != nil {
+		return internalgit.ImportRepoResult{}, redactToken(err, token)
+	}
+	if err := a.checkImport(ctx, input.RepoFullName, repoPath); err != nil {
+		return internalgit.ImportRepoResult{}, err
+	}
+	if err := publishImportedRefs(ctx, repoPath); err != nil {
+		return internalgit.ImportRepoResult{}, err
+	}
+	if head, err := remoteHEAD(ctx, remote); err == nil && head != "" {
+		if err := setHEAD(ctx, repoPath, head); err != nil {
+			return internalgit.ImportRepoResult{}, err
+		}
+	}
+
+	size, err := dirSize(repoPath)
+	if err != nil {
+		return internalgit.ImportRepoResult{}, err
+	}
+	if limit := a.config.MaxRepoSizeMB << 20; limit > 0 && size > limit {
+		os.RemoveAll(repoPath)
+		return internalgit.ImportRepoResult{}, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("%s is %s, over the %d MiB repository size limit", input.GitHubRepo, formatMiB(size), a.config.MaxRepoSizeMB), "RepoTooLarge", nil)
+	}
+	if err := a.internalReposQ.UpdateInternalRepoSize(ctx, internalrepos.UpdateInternalRepoSizeParams{
+		FullName:  input.RepoFullName,
+		SizeBytes: size,
+	}); err != nil {
+		a.logger.Warn("failed to record repo size", "repo", input.RepoFullName, "error", err)
+	}
+
+	refs, err := bareRefs(ctx, repoPath)
+	if err != nil {
+		return internalgit.ImportRepoResult{}, err
+	}
+	result := internalgit.ImportRepoResult{DefaultBranch: headBranch(ctx, repoPath)}
+	for ref := range refs {
+		switch {
+		case strings.HasPrefix(ref, "refs/heads/"):
+			result.Branches++
+		case strings.HasPrefix(ref, "refs/tags/"):
+			result.Tags++
+		}
+	}
+	a.logger.Info("imported repo", "repo", input.RepoFullName, "github", input.GitHubRepo, "branches", result.Branches, "tags", result.Tags)
+	return result, nil
+}
+
+// checkImport runs the push secret scan on the fetched refs, honoring the
+// repo's bypass and recording findings like a push. A rejected import
+// leaves nothing behind.
+func (a *Activities) checkImport(ctx context.Context, repoFullName, repoPath string) error {
+	hook := hookConfig{SecretScan: secretScanMode(ctx, a.config, a.internalReposQ, repoFullName)}
+	if hook.SecretScan == "" {
+		return nil
+	}
+	staged, err := bareRefs(ctx, repoPath, importRefPrefix)
+	if err != nil {
+		return err
+	}
+	var tips []string
+	for _, sha := range staged {
+		tips = append(tips, sha)
+	}
+
+	findings, err := os.CreateTemp("", "secret-findings-*")
+	if err != nil {
+		return fmt.Errorf("create findings file: %w", err)
+	}
+	findings.Close()
+	defer os.Remove(findings.Name())
+	hook.FindingsFile = findings.Name()
+
+	var report bytes.Buffer
+	rejected := checkNewObjects(ctx, repoPath, tips, &report, hook) != 0
+	owner := ""
+	if repo, err := a.internalReposQ.GetInternalRepoByFullName(ctx, repoFullName); err == nil {
+		owner = repo.UserID
+	}
+	recordSecretFindings(ctx, a.internalReposQ, a.logger, repoFullName, owner, hook.FindingsFile, hook.SecretScan == secretScanAudit)
+	if rejected {
+		os.RemoveAll(repoPath)
+		msg := strings.ReplaceAll(strings.TrimSpace(report.String()), "push rejected", "import rejected")
+		return temporal.NewNonRetryableApplicationError(msg, "ImportRejected", nil)
+	}
+	return nil
+}
+
+// MirrorRepo force pushes every branch and tag to the repo's GitHub
+// mirror and deletes the ones that are gone. The outcome is recorded on
+// the repo.
+func (a *Activities) MirrorRepo(ctx context.Context, input internalgit.MirrorRepoInput) error {
+	internalRepo, err := a.internalReposQ.GetInternalRepoByFullName(ctx, input.RepoFullName)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("repo %s not found", input.RepoFullName), "RepoNotFound", err)
+	}
+	if internalRepo.MirrorGithubRepo == nil {
+		return nil
+	}
+
+	err = a.mirrorRepo(ctx, internalRepo.FullName, *internalRepo.MirrorGithubRepo)
+	if err != nil {
+		msg := err.Error()
+		if recErr := a.internalReposQ.SetInternalRepoMirrorError(ctx, internalrepos.SetInternalRepoMirrorErrorParams{
+			FullName:    input.RepoFullName,
+			MirrorError: &msg,
+		}); recErr != nil {
+			a.logger.Warn("failed to record mirror error", "repo", input.RepoFullName, "error", recErr)
+		}
+		return err
+	}
+	if err := a.internalReposQ.MarkInternalRepoMirrored(ctx, input.RepoFullName); err != nil {
+		a.logger.Warn("failed to record mirror push", "repo", input.RepoFullName, "error", err)
+	}
+	return nil
+}
+
+func (a *Activities) mirrorRepo(ctx context.Context, repoFullName, githubRepo string) error {
+	owner, repo, _ := strings.Cut(repoFullName, "/")
+	repoPath := barePath(a.config.ReposRoot, owner, repo)
+	// Pruning from an empty repo would delete every branch on GitHub
+	if refs, _ := bareRefs(ctx, repoPath); len(refs) == 0 {
+		return nil
+	}
+
+	remote, token, err := a.githubRemote(ctx, repoFullName, githubRepo)
+	if err != nil {
+		return err
+	}
+	if err := pushMirror(ctx, repoPath, remote); err != nil {
+		return redactToken(err, token)
+	}
+	a.logger.Info("mirrored repo", "repo", repoFullName, "github", githubRepo)
+	return nil
+}
+
+// githubRemote returns an HTTPS remote for githubRepo authenticated with
+// an installation token of the repo owner's GitHub App installation.
+func (a *Activities) githubRemote(ctx context.Context, repoFullName, githubRepo string) (remote, token string, err error) {
+	if a.githubApp == nil {
+		return "", "", temporal.NewNonRetryableApplicationError("GitHub App is not configured on the git server", "GitHubAppNotConfigured", nil)
+	}
+	internalRepo, err := a.internalReposQ.GetInternalRepoByFullName(ctx, repoFullName)
+	if err != nil {
+		return "", "", temporal.NewNonRetryableApplicationError(fmt.Sprintf("repo %s not found", repoFullName), "RepoNotFound", err)
+	}
+	creds, err := a.githubCredsQ.GetGitHubCredsByUserID(ctx, internalRepo.UserID)
+	if err != nil || creds.GithubAppInstallationID == nil {
+		return "", "", temporal.NewNonRetryableApplicationError("the GitHub App is not installed for this user", "GitHubAppNotInstalled", err)
+	}
+
+	_, name, _ := strings.Cut(githubRepo, "/")
+	tok, err := a.githubApp.CreateInstallationToken(ctx, *creds.GithubAppInstallationID, []string{name})
+	if err != nil {
+		return "", "", fmt.Errorf("create installation token: %w", err)
+	}
+	return fmt.Sprintf("https://x-access-token:%s@github.com/%s.git", tok.Token, githubRepo), tok.Token, nil
+}
+
+// fetchRefs copies the branches and tags of remote into a bare repo under
+// importRefPrefix, where publishImportedRefs picks them up once checked.
+func fetchRefs(ctx context.Context, repoPath, remote string) error {
+	args := append([]string{"--git-dir", repoPath, "fetch", "--quiet", "--no-tags", "--prune", remote}, importRefspecs...)
+	return runRemoteGit(ctx, "git fetch", args...)
+}
+
+// publishImportedRefs moves the refs under importRefPrefix to refs/heads
+// and refs/tags in one transaction.
+func publishImportedRefs(ctx context.Context, repoPath string) error {
+	staged, err := bareRefs(ctx, repoPath, importRefPrefix)
+	if err != nil {
+		return err
+	}
+	var updates strings.Builder
+	for ref, sha := range staged {
+		fmt.Fprintf(&updates, "create refs/%s %s\ndelete %s %s\n", strings.TrimPrefix(ref, importRefPrefix), sha, ref, sha)
+	}
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", repoPath, "update-ref", "--stdin")
+	cmd.Stdin = strings.NewReader(updates.String())
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git update-ref: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// bareRefs maps the refs of a bare repo matching patterns (all of them
+// if none) to the objects they point at; tags are not peeled.
+func bareRefs(ctx context.Context, repoPath string, patterns ...string) (RefSnapshot, error) {
+	out, err := gitRead(ctx, repoPath, append([]string{"for-each-ref", "--format=%(refname) %(objectname)"}, patterns...)...)
+	if err != nil {
+		return nil, err
+	}
+	refs := make(RefSnapshot)
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if ref, sha, ok := strings.Cut(line, " "); ok {
+			refs[ref] = sha
+		}
+	}
+	return refs, nil
+}
+
+// pushMirror makes remote's branches and tags match the bare repo's.
+func pushMirror(ctx context.Context, repoPath, remote string) error {
+	args := append([]string{"--git-dir", repoPath, "push", "--quiet", "--force", "--prune", remote}, mirrorRefspecs...)
+	return runRemoteGit(ctx, "git push", args...)
+}
+
+// remoteHEAD returns the ref remote's HEAD points at, e.g.
+// "refs/heads/main".
+func remoteHEAD(ctx context.Context, remote string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "ls-remote", "--symref", remote, "HEAD")
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git ls-remote: %w", err)
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		if ref, ok := strings.CutPrefix(line, "ref: "); ok {
+			ref, _, _ = strings.Cut(ref, "\t")
+			return ref, nil
+		}
+	}
+	return "", nil
+}
+
+func setHEAD(ctx context.Context, repoPath, ref string) error {
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", repoPath, "symbolic-ref", "HEAD", ref)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git symbolic-ref: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func headBranch(ctx context.Context, repoPath string) string {
+	out, err := exec.CommandContext(ctx, "git", "--git-dir", repoPath, "symbolic-ref", "--short", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func runRemoteGit(ctx context.Context, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %w: %s", name, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// redactToken keeps an installation token out of errors, which end up in
+// workflow history and on the repo.
+func redactToken(err error, token string) error {
+	if err == nil || token == "" || !strings.Contains(err.Error(), token) {
+		return err
+	}
+	return fmt.Errorf("%s", strings.ReplaceAll(err.Error(), token, "***"))
+}
+
+// startMirror pushes the repo to its GitHub mirror, if it has one. A
+// push that arrives while a mirror run is still going replaces it.
+func (s *Server) startMirror(ctx context.Context, repoFullName string) {
+	internalRepo, err := s.internalReposQ.GetInternalRepoByFullName(ctx, repoFullName)
+	if err != nil || internalRepo.MirrorGithubRepo == nil {
+		return
+	}
+	if _, err := s.temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                       "mirror-" + repoFullName,
+		TaskQueue:                TaskQueue,
+		WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+	}, MirrorRepoWorkflow, internalgit.MirrorRepoInput{RepoFullName: repoFullName}); err != nil {
+		s.logger.Error("failed to start mirror push", "repo", repoFullName, "error", err)
+	}
+}
@@ -3,6 +3,7 @@ package gitserver
 import (
 	"fmt"
 	"io"
+	"strconv"
 )
 
 // writePktLine writes a single pkt-line formatted string.
@@ -24,3 +25,65 @@ func writePktFlush(w io.Writer) error {
 func writeServiceAdvertisement(w io.Writer, service string) error {
 	return writePktLine(w, "# service="+service+"\n")
 }
+
+// Side-band channels of a receive-pack response.
+const (
+	sidebandData     = 1
+	sidebandProgress = 2
+)
+
+// readPktLine reads one pkt-line. A flush packet returns flush true and
+// no data.
+func readPktLine(r io.Reader) (data []byte, flush bool, err error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, false, err
+	}
+	n, err := strconv.ParseUint(string(hdr[:]), 16, 16)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid pkt-line length %q", hdr[:])
+	}
+	if n == 0 {
+		return nil, true, nil
+	}
+	if n < 4 {
+		return nil, false, fmt.Errorf("unexpected pkt-line length %d", n)
+	}
+	data = make([]byte, n-4)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, false, err
+	}
+	return data, false, nil
+}
+
+// sidebandMax returns the largest side-band payload the client accepts
+// given its capabilities, or 0 if it didn't ask for side-band.
+func sidebandMax(caps []string) int {
+	for _, c := range caps {
+		if c == "side-band-64k" {
+			return 65515
+		}
+	}
+	for _, c := range caps {
+		if c == "side-band" {
+			return 995
+		}
+	}
+	return 0
+}
+
+// writeSideband writes data on a side-band channel, split into packets
+// of at most max bytes.
+func writeSideband(w io.Writer, band byte, data []byte, max int) error {
+	for len(data) > 0 {
+		n := min(len(data), max)
+		if _, err := fmt.Fprintf(w, "%04x%c", n+5, band); err != nil {
+			return err
+		}
+		if _, err := w.Write(data[:n]); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
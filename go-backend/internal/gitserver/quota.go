@@ -0,0 +1,188 @@
+package gitserver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+)
+
+// pushCommand is one ref update from the start of a receive-pack request.
+type pushCommand struct {
+	OldSHA string
+	NewSHA string
+	Ref    string
+}
+
+// pushRequest is the part of a receive-pack request before the pack.
+type pushRequest struct {
+	Commands []pushCommand
+	Caps     []string
+	// Options are the client's push options (git push -o).
+	Options []string
+	// Header holds the bytes read, to be replayed to git.
+	Header []byte
+}
+
+// readPushRequest reads the ref update commands that start a
+// receive-pack request, up to the flush packet, and the push options that
+// follow them if the client sent any.
+func readPushRequest(r io.Reader) (pushRequest, error) {
+	var req pushRequest
+	var buf bytes.Buffer
+	tee := io.TeeReader(r, &buf)
+	for {
+		data, flush, err := readPktLine(tee)
+		if err != nil {
+			return pushRequest{}, err
+		}
+		if flush {
+			break
+		}
+		line := strings.TrimSuffix(string(data), "\n")
+		if strings.HasPrefix(line, "shallow ") {
+			continue
+		}
+		if cmd, rest, ok := strings.Cut(line, "\x00"); ok {
+			line = cmd
+			req.Caps = strings.Fields(rest)
+		}
+		f := strings.Fields(line)
+		if len(f) != 3 {
+			return pushRequest{}, fmt.Errorf("invalid push command %q", line)
+		}
+		req.Commands = append(req.Commands, pushCommand{OldSHA: f[0], NewSHA: f[1], Ref: f[2]})
+	}
+
+	if slices.Contains(req.Caps, "push-options") {
+		for {
+			data, flush, err := readPktLine(tee)
+			if err != nil {
+				return pushRequest{}, err
+			}
+			if flush {
+				break
+			}
+			req.Options = append(req.Options, strings.TrimSuffix(string(data), "\n"))
+		}
+	}
+	req.Header = buf.Bytes()
+	return req, nil
+}
+
+// pushHasPack reports whether any command creates or moves a ref, which
+// means a pack follows. Delete-only pushes send none.
+func pushHasPack(cmds []pushCommand) bool {
+	for _, c := range cmds {
+		if !isZeroSHA(c.NewSHA) {
+			return true
+		}
+	}
+	return false
+}
+
+func isZeroSHA(sha string) bool {
+	return strings.Trim(sha, "0") == ""
+}
+
+// pushBudget returns how many bytes a push to the repo may send before it
+// goes over the repo or user quota, and the message to reject it with.
+// limited is false when no quota is configured.
+func (s *Server) pushBudget(owner, repoPath string) (budget int64, limited bool, msg string, err error) {
+	if limit := s.config.MaxRepoSizeMB << 20; limit > 0 {
+		size, err := dirSize(repoPath)
+		if err != nil {
+			return 0, false, "", err
+		}
+		budget, limited = limit-size, true
+		msg = fmt.Sprintf("push rejected: the repository would exceed its %d MiB size limit (%s used)", s.config.MaxRepoSizeMB, formatMiB(size))
+	}
+	if limit := s.config.MaxUserSizeMB << 20; limit > 0 {
+		size, err := dirSize(filepath.Join(s.config.ReposRoot, owner))
+		if err != nil {
+			return 0, false, "", err
+		}
+		if !limited || limit-size < budget {
+			budget, limited = limit-size, true
+			msg = fmt.Sprintf("push rejected: your repositories would exceed their %d MiB storage limit (%s used)", s.config.MaxUserSizeMB, formatMiB(size))
+		}
+	}
+	return max(budget, 0), limited, msg, nil
+}
+
+// writePushRejection answers a receive-pack request without running git.
+// Every ref update is rejected with reason, and msg is printed by the
+// client as "remote: ..." when it asked for side-band.
+func writePushRejection(w http.ResponseWriter, cmds []pushCommand, caps []string, reason, msg string) {
+	w.Header().Set("Content-Type", "application/x-git-receive-pack-result")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	var report bytes.Buffer
+	for _, c := range caps {
+		if c == "report-status" || c == "report-status-v2" {
+			writePktLine(&report, "unpack ok\n")
+			for _, cmd := range cmds {
+				writePktLine(&report, "ng "+cmd.Ref+" "+reason+"\n")
+			}
+			writePktFlush(&report)
+			break
+		}
+	}
+
+	max := sidebandMax(caps)
+	if max == 0 {
+		w.Write(report.Bytes())
+		return
+	}
+	writeSideband(w, sidebandProgress, []byte("error: "+msg+"\n"), max)
+	writeSideband(w, sidebandData, report.Bytes(), max)
+	writePktFlush(w)
+}
+
+// recordRepoSize stores the size of a bare repo for the dashboard.
+func (s *Server) recordRepoSize(repoFullName, repoPath string) {
+	size, err := dirSize(repoPath)
+	if err != nil {
+		s.logger.Warn("failed to measure repo size", "repo", repoFullName, "error", err)
+		return
+	}
+	if err := s.internalReposQ.UpdateInternalRepoSize(context.Background(), internalrepos.UpdateInternalRepoSizeParams{
+		FullName:  repoFullName,
+		SizeBytes: size,
+	}); err != nil {
+		s.logger.Warn("failed to record repo size", "repo", repoFullName, "error", err)
+	}
+}
+
+// dirSize returns the total size of the regular files under dir. A
+// missing dir is empty.
+func dirSize(dir string) (int64, error) {
+	var total int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				total += info.Size()
+			}
+		}
+		return nil
+	})
+	return total, err
+}
+
+func formatMiB(n int64) string {
+	return fmt.Sprintf("%.1f MiB", float64(n)/(1<<20))
+}
@@ -0,0 +1,124 @@
+package gitserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+	"github.com/jackc/pgx/v5"
+)
+
+type fakeInternalReposQ struct {
+	internalrepos.Querier
+}
+
+func (fakeInternalReposQ) GetInternalRepoByFullName(context.Context, string) (internalrepos.InternalRepo, error) {
+	return internalrepos.InternalRepo{}, pgx.ErrNoRows
+}
+
+func (fakeInternalReposQ) UpdateInternalRepoSize(context.Context, internalrepos.UpdateInternalRepoSizeParams) error {
+	return nil
+}
+
+func TestReadPushRequest(t *testing.T) {
+	var body bytes.Buffer
+	writePktLine(&body, strings.Repeat("0", 40)+" "+strings.Repeat("a", 40)+" refs/heads/main\x00report-status side-band-64k push-options agent=git/2.43\n")
+	writePktLine(&body, strings.Repeat("b", 40)+" "+strings.Repeat("0", 40)+" refs/heads/old\n")
+	writePktFlush(&body)
+	writePktLine(&body, "wait\n")
+	writePktFlush(&body)
+	header := body.Len()
+	body.WriteString("PACK...")
+
+	r := bytes.NewReader(body.Bytes())
+	push, err := readPushRequest(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []pushCommand{
+		{OldSHA: strings.Repeat("0", 40), NewSHA: strings.Repeat("a", 40), Ref: "refs/heads/main"},
+		{OldSHA: strings.Repeat("b", 40), NewSHA: strings.Repeat("0", 40), Ref: "refs/heads/old"},
+	}
+	if !reflect.DeepEqual(push.Commands, want) {
+		t.Errorf("commands = %+v, want %+v", push.Commands, want)
+	}
+	if !reflect.DeepEqual(push.Caps, []string{"report-status", "side-band-64k", "push-options", "agent=git/2.43"}) {
+		t.Errorf("caps = %v", push.Caps)
+	}
+	if !reflect.DeepEqual(push.Options, []string{"wait"}) {
+		t.Errorf("options = %v", push.Options)
+	}
+	if !bytes.Equal(push.Header, body.Bytes()[:header]) {
+		t.Errorf("header = %q, want the commands and options", push.Header)
+	}
+	if rest, _ := io.ReadAll(r); string(rest) != "PACK..." {
+		t.Errorf("read past the header: rest = %q", rest)
+	}
+	if !pushHasPack(push.Commands) || pushHasPack(push.Commands[1:]) {
+		t.Error("pushHasPack should be true only with a non-delete command")
+	}
+
+	if _, err := readPushRequest(strings.NewReader("0010not a command")); err == nil {
+		t.Error("invalid command accepted")
+	}
+}
+
+// TestPushOverQuota pushes through the HTTP handler with a real git
+// client and checks the rejection it prints.
+func TestPushOverQuota(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	root := t.TempDir()
+	s, err := NewServer(Config{ReposRoot: root, AdminToken: "secret", MaxRepoSizeMB: 1}, nil, nil, nil, fakeInternalReposQ{}, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	work := t.TempDir()
+	git(t, work, "init", "--quiet", "--initial-branch", "main")
+	blob := make([]byte, 2<<20)
+	rand.Read(blob)
+	if err := os.WriteFile(filepath.Join(work, "big.bin"), blob, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "big")
+
+	remote := strings.Replace(srv.URL, "http://", "http://x-admin-token:secret@", 1) + "/alice/app.git"
+	cmd := exec.Command("git", "push", remote, "main")
+	cmd.Dir = work
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("push over quota succeeded:\n%s", out)
+	}
+	for _, want := range []string{"remote: error: push rejected: the repository would exceed its 1 MiB size limit", "[remote rejected] main -> main (size limit exceeded)"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("push output missing %q:\n%s", want, out)
+		}
+	}
+	if refs, err := snapshotRefs(filepath.Join(root, "alice", "app.git")); err != nil || len(refs) != 0 {
+		t.Errorf("refs after rejected push = %v, err = %v", refs, err)
+	}
+}
+
+func TestWritePushRejection_NoSideband(t *testing.T) {
+	w := httptest.NewRecorder()
+	writePushRejection(w, []pushCommand{{Ref: "refs/heads/main"}}, []string{"report-status"}, "size limit exceeded", "too big")
+	want := "000eunpack ok\n002bng refs/heads/main size limit exceeded\n0000"
+	if got := w.Body.String(); got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
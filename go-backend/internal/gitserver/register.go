@@ -0,0 +1,19 @@
+package gitserver
+
+import (
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+)
+
+func RegisterWorkflowsAndActivities(w worker.Worker, activities *Activities) {
+	w.RegisterWorkflow(MaintainReposWorkflow)
+	// internalgit starts these by name
+	w.RegisterWorkflowWithOptions(ImportRepoWorkflow, workflow.RegisterOptions{Name: internalgit.ImportRepoWorkflowName})
+	w.RegisterWorkflowWithOptions(MirrorRepoWorkflow, workflow.RegisterOptions{Name: internalgit.MirrorRepoWorkflowName})
+
+	w.RegisterActivity(activities.ListBareRepos)
+	w.RegisterActivity(activities.MaintainRepo)
+	w.RegisterActivity(activities.ImportRepo)
+	w.RegisterActivity(activities.MirrorRepo)
+}
@@ -1,6 +1,7 @@
 package gitserver
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -30,15 +31,21 @@ func ensureBareRepo(reposRoot, owner, repo string) (string, error) {
 }
 
 // RefSnapshot maps ref names (e.g. "refs/heads/main") to commit SHAs.
+// Annotated tags map to the commit they point at, not the tag object.
 type RefSnapshot map[string]string
 
 // snapshotRefs captures current refs in a bare repo via git for-each-ref.
+// An empty repo yields an empty snapshot; an error means the refs could not
+// be read at all.
 func snapshotRefs(barePath string) (RefSnapshot, error) {
-	cmd := exec.Command("git", "for-each-ref", "--format=%(refname) %(objectname)", barePath)
+	cmd := exec.Command("git", "--git-dir", barePath, "for-each-ref", "--format=%(refname) %(objectname) %(*objectname)")
 	out, err := cmd.Output()
 	if err != nil {
-		// Empty repo has no refs — that's fine
-		return RefSnapshot{}, nil
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("git for-each-ref: %w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("git for-each-ref: %w", err)
 	}
 
 	refs := make(RefSnapshot)
@@ -46,9 +53,13 @@ func snapshotRefs(barePath string) (RefSnapshot, error) {
 		if line == "" {
 			continue
 		}
-		parts := strings.SplitN(line, " ", 2)
-		if len(parts) == 2 {
+		parts := strings.Fields(line)
+		switch len(parts) {
+		case 2:
 			refs[parts[0]] = parts[1]
+		case 3:
+			// Annotated tag: %(*objectname) is the peeled commit
+			refs[parts[0]] = parts[2]
 		}
 	}
 	return refs, nil
@@ -56,36 +67,62 @@ func snapshotRefs(barePath string) (RefSnapshot, error) {
 
 // ChangedRef represents a ref that changed between two snapshots.
 type ChangedRef struct {
-	Name   string // e.g. "refs/heads/main"
-	Branch string // e.g. "main"
-	OldSHA string // empty if new branch
+	Name   string // e.g. "refs/heads/main" or "refs/tags/v1.2.0"
+	Branch string // e.g. "main"; empty for tags
+	Tag    string // e.g. "v1.2.0"; empty for branches
+	OldSHA string // empty if new branch or tag
 	NewSHA string
 }
 
-// diffRefs compares two ref snapshots and returns changed/new branches.
-// Deleted branches (present in before but not after) are ignored — we only
-// trigger deploys for branches that have new commits.
+// diffRefs compares two ref snapshots and returns changed/new branches and
+// tags. Deleted refs (present in before but not after) are ignored — we only
+// trigger deploys for refs that point at new commits.
 func diffRefs(before, after RefSnapshot) []ChangedRef {
 	var changes []ChangedRef
 	for ref, newSHA := range after {
-		if !strings.HasPrefix(ref, "refs/heads/") {
-			continue
-		}
 		oldSHA := before[ref]
 		if oldSHA == newSHA {
 			continue
 		}
-		branch := strings.TrimPrefix(ref, "refs/heads/")
-		changes = append(changes, ChangedRef{
+		change := ChangedRef{
 			Name:   ref,
-			Branch: branch,
 			OldSHA: oldSHA,
 			NewSHA: newSHA,
-		})
+		}
+		switch {
+		case strings.HasPrefix(ref, "refs/heads/"):
+			change.Branch = strings.TrimPrefix(ref, "refs/heads/")
+		case strings.HasPrefix(ref, "refs/tags/"):
+			change.Tag = strings.TrimPrefix(ref, "refs/tags/")
+		default:
+			continue
+		}
+		changes = append(changes, change)
 	}
 	return changes
 }
 
+// changedFiles lists the paths that differ between two commits in a bare
+// repo. A new branch has no old commit to compare against, so it returns
+// nil, meaning unknown.
+func changedFiles(barePath, oldSHA, newSHA string) ([]string, error) {
+	if oldSHA == "" {
+		return nil, nil
+	}
+	cmd := exec.Command("git", "--git-dir", barePath, "diff", "--name-only", "--no-renames", "-z", oldSHA, newSHA)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff %s..%s: %w", oldSHA, newSHA, err)
+	}
+	files := []string{}
+	for _, f := range strings.Split(string(out), "\x00") {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
 // barePath resolves the filesystem path for a bare repo.
 func barePath(reposRoot, owner, repo string) string {
 	return filepath.Join(reposRoot, owner, repo+".git")
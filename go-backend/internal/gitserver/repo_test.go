@@ -0,0 +1,56 @@
+package gitserver
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSnapshotRefs(t *testing.T) {
+	bare, first, second := testRepo(t)
+
+	before, err := snapshotRefs(bare)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before["refs/heads/main"] != second || before["refs/heads/feature"] != first {
+		t.Errorf("snapshot = %v", before)
+	}
+	if before["refs/tags/v1"] != first {
+		t.Errorf("v1 = %s, want the peeled commit %s", before["refs/tags/v1"], first)
+	}
+
+	work := t.TempDir()
+	git(t, work, "clone", "--quiet", bare, ".")
+	git(t, work, "commit", "--quiet", "--allow-empty", "-m", "third")
+	third := git(t, work, "rev-parse", "HEAD")
+	git(t, work, "push", "--quiet", "origin", "main", "HEAD:refs/heads/release")
+
+	after, err := snapshotRefs(bare)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := diffRefs(before, after)
+	got := make(map[string]ChangedRef, len(changes))
+	for _, c := range changes {
+		got[c.Name] = c
+	}
+	if c := got["refs/heads/main"]; len(changes) != 2 || c.OldSHA != second || c.NewSHA != third {
+		t.Errorf("changes = %+v, want main moved to %s and a new release branch", changes, third)
+	}
+	if c := got["refs/heads/release"]; c.Branch != "release" || c.OldSHA != "" || c.NewSHA != third {
+		t.Errorf("release = %+v", c)
+	}
+}
+
+func TestSnapshotRefs_EmptyAndMissing(t *testing.T) {
+	bare, err := ensureBareRepo(t.TempDir(), "alice", "fresh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refs, err := snapshotRefs(bare); err != nil || len(refs) != 0 {
+		t.Errorf("empty repo: refs = %v, err = %v", refs, err)
+	}
+	if _, err := snapshotRefs(filepath.Join(t.TempDir(), "missing.git")); err == nil {
+		t.Error("snapshot of a missing repo succeeded")
+	}
+}
@@ -0,0 +1,200 @@
+package gitserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+	"github.com/lithammer/shortuuid/v4"
+)
+
+// Secret scan modes passed to the pre-receive hook. In audit mode findings
+// are recorded but the push goes through.
+const (
+	secretScanEnforce = "enforce"
+	secretScanAudit   = "audit"
+)
+
+// maxScannedBlobSize skips blobs too large to be hand-written config.
+const maxScannedBlobSize = 1 << 20
+
+// secretRules only match secrets with a distinctive shape, so a rejected
+// push is almost always a real leak.
+var secretRules = []struct {
+	Name    string
+	Pattern *regexp.Regexp
+}{
+	{"private-key", regexp.MustCompile(`-----BEGIN (?:RSA |EC |DSA |OPENSSH |PGP |ENCRYPTED )?PRIVATE KEY(?: BLOCK)?-----`)},
+	{"aws-access-key-id", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"aws-secret-access-key", regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?[A-Za-z0-9/+]{40}\b`)},
+	{"turso-token", regexp.MustCompile(`(?i)(?:turso\w*token["']?\s*[:=]\s*["']?|authToken=)eyJ[\w-]+\.[\w-]+\.[\w-]+`)},
+	{"git-token", regexp.MustCompile(`\bmlg_[\w-]{43}`)},
+	{"api-key", regexp.MustCompile(`\bdk_live_[0-9a-f]{64}\b`)},
+}
+
+type secretFinding struct {
+	Rule    string `json:"rule"`
+	Path    string `json:"path"`
+	Line    int    `json:"line"`
+	BlobSHA string `json:"blobSha"`
+}
+
+// scanBlobs reads the blobs through one git cat-file and returns up to
+// maxReportedFiles findings.
+func scanBlobs(ctx context.Context, gitDir string, blobs []pushedFile) ([]secretFinding, error) {
+	var scan []pushedFile
+	for _, b := range blobs {
+		if b.Size <= maxScannedBlobSize {
+			scan = append(scan, b)
+		}
+	}
+	if len(scan) == 0 {
+		return nil, nil
+	}
+
+	cmd := exec.CommandContext(ctx, "git", gitArgs(gitDir, "cat-file", "--batch")...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("git cat-file: %w", err)
+	}
+	go func() {
+		defer stdin.Close()
+		w := bufio.NewWriter(stdin)
+		for _, b := range scan {
+			fmt.Fprintln(w, b.SHA)
+		}
+		w.Flush()
+	}()
+
+	var findings []secretFinding
+	r := bufio.NewReader(stdout)
+	for _, b := range scan {
+		header, err := r.ReadString('\n')
+		if err != nil {
+			cmd.Wait()
+			return nil, fmt.Errorf("git cat-file: %w", err)
+		}
+		var sha, typ string
+		var size int64
+		if _, err := fmt.Sscanf(header, "%s %s %d", &sha, &typ, &size); err != nil {
+			cmd.Wait()
+			return nil, fmt.Errorf("git cat-file: unexpected output %q", strings.TrimSpace(header))
+		}
+		content := make([]byte, size+1)
+		if _, err := io.ReadFull(r, content); err != nil {
+			cmd.Wait()
+			return nil, fmt.Errorf("git cat-file: %w", err)
+		}
+		if len(findings) >= maxReportedFiles {
+			continue
+		}
+		for _, f := range findSecrets(content[:size]) {
+			f.Path, f.BlobSHA = b.Path, b.SHA
+			findings = append(findings, f)
+		}
+	}
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("git cat-file: %w", err)
+	}
+	return findings[:min(len(findings), maxReportedFiles)], nil
+}
+
+// findSecrets returns the rule and line of each secret in a text file.
+// Binary files are skipped.
+func findSecrets(content []byte) []secretFinding {
+	if bytes.IndexByte(content[:min(len(content), 8000)], 0) >= 0 {
+		return nil
+	}
+	var findings []secretFinding
+	for i, line := range bytes.Split(content, []byte("\n")) {
+		for _, rule := range secretRules {
+			match := rule.Pattern.Find(line)
+			// AWS documents its example keys with an EXAMPLE suffix
+			if match == nil || bytes.Contains(match, []byte("EXAMPLE")) {
+				continue
+			}
+			findings = append(findings, secretFinding{Rule: rule.Name, Line: i + 1})
+		}
+	}
+	return findings
+}
+
+func printFindings(out io.Writer, level string, findings []secretFinding) {
+	for _, f := range findings {
+		fmt.Fprintf(out, "%s:   %s:%d (%s)\n", level, f.Path, f.Line, f.Rule)
+	}
+}
+
+func writeFindings(path string, findings []secretFinding) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.Marshal(findings)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// secretScanMode returns how pushes to the repo are scanned. Repos the
+// server has no record of are scanned like any other.
+func secretScanMode(ctx context.Context, config Config, internalReposQ internalrepos.Querier, repoFullName string) string {
+	if !config.SecretScanning {
+		return ""
+	}
+	repo, err := internalReposQ.GetInternalRepoByFullName(ctx, repoFullName)
+	if err == nil && repo.SecretScanBypass {
+		return secretScanAudit
+	}
+	return secretScanEnforce
+}
+
+// recordSecretFindings stores the findings checkNewObjects wrote to path
+// in the audit table. userID is the pusher, if known.
+func recordSecretFindings(ctx context.Context, internalReposQ internalrepos.Querier, logger *slog.Logger, repoFullName, userID, path string, bypassed bool) {
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) == 0 {
+		return
+	}
+	var findings []secretFinding
+	if err := json.Unmarshal(data, &findings); err != nil {
+		logger.Warn("failed to read secret scan findings", "repo", repoFullName, "error", err)
+		return
+	}
+
+	var pusher *string
+	if userID != "" {
+		pusher = &userID
+	}
+	logger.Warn("secrets found in push", "repo", repoFullName, "findings", len(findings), "bypassed", bypassed)
+	for _, f := range findings {
+		if err := internalReposQ.CreateSecretScanFinding(ctx, internalrepos.CreateSecretScanFindingParams{
+			ID:           shortuuid.New(),
+			RepoFullName: repoFullName,
+			UserID:       pusher,
+			Rule:         f.Rule,
+			Path:         f.Path,
+			Line:         int32(f.Line),
+			BlobSha:      f.BlobSHA,
+			Bypassed:     bypassed,
+		}); err != nil {
+			logger.Error("failed to record secret scan finding", "repo", repoFullName, "error", err)
+		}
+	}
+}
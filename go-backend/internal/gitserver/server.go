@@ -1,40 +1,62 @@
 package gitserver
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
 
 	"github.com/augustdev/autoclip/internal/deployments"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/gittokens"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/sshkeys"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"go.temporal.io/sdk/client"
 )
 
 type Server struct {
-	config        Config
-	gitTokensQ    gittokens.Querier
-	servicesQ     services.Querier
-	deployService *deployments.Service
-	logger        *slog.Logger
-	router        chi.Router
+	config         Config
+	gitTokensQ     gittokens.Querier
+	sshKeysQ       sshkeys.Querier
+	servicesQ      services.Querier
+	internalReposQ internalrepos.Querier
+	deployService  *deployments.Service
+	temporalClient client.Client
+	logger         *slog.Logger
+	router         chi.Router
+	hooksPath      string
 }
 
 func NewServer(
 	config Config,
 	gitTokensQ gittokens.Querier,
+	sshKeysQ sshkeys.Querier,
 	servicesQ services.Querier,
+	internalReposQ internalrepos.Querier,
 	deployService *deployments.Service,
+	temporalClient client.Client,
 	logger *slog.Logger,
-) *Server {
+) (*Server, error) {
 	s := &Server{
-		config:        config,
-		gitTokensQ:    gitTokensQ,
-		servicesQ:     servicesQ,
-		deployService: deployService,
-		logger:        logger,
+		config:         config,
+		gitTokensQ:     gitTokensQ,
+		sshKeysQ:       sshKeysQ,
+		servicesQ:      servicesQ,
+		internalReposQ: internalReposQ,
+		deployService:  deployService,
+		temporalClient: temporalClient,
+		logger:         logger,
 	}
 
+	// Without the pre-receive hook pushes would skip the size limits and
+	// the secret scan, so refuse to serve rather than accept them unchecked.
+	hooksPath, err := installHooks()
+	if err != nil {
+		return nil, fmt.Errorf("install git hooks: %w", err)
+	}
+	s.hooksPath = hooksPath
+
 	r := chi.NewRouter()
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.RealIP)
@@ -49,8 +71,17 @@ func NewServer(
 	r.Post("/{owner}/{repo}.git/git-upload-pack", s.handleUploadPack)
 	r.Post("/{owner}/{repo}.git/git-receive-pack", s.handleReceivePack)
 
+	// Read-only repo browsing, JSON
+	r.Get("/{owner}/{repo}.git/browse/refs", s.handleBrowseRefs)
+	r.Get("/{owner}/{repo}.git/browse/log", s.handleBrowseLog)
+	r.Get("/{owner}/{repo}.git/browse/tree", s.handleBrowseTree)
+	r.Get("/{owner}/{repo}.git/browse/blob", s.handleBrowseBlob)
+
+	// Source upload via a presigned URL, a tarball instead of a push
+	r.Put("/{owner}/{repo}.git/upload", s.handleSourceUpload)
+
 	s.router = r
-	return s
+	return s, nil
 }
 
 func (s *Server) Handler() http.Handler {
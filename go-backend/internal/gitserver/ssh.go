@@ -0,0 +1,395 @@
+package gitserver
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshHandshakeTimeout = 30 * time.Second
+	// Permissions extensions set by the public key callback
+	sshExtUserID = "user-id"
+	sshExtKeyID  = "key-id"
+)
+
+// sshRepoPattern matches the owner/repo path of an SSH git command once
+// the leading slash and .git suffix are removed.
+var sshRepoPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*/[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// ServeSSH accepts git connections over SSH on l until it is closed.
+func (s *Server) ServeSSH(l net.Listener) error {
+	hostKeyPath := s.config.SSHHostKeyPath
+	if hostKeyPath == "" {
+		hostKeyPath = filepath.Join(s.config.ReposRoot, ".ssh_host_ed25519_key")
+	}
+	hostKey, err := loadHostKey(hostKeyPath)
+	if err != nil {
+		return err
+	}
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: s.checkSSHKey,
+		ServerVersion:     "SSH-2.0-git-server",
+	}
+	config.AddHostKey(hostKey)
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handleSSHConn(conn, config)
+	}
+}
+
+// loadHostKey reads the SSH host key at path, generating an ed25519 key
+// there if there is none so clients see the same key across restarts.
+func loadHostKey(path string) (ssh.Signer, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generate ssh host key: %w", err)
+		}
+		block, err := ssh.MarshalPrivateKey(key, "git-server")
+		if err != nil {
+			return nil, fmt.Errorf("marshal ssh host key: %w", err)
+		}
+		data = pem.EncodeToMemory(block)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, fmt.Errorf("mkdir for ssh host key: %w", err)
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			return nil, fmt.Errorf("write ssh host key: %w", err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("read ssh host key: %w", err)
+	}
+	signer, err := ssh.ParsePrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse ssh host key: %w", err)
+	}
+	return signer, nil
+}
+
+// checkSSHKey accepts public keys uploaded by a user. Any SSH user name
+// works; the key alone identifies the user.
+func (s *Server) checkSSHKey(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stored, err := s.sshKeysQ.GetSSHKeyByFingerprint(ctx, ssh.FingerprintSHA256(key))
+	if err != nil {
+		s.logger.Debug("ssh key lookup failed", "error", err)
+		return nil, fmt.Errorf("unknown public key")
+	}
+	return &ssh.Permissions{Extensions: map[string]string{
+		sshExtUserID: stored.UserID,
+		sshExtKeyID:  stored.ID,
+	}}, nil
+}
+
+func (s *Server) handleSSHConn(nConn net.Conn, config *ssh.ServerConfig) {
+	defer nConn.Close()
+	nConn.SetDeadline(time.Now().Add(sshHandshakeTimeout))
+	conn, chans, reqs, err := ssh.NewServerConn(nConn, config)
+	if err != nil {
+		s.logger.Debug("ssh handshake failed", "remote", nConn.RemoteAddr().String(), "error", err)
+		return
+	}
+	defer conn.Close()
+	nConn.SetDeadline(time.Time{})
+	go ssh.DiscardRequests(reqs)
+
+	// The key is only proven once the handshake is done; the callback
+	// also sees keys the client merely offers.
+	userID := conn.Permissions.Extensions[sshExtUserID]
+	keyID := conn.Permissions.Extensions[sshExtKeyID]
+	go func() {
+		if err := s.sshKeysQ.UpdateSSHKeyLastUsed(context.Background(), keyID); err != nil {
+			s.logger.Warn("failed to update ssh key last_used_at", "error", err)
+		}
+	}()
+
+	// Commands are stopped when the client goes away
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		ch, chReqs, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go s.handleSSHSession(ctx, userID, ch, chReqs)
+	}
+}
+
+// handleSSHSession runs the one git command a session execs. Only
+// GIT_PROTOCOL is taken from the client's environment.
+func (s *Server) handleSSHSession(ctx context.Context, userID string, ch ssh.Channel, reqs <-chan *ssh.Request) {
+	defer ch.Close()
+	var env []string
+	for req := range reqs {
+		switch req.Type {
+		case "env":
+			var kv struct{ Name, Value string }
+			ok := ssh.Unmarshal(req.Payload, &kv) == nil && kv.Name == "GIT_PROTOCOL"
+			if ok {
+				env = append(env, "GIT_PROTOCOL="+kv.Value)
+			}
+			req.Reply(ok, nil)
+		case "exec":
+			var payload struct{ Command string }
+			if err := ssh.Unmarshal(req.Payload, &payload); err != nil {
+				req.Reply(false, nil)
+				continue
+			}
+			req.Reply(true, nil)
+			go ssh.DiscardRequests(reqs)
+			sendExitStatus(ch, s.runSSHCommand(ctx, userID, ch, env, payload.Command))
+			return
+		case "shell":
+			req.Reply(true, nil)
+			go ssh.DiscardRequests(reqs)
+			fmt.Fprint(ch.Stderr(), "You've successfully authenticated, but shell access is not provided.\r\n")
+			sendExitStatus(ch, 1)
+			return
+		default:
+			req.Reply(false, nil)
+		}
+	}
+}
+
+func sendExitStatus(ch ssh.Channel, code int) {
+	ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{uint32(code)}))
+}
+
+// parseSSHCommand parses the command git runs over SSH, such as
+// git-upload-pack '/alice/app.git', into the service and repo full name.
+func parseSSHCommand(command string) (service, repoFullName string, err error) {
+	service, path, ok := strings.Cut(command, " ")
+	if !ok || (service != "git-upload-pack" && service != "git-receive-pack") {
+		return "", "", fmt.Errorf("unsupported command %q: only git clone, fetch and push are allowed", command)
+	}
+	path = strings.TrimSpace(path)
+	if unquoted, ok := strings.CutPrefix(path, "'"); ok {
+		path, ok = strings.CutSuffix(unquoted, "'")
+		if !ok {
+			return "", "", fmt.Errorf("invalid repository path")
+		}
+	}
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/")
+	repoFullName = strings.TrimSuffix(path, ".git")
+	if !sshRepoPattern.MatchString(repoFullName) {
+		return "", "", fmt.Errorf("invalid repository path %q, expected owner/repo.git", path)
+	}
+	return service, repoFullName, nil
+}
+
+// runSSHCommand checks the user owns the repo and runs the git service
+// on the session, returning its exit code. Keys are user-wide, so they
+// reach every repo of the user and no other.
+func (s *Server) runSSHCommand(ctx context.Context, userID string, ch ssh.Channel, env []string, command string) int {
+	service, repoFullName, err := parseSSHCommand(command)
+	if err != nil {
+		fmt.Fprintf(ch.Stderr(), "%s\n", err)
+		return 1
+	}
+	internalRepo, err := s.internalReposQ.GetInternalRepoByFullName(ctx, repoFullName)
+	if err != nil || internalRepo.UserID != userID {
+		fmt.Fprintf(ch.Stderr(), "repository %s not found\n", repoFullName)
+		return 1
+	}
+	auth := &AuthResult{
+		UserID:   userID,
+		RepoID:   &internalRepo.ID,
+		Scopes:   []string{"pull", "push"},
+		RepoFull: &internalRepo.FullName,
+	}
+
+	owner, repo, _ := strings.Cut(repoFullName, "/")
+	if service == "git-receive-pack" {
+		return s.sshReceivePack(ctx, ch, env, auth, owner, repo)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", append(gitConfigArgs("upload-pack"), "upload-pack", barePath(s.config.ReposRoot, owner, repo))...)
+	cmd.Env = append(os.Environ(), env...)
+	if err := runOnChannel(cmd, ch, nil); err != nil {
+		s.logger.Error("git upload-pack failed", "repo", repoFullName, "error", err)
+		return exitCode(err)
+	}
+	return 0
+}
+
+// sshReceivePack runs a push like handleReceivePack does. The repo size
+// quota is left to git's receive.maxInputSize, and deploy output goes to
+// stderr once receive-pack is done.
+func (s *Server) sshReceivePack(ctx context.Context, ch ssh.Channel, env []string, auth *AuthResult, owner, repo string) int {
+	repoFullName := owner + "/" + repo
+	repoPath, err := ensureBareRepo(s.config.ReposRoot, owner, repo)
+	if err != nil {
+		s.logger.Error("failed to ensure bare repo", "error", err)
+		fmt.Fprintln(ch.Stderr(), "internal error")
+		return 1
+	}
+
+	var config []string
+	budget, limited, limitMsg, err := s.pushBudget(owner, repoPath)
+	if err != nil {
+		s.logger.Error("failed to measure repo size", "repo", repoFullName, "error", err)
+		fmt.Fprintln(ch.Stderr(), "internal error")
+		return 1
+	}
+	if limited {
+		// Zero means no limit to git
+		config = append(config, fmt.Sprintf("receive.maxInputSize=%d", max(budget, 1)))
+	}
+
+	before, err := snapshotRefs(repoPath)
+	if err != nil {
+		s.logger.Error("failed to snapshot refs before push", "error", err)
+		fmt.Fprintln(ch.Stderr(), "internal error")
+		return 1
+	}
+
+	hook := hookConfig{
+		MaxFileSize: s.config.MaxFileSizeMB << 20,
+		SecretScan:  secretScanMode(ctx, s.config, s.internalReposQ, repoFullName),
+	}
+	if hook.SecretScan != "" {
+		findings, err := os.CreateTemp("", "secret-findings-*")
+		if err != nil {
+			s.logger.Error("failed to create findings file", "error", err)
+			fmt.Fprintln(ch.Stderr(), "internal error")
+			return 1
+		}
+		findings.Close()
+		defer os.Remove(findings.Name())
+		hook.FindingsFile = findings.Name()
+	}
+
+	// The push request is read on its way to git for its commands and
+	// push options.
+	pushes := make(chan pushRequest, 1)
+	in := &countingChannel{Channel: ch}
+	cmd := s.receivePackCommand(ctx, repoPath, hook, false, config...)
+	cmd.Env = append(cmd.Env, env...)
+	err = runOnChannel(cmd, in, func(stdin io.Writer) error {
+		push, err := readPushRequest(in)
+		pushes <- push
+		if err != nil {
+			return err
+		}
+		_, err = stdin.Write(push.Header)
+		return err
+	})
+	var push pushRequest
+	select {
+	case push = <-pushes:
+	default:
+	}
+	if hook.FindingsFile != "" {
+		recordSecretFindings(ctx, s.internalReposQ, s.logger, repoFullName, auth.UserID, hook.FindingsFile, hook.SecretScan == secretScanAudit)
+	}
+	if limited && in.received.Load()-int64(len(push.Header)) > budget {
+		// git's own "pack exceeds maximum allowed size" doesn't say which
+		// quota the push ran into
+		fmt.Fprintln(ch.Stderr(), limitMsg)
+	}
+	if err != nil {
+		s.logger.Error("git receive-pack failed", "repo", repoFullName, "error", err)
+		return exitCode(err)
+	}
+	if len(push.Commands) > 0 {
+		go s.recordRepoSize(repoFullName, repoPath)
+		s.startMirror(ctx, repoFullName)
+	}
+
+	after, err := snapshotRefs(repoPath)
+	if err != nil {
+		s.logger.Error("failed to snapshot refs after push", "repo", repoFullName, "error", err)
+		return 0
+	}
+
+	deploys := s.triggerDeploysForPush(ctx, repoPath, repoFullName, before, after)
+	wait := slices.Contains(push.Options, pushWaitOption)
+	out := stderrPrinter{ch.Stderr()}
+	s.reportDeploys(out, deploys, wait)
+	if wait && len(deploys) > 0 {
+		followDeploys(ctx, out, deploys, s.deployService.GetDeployment, s.serviceURL, pushWaitInterval, pushWaitTimeout)
+	}
+	return 0
+}
+
+// runOnChannel runs cmd with its input and output on the session. The
+// client's input is copied by hand, after prefix if set, so the command
+// isn't held up waiting for a client that never closes it.
+func runOnChannel(cmd *exec.Cmd, ch ssh.Channel, prefix func(stdin io.Writer) error) error {
+	cmd.Stdout = ch
+	cmd.Stderr = ch.Stderr()
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() {
+		defer stdin.Close()
+		if prefix != nil {
+			if err := prefix(stdin); err != nil {
+				return
+			}
+		}
+		io.Copy(stdin, ch)
+	}()
+	return cmd.Wait()
+}
+
+// countingChannel counts the bytes read from the client.
+type countingChannel struct {
+	ssh.Channel
+	received atomic.Int64
+}
+
+func (c *countingChannel) Read(p []byte) (int, error) {
+	n, err := c.Channel.Read(p)
+	c.received.Add(int64(n))
+	return n, err
+}
+
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+		return exitErr.ExitCode()
+	}
+	return 1
+}
+
+// stderrPrinter prints push output on an SSH session's stderr, prefixed
+// like the messages git relays from the server.
+type stderrPrinter struct {
+	w io.Writer
+}
+
+func (p stderrPrinter) Printf(format string, args ...any) {
+	fmt.Fprintf(p.w, "remote: "+format+"\n", args...)
+}
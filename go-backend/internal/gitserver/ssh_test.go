@@ -0,0 +1,198 @@
+package gitserver
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/sshkeys"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/ssh"
+)
+
+type fakeSSHKeysQ struct {
+	sshkeys.Querier
+	keys map[string]sshkeys.SshKey
+}
+
+func (f fakeSSHKeysQ) GetSSHKeyByFingerprint(_ context.Context, fingerprint string) (sshkeys.SshKey, error) {
+	key, ok := f.keys[fingerprint]
+	if !ok {
+		return sshkeys.SshKey{}, pgx.ErrNoRows
+	}
+	return key, nil
+}
+
+func (fakeSSHKeysQ) UpdateSSHKeyLastUsed(context.Context, string) error {
+	return nil
+}
+
+// ownedReposQ knows the repos in it, by full name.
+type ownedReposQ struct {
+	fakeInternalReposQ
+	repos map[string]internalrepos.InternalRepo
+}
+
+func (f ownedReposQ) GetInternalRepoByFullName(_ context.Context, fullName string) (internalrepos.InternalRepo, error) {
+	repo, ok := f.repos[fullName]
+	if !ok {
+		return internalrepos.InternalRepo{}, pgx.ErrNoRows
+	}
+	return repo, nil
+}
+
+func TestParseSSHCommand(t *testing.T) {
+	tests := []struct {
+		command, service, repo string
+		ok                     bool
+	}{
+		{"git-upload-pack '/alice/app.git'", "git-upload-pack", "alice/app", true},
+		{"git-receive-pack 'alice/app.git'", "git-receive-pack", "alice/app", true},
+		{"git-upload-pack '/alice/app'", "git-upload-pack", "alice/app", true},
+		{"git-upload-pack /alice/my-app.v2.git/", "git-upload-pack", "alice/my-app.v2", true},
+		{"git-upload-archive '/alice/app.git'", "", "", false},
+		{"sh -c 'id'", "", "", false},
+		{"git-upload-pack", "", "", false},
+		{"git-upload-pack '/alice/app.git", "", "", false},
+		{"git-upload-pack '/../etc/app.git'", "", "", false},
+		{"git-upload-pack '/alice/../app.git'", "", "", false},
+		{"git-upload-pack '/alice/app/extra.git'", "", "", false},
+		{"git-upload-pack '/alice/.hidden.git'", "", "", false},
+	}
+	for _, tt := range tests {
+		service, repo, err := parseSSHCommand(tt.command)
+		if (err == nil) != tt.ok || service != tt.service || repo != tt.repo {
+			t.Errorf("parseSSHCommand(%q) = %q, %q, %v, want %q, %q, ok %v", tt.command, service, repo, err, tt.service, tt.repo, tt.ok)
+		}
+	}
+}
+
+// startSSHServer serves alice/app and bob/app over SSH and returns the
+// repos root, a remote URL builder and a git runner authenticating as
+// alice.
+func startSSHServer(t *testing.T, cfg Config) (string, func(repo string) string, func(dir string, args ...string) (string, error)) {
+	t.Helper()
+	for _, bin := range []string{"git", "ssh"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skip(bin + " not installed")
+		}
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.ReposRoot = t.TempDir()
+	keysQ := fakeSSHKeysQ{keys: map[string]sshkeys.SshKey{
+		ssh.FingerprintSHA256(sshPub): {ID: "key1", UserID: "alice-id"},
+	}}
+	reposQ := ownedReposQ{repos: map[string]internalrepos.InternalRepo{
+		"alice/app": {ID: "r1", UserID: "alice-id", FullName: "alice/app"},
+		"bob/app":   {ID: "r2", UserID: "bob-id", FullName: "bob/app"},
+	}}
+	s, err := NewServer(cfg, nil, keysQ, fakeServicesQ{}, reposQ, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go s.ServeSSH(l)
+
+	remote := func(repo string) string {
+		return "ssh://git@" + l.Addr().String() + "/" + repo + ".git"
+	}
+	sshGit := func(dir string, args ...string) (string, error) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_SSH_COMMAND=ssh -i "+keyFile+" -o IdentitiesOnly=yes -o BatchMode=yes -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null -o LogLevel=ERROR")
+		out, err := cmd.CombinedOutput()
+		return string(out), err
+	}
+	return cfg.ReposRoot, remote, sshGit
+}
+
+// TestSSHPushAndClone pushes and clones over SSH with real git and ssh
+// clients.
+func TestSSHPushAndClone(t *testing.T) {
+	root, remote, sshGit := startSSHServer(t, Config{})
+
+	work := t.TempDir()
+	git(t, work, "init", "--quiet", "--initial-branch", "main")
+	if err := os.WriteFile(filepath.Join(work, "index.html"), []byte("<h1>hi</h1>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "hi")
+	head := git(t, work, "rev-parse", "HEAD")
+
+	if out, err := sshGit(work, "push", "-o", pushWaitOption, remote("alice/app"), "main"); err != nil {
+		t.Fatalf("push failed: %v\n%s", err, out)
+	}
+	if got := git(t, filepath.Join(root, "alice", "app.git"), "rev-parse", "main"); got != head {
+		t.Errorf("main = %s after push, want %s", got, head)
+	}
+
+	clone := filepath.Join(t.TempDir(), "clone")
+	if out, err := sshGit(work, "clone", "--quiet", "--branch", "main", remote("alice/app"), clone); err != nil {
+		t.Fatalf("clone failed: %v\n%s", err, out)
+	}
+	if got := git(t, clone, "rev-parse", "HEAD"); got != head {
+		t.Errorf("cloned HEAD = %s, want %s", got, head)
+	}
+
+	for _, repo := range []string{"bob/app", "alice/missing"} {
+		out, err := sshGit(work, "push", remote(repo), "main")
+		if err == nil || !strings.Contains(out, "repository "+repo+" not found") {
+			t.Errorf("push to %s: err = %v, output:\n%s", repo, err, out)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "bob")); !os.IsNotExist(err) {
+		t.Errorf("push to another user's repo created it on disk: %v", err)
+	}
+}
+
+func TestSSHPushOverQuota(t *testing.T) {
+	_, remote, sshGit := startSSHServer(t, Config{MaxRepoSizeMB: 1})
+
+	work := t.TempDir()
+	git(t, work, "init", "--quiet", "--initial-branch", "main")
+	big := make([]byte, 2<<20)
+	rand.Read(big)
+	if err := os.WriteFile(filepath.Join(work, "big.bin"), big, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, work, "add", "-A")
+	git(t, work, "commit", "--quiet", "-m", "big")
+
+	out, err := sshGit(work, "push", remote("alice/app"), "main")
+	if err == nil || !strings.Contains(out, "push rejected: the repository would exceed its 1 MiB size limit") {
+		t.Errorf("push over quota: err = %v, output:\n%s", err, out)
+	}
+}
@@ -0,0 +1,371 @@
+package gitserver
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/go-chi/chi/v5"
+)
+
+// uploadRefPrefix holds the commits of uploads until they are checked.
+const uploadRefPrefix = "refs/uploads/"
+
+func tooLarge(format string, args ...any) error {
+	return &statusError{status: http.StatusRequestEntityTooLarge, msg: fmt.Sprintf(format, args...)}
+}
+
+// handleSourceUpload handles PUT /{owner}/{repo}.git/upload?branch=&message=&expires=&sig=
+// The body is a tar.gz (or plain tar) whose contents replace the tree of
+// branch in a new commit. The URL is presigned by internalgit, so no
+// token is needed.
+func (s *Server) handleSourceUpload(w http.ResponseWriter, r *http.Request) {
+	owner := chi.URLParam(r, "owner")
+	repo := chi.URLParam(r, "repo")
+	repoFullName := owner + "/" + repo
+
+	q := r.URL.Query()
+	if err := verifyUploadSignature(s.config.UploadSigningKey, repoFullName, q, time.Now()); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	repoPath, err := ensureBareRepo(s.config.ReposRoot, owner, repo)
+	if err != nil {
+		s.logger.Error("failed to ensure bare repo", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	src, err := os.CreateTemp("", "source-upload-*")
+	if err != nil {
+		s.logger.Error("failed to create upload file", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	defer os.Remove(src.Name())
+	defer src.Close()
+
+	limit := int64(internalgit.MaxSourceUploadSize)
+	limitMsg := fmt.Sprintf("upload is larger than %d MiB", internalgit.MaxSourceUploadSize>>20)
+	budget, limited, msg, err := s.pushBudget(owner, repoPath)
+	if err != nil {
+		s.logger.Error("failed to measure repo size", "repo", repoFullName, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if limited && budget < limit {
+		limit, limitMsg = budget, strings.Replace(msg, "push rejected", "upload rejected", 1)
+	}
+	if _, err := io.Copy(src, http.MaxBytesReader(w, r.Body, limit)); err != nil {
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			http.Error(w, limitMsg, http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "failed to read upload", http.StatusBadRequest)
+		return
+	}
+
+	before, err := snapshotRefs(repoPath)
+	if err != nil {
+		s.logger.Error("failed to snapshot refs before upload", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	hook := hookConfig{
+		MaxFileSize: s.config.MaxFileSizeMB << 20,
+		SecretScan:  secretScanMode(r.Context(), s.config, s.internalReposQ, repoFullName),
+	}
+	if hook.SecretScan != "" {
+		findings, err := os.CreateTemp("", "secret-findings-*")
+		if err != nil {
+			s.logger.Error("failed to create findings file", "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		findings.Close()
+		defer os.Remove(findings.Name())
+		hook.FindingsFile = findings.Name()
+	}
+
+	result, err := commitSource(r.Context(), repoPath, q.Get("branch"), q.Get("message"), hook, src)
+	if hook.FindingsFile != "" {
+		// The upload URL was minted for the repo owner
+		repoOwner := ""
+		if repo, err := s.internalReposQ.GetInternalRepoByFullName(r.Context(), repoFullName); err == nil {
+			repoOwner = repo.UserID
+		}
+		recordSecretFindings(r.Context(), s.internalReposQ, s.logger, repoFullName, repoOwner, hook.FindingsFile, hook.SecretScan == secretScanAudit)
+	}
+	if err != nil || result.Unchanged {
+		s.writeJSONResult(w, r, result, err)
+		return
+	}
+	go s.recordRepoSize(repoFullName, repoPath)
+	s.startMirror(r.Context(), repoFullName)
+	s.logger.Info("committed source upload",
+		"repo", repoFullName,
+		"branch", result.Branch,
+		"commitSHA", result.CommitSHA,
+		"files", result.Files)
+
+	after, err := snapshotRefs(repoPath)
+	if err != nil {
+		s.logger.Error("failed to snapshot refs after upload", "repo", repoFullName, "error", err)
+	} else {
+		s.triggerDeploysForPush(r.Context(), repoPath, repoFullName, before, after)
+	}
+	s.writeJSONResult(w, r, result, nil)
+}
+
+// verifyUploadSignature checks an upload URL signed with
+// internalgit.SignSourceUpload and not yet expired.
+func verifyUploadSignature(key, repoFullName string, q url.Values, now time.Time) error {
+	if key == "" {
+		return fmt.Errorf("source uploads are not enabled")
+	}
+	want := internalgit.SignSourceUpload(key, repoFullName, q)
+	if !hmac.Equal([]byte(q.Get("sig")), []byte(want)) {
+		return fmt.Errorf("invalid upload signature")
+	}
+	expires, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if err != nil || now.Unix() > expires {
+		return fmt.Errorf("upload URL has expired; request a new one")
+	}
+	return nil
+}
+
+// commitSource commits the contents of a tarball to branch of a bare
+// repo as a child of the branch tip, replacing the whole tree. If every
+// entry sits under one top-level directory, that directory becomes the
+// root. Uploads that don't change the tree make no commit. The new
+// files are checked against hook's file size limit and secret scan, as a
+// push would be, before the branch is updated.
+func commitSource(ctx context.Context, repoPath, branch, message string, hook hookConfig, src io.ReadSeeker) (*internalgit.SourceUploadResult, error) {
+	if branch == "" || strings.HasPrefix(branch, "-") ||
+		exec.Command("git", "check-ref-format", "refs/heads/"+branch).Run() != nil {
+		return nil, badRequest("invalid branch name: %q", branch)
+	}
+	if message == "" {
+		message = internalgit.DefaultSourceUploadMessage
+	}
+	ref := "refs/heads/" + branch
+
+	// First pass validates the archive and finds a common top-level
+	// directory; the second streams the files into git.
+	var files []string
+	var total int64
+	err := walkTarball(src, func(name string, hdr *tar.Header, _ io.Reader) error {
+		if hook.MaxFileSize > 0 && hdr.Size > hook.MaxFileSize {
+			return tooLarge("%s is %s, over the %d MiB file size limit", name, formatMiB(hdr.Size), hook.MaxFileSize>>20)
+		}
+		files = append(files, name)
+		total += hdr.Size
+		if len(files) > internalgit.MaxSourceUploadFiles {
+			return tooLarge("archive has more than %d files", internalgit.MaxSourceUploadFiles)
+		}
+		if total > internalgit.MaxSourceUnpackedSize {
+			return tooLarge("archive unpacks to more than %d MiB", internalgit.MaxSourceUnpackedSize>>20)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, badRequest("archive contains no files")
+	}
+	prefix := commonTopDir(files)
+
+	parent := ""
+	if out, err := gitRead(ctx, repoPath, "rev-parse", "--verify", "--quiet", ref+"^{commit}"); err == nil {
+		parent = strings.TrimSpace(string(out))
+	}
+
+	var suffix [8]byte
+	rand.Read(suffix[:])
+	tmpRef := uploadRefPrefix + hex.EncodeToString(suffix[:])
+	defer exec.Command("git", "--git-dir", repoPath, "update-ref", "-d", tmpRef).Run()
+
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if err := fastImport(ctx, repoPath, tmpRef, parent, message, prefix, src); err != nil {
+		return nil, err
+	}
+
+	out, err := gitRead(ctx, repoPath, "rev-parse", "--verify", tmpRef)
+	if err != nil {
+		return nil, err
+	}
+	commit := strings.TrimSpace(string(out))
+	result := &internalgit.SourceUploadResult{Branch: branch, CommitSHA: commit, Files: len(files)}
+
+	if parent != "" {
+		trees, err := gitRead(ctx, repoPath, "rev-parse", commit+"^{tree}", parent+"^{tree}")
+		if err != nil {
+			return nil, err
+		}
+		if t := strings.Fields(string(trees)); len(t) == 2 && t[0] == t[1] {
+			result.CommitSHA = parent
+			result.Unchanged = true
+			return result, nil
+		}
+	}
+
+	var report bytes.Buffer
+	if checkNewObjects(ctx, repoPath, []string{commit}, &report, hook) != 0 {
+		msg := strings.ReplaceAll(strings.TrimSpace(report.String()), "push rejected", "upload rejected")
+		return nil, &statusError{status: http.StatusUnprocessableEntity, msg: msg}
+	}
+
+	// Fails if the branch moved since we read it, e.g. a concurrent push
+	if out, err := exec.CommandContext(ctx, "git", "--git-dir", repoPath, "update-ref", "-m", "source upload", ref, commit, parent).CombinedOutput(); err != nil {
+		return nil, &statusError{status: http.StatusConflict, msg: fmt.Sprintf("branch %s changed during the upload, try again: %s", branch, strings.TrimSpace(string(out)))}
+	}
+
+	// A repo created by an upload has no HEAD yet; point it at the branch
+	// so clones and browsing default to it.
+	if _, err := gitRead(ctx, repoPath, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		exec.Command("git", "--git-dir", repoPath, "symbolic-ref", "HEAD", ref).Run()
+	}
+	return result, nil
+}
+
+// walkTarball calls fn for each file and symlink in a tar or tar.gz with
+// its cleaned path. Directories, .git contents and special files are
+// skipped; paths escaping the root and hard links are rejected.
+func walkTarball(src io.Reader, fn func(name string, hdr *tar.Header, r io.Reader) error) error {
+	br := bufio.NewReader(src)
+	var r io.Reader = br
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return badRequest("invalid gzip data: %v", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return badRequest("invalid tar archive: %v", err)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeReg, tar.TypeSymlink:
+		case tar.TypeLink:
+			return badRequest("hard links are not supported: %s", hdr.Name)
+		default:
+			continue
+		}
+
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "./"))
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return badRequest("archive path escapes the root: %s", hdr.Name)
+		}
+		if name == "." || strings.HasPrefix(name, `"`) || strings.ContainsAny(name, "\n\x00") {
+			return badRequest("unsupported archive path: %q", hdr.Name)
+		}
+		if hasGitDir(name) {
+			continue
+		}
+		if err := fn(name, hdr, tr); err != nil {
+			return err
+		}
+	}
+}
+
+func hasGitDir(name string) bool {
+	for _, part := range strings.Split(name, "/") {
+		if part == ".git" {
+			return true
+		}
+	}
+	return false
+}
+
+// commonTopDir returns "dir/" if every file is under the same top-level
+// directory, as with `tar czf app.tar.gz app/`.
+func commonTopDir(files []string) string {
+	top, _, ok := strings.Cut(files[0], "/")
+	if !ok {
+		return ""
+	}
+	for _, f := range files[1:] {
+		if !strings.HasPrefix(f, top+"/") {
+			return ""
+		}
+	}
+	return top + "/"
+}
+
+// fastImport writes one commit with the tarball's files to ref via git
+// fast-import.
+func fastImport(ctx context.Context, repoPath, ref, parent, message, prefix string, src io.Reader) error {
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", repoPath, "fast-import", "--quiet", "--date-format=raw")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("git fast-import: %w", err)
+	}
+
+	w := bufio.NewWriter(stdin)
+	fmt.Fprintf(w, "commit %s\ncommitter Ink <uploads@ml.ink> %d +0000\ndata %d\n%s\n", ref, time.Now().Unix(), len(message), message)
+	if parent != "" {
+		fmt.Fprintf(w, "from %s\n", parent)
+	}
+	fmt.Fprintf(w, "deleteall\n")
+
+	werr := walkTarball(src, func(name string, hdr *tar.Header, r io.Reader) error {
+		name = strings.TrimPrefix(name, prefix)
+		if hdr.Typeflag == tar.TypeSymlink {
+			fmt.Fprintf(w, "M 120000 inline %s\ndata %d\n%s\n", name, len(hdr.Linkname), hdr.Linkname)
+			return nil
+		}
+		mode := "100644"
+		if hdr.Mode&0o111 != 0 {
+			mode = "100755"
+		}
+		fmt.Fprintf(w, "M %s inline %s\ndata %d\n", mode, name, hdr.Size)
+		if _, err := io.CopyN(w, r, hdr.Size); err != nil {
+			return badRequest("truncated archive entry %s", name)
+		}
+		return w.WriteByte('\n')
+	})
+	if werr == nil {
+		werr = w.Flush()
+	}
+	stdin.Close()
+	if err := cmd.Wait(); err != nil && werr == nil {
+		werr = fmt.Errorf("git fast-import: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return werr
+}
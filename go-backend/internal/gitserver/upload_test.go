@@ -0,0 +1,236 @@
+package gitserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/internalgit"
+)
+
+type tarEntry struct {
+	name, body, link string
+	mode             int64
+	typ              byte
+}
+
+func makeTarball(t *testing.T, entries ...tarEntry) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: e.mode, Typeflag: e.typ, Linkname: e.link, Size: int64(len(e.body))}
+		if hdr.Typeflag == 0 {
+			hdr.Typeflag = tar.TypeReg
+		}
+		if hdr.Mode == 0 {
+			hdr.Mode = 0o644
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			hdr.Size = 0
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(e.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestCommitSource(t *testing.T) {
+	bare, _, second := testRepo(t)
+	ctx := context.Background()
+
+	src := makeTarball(t,
+		tarEntry{name: "app/", typ: tar.TypeDir},
+		tarEntry{name: "app/README.md", body: "uploaded\n"},
+		tarEntry{name: "app/run.sh", body: "#!/bin/sh\n", mode: 0o755},
+		tarEntry{name: "app/docs", typ: tar.TypeSymlink, link: "README.md"},
+		tarEntry{name: "app/.git/config", body: "[core]\n"},
+	)
+	result, err := commitSource(ctx, bare, "main", "deploy from tarball", hookConfig{}, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Unchanged || result.Files != 3 || result.Branch != "main" {
+		t.Errorf("result = %+v", result)
+	}
+
+	log, err := commitLog(ctx, bare, "main", "", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := log.Commits; len(got) != 2 || got[0].SHA != result.CommitSHA || got[0].Subject != "deploy from tarball" || got[1].SHA != second {
+		t.Errorf("log = %+v, want the upload on top of %s", got, second)
+	}
+
+	tree, err := listTree(ctx, bare, "main", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range tree.Entries {
+		names = append(names, e.Path)
+	}
+	if want := []string{"README.md", "docs", "run.sh"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("tree = %v, want %v with app/ stripped and the old files gone", names, want)
+	}
+	if mode := git(t, bare, "ls-tree", "main", "run.sh"); mode[:6] != "100755" {
+		t.Errorf("run.sh = %s, want executable", mode)
+	}
+	if mode := git(t, bare, "ls-tree", "main", "docs"); mode[:6] != "120000" {
+		t.Errorf("docs = %s, want a symlink", mode)
+	}
+
+	src.Seek(0, 0)
+	again, err := commitSource(ctx, bare, "main", "same again", hookConfig{}, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.Unchanged || again.CommitSHA != result.CommitSHA {
+		t.Errorf("second upload = %+v, want unchanged at %s", again, result.CommitSHA)
+	}
+}
+
+func TestCommitSource_EmptyRepo(t *testing.T) {
+	bare, err := ensureBareRepo(t.TempDir(), "alice", "fresh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := commitSource(context.Background(), bare, "prod", "", hookConfig{}, makeTarball(t, tarEntry{name: "index.html", body: "<h1>hi</h1>"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head := git(t, bare, "rev-parse", "HEAD"); head != result.CommitSHA {
+		t.Errorf("HEAD = %s, want the uploaded commit %s", head, result.CommitSHA)
+	}
+	if subject := git(t, bare, "log", "-1", "--format=%s"); subject != internalgit.DefaultSourceUploadMessage {
+		t.Errorf("subject = %q", subject)
+	}
+}
+
+func TestCommitSource_Rejects(t *testing.T) {
+	bare, _, second := testRepo(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		branch  string
+		entries []tarEntry
+		status  int
+	}{
+		{"escaping path", "main", []tarEntry{{name: "../evil", body: "x"}}, http.StatusBadRequest},
+		{"absolute path", "main", []tarEntry{{name: "/etc/passwd", body: "x"}}, http.StatusBadRequest},
+		{"hard link", "main", []tarEntry{{name: "a", body: "x"}, {name: "b", typ: tar.TypeLink, link: "a"}}, http.StatusBadRequest},
+		{"no files", "main", []tarEntry{{name: "dir/", typ: tar.TypeDir}}, http.StatusBadRequest},
+		{"bad branch", "-main", []tarEntry{{name: "a", body: "x"}}, http.StatusBadRequest},
+		{"bad branch", "a..b", []tarEntry{{name: "a", body: "x"}}, http.StatusBadRequest},
+		{"file too large", "main", []tarEntry{{name: "a", body: "x"}, {name: "big.bin", body: "12345"}}, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		_, err := commitSource(ctx, bare, tt.branch, "", hookConfig{MaxFileSize: 4}, makeTarball(t, tt.entries...))
+		if errStatus(err) != tt.status {
+			t.Errorf("%s: error = %v, want status %d", tt.name, err, tt.status)
+		}
+	}
+	if _, err := commitSource(ctx, bare, "main", "", hookConfig{}, bytes.NewReader([]byte("not a tarball"))); errStatus(err) != http.StatusBadRequest {
+		t.Errorf("garbage upload error = %v, want status 400", err)
+	}
+
+	if head := git(t, bare, "rev-parse", "main"); head != second {
+		t.Errorf("main moved to %s after rejected uploads", head)
+	}
+	if refs := git(t, bare, "for-each-ref", "refs/uploads"); refs != "" {
+		t.Errorf("temporary refs left behind: %s", refs)
+	}
+}
+
+func TestCommitSource_SecretScan(t *testing.T) {
+	bare, _, second := testRepo(t)
+	ctx := context.Background()
+	env := tarEntry{name: ".env", body: "AWS_REGION=us-east-1\nAWS_ACCESS_KEY_ID=AKIA" + "Q3EGRDJ5XJ7N2ZTB\n"}
+	findings := filepath.Join(t.TempDir(), "findings.json")
+
+	_, err := commitSource(ctx, bare, "main", "", hookConfig{SecretScan: secretScanEnforce, FindingsFile: findings}, makeTarball(t, env))
+	if errStatus(err) != http.StatusUnprocessableEntity || !strings.Contains(err.Error(), ".env:2 (aws-access-key-id)") {
+		t.Fatalf("upload with a secret: error = %v", err)
+	}
+	if head := git(t, bare, "rev-parse", "main"); head != second {
+		t.Errorf("main moved to %s after a rejected upload", head)
+	}
+	if data, _ := os.ReadFile(findings); !strings.Contains(string(data), `"path":".env","line":2`) {
+		t.Errorf("findings = %s", data)
+	}
+
+	result, err := commitSource(ctx, bare, "main", "", hookConfig{SecretScan: secretScanAudit, FindingsFile: findings}, makeTarball(t, env))
+	if err != nil {
+		t.Fatalf("upload to a bypassed repo rejected: %v", err)
+	}
+	if head := git(t, bare, "rev-parse", "main"); head != result.CommitSHA {
+		t.Errorf("main = %s, want the uploaded commit %s", head, result.CommitSHA)
+	}
+}
+
+func TestCommonTopDir(t *testing.T) {
+	tests := []struct {
+		files []string
+		want  string
+	}{
+		{[]string{"app/a", "app/b/c"}, "app/"},
+		{[]string{"app/a", "other/b"}, ""},
+		{[]string{"a", "b"}, ""},
+		{[]string{"app/a", "app"}, ""},
+		{[]string{"app/a"}, "app/"},
+	}
+	for _, tt := range tests {
+		if got := commonTopDir(tt.files); got != tt.want {
+			t.Errorf("commonTopDir(%v) = %q, want %q", tt.files, got, tt.want)
+		}
+	}
+}
+
+func TestVerifyUploadSignature(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	signed := func(fullName string, expires time.Time) url.Values {
+		q := url.Values{"branch": {"main"}, "message": {"hi"}, "expires": {strconv.FormatInt(expires.Unix(), 10)}}
+		q.Set("sig", internalgit.SignSourceUpload("key", fullName, q))
+		return q
+	}
+
+	if err := verifyUploadSignature("key", "alice/app", signed("alice/app", now.Add(time.Minute)), now); err != nil {
+		t.Errorf("valid URL: %v", err)
+	}
+	if err := verifyUploadSignature("key", "alice/app", signed("alice/app", now.Add(-time.Second)), now); err == nil {
+		t.Error("expired URL accepted")
+	}
+	if err := verifyUploadSignature("key", "bob/app", signed("alice/app", now.Add(time.Minute)), now); err == nil {
+		t.Error("URL accepted for another repo")
+	}
+	tampered := signed("alice/app", now.Add(time.Minute))
+	tampered.Set("branch", "prod")
+	if err := verifyUploadSignature("key", "alice/app", tampered, now); err == nil {
+		t.Error("URL with a changed branch accepted")
+	}
+	if err := verifyUploadSignature("", "alice/app", signed("alice/app", now.Add(time.Minute)), now); err == nil {
+		t.Error("upload accepted without a signing key")
+	}
+}
@@ -23,6 +23,12 @@ type CreateAPIKeyResult struct {
 	Secret string  `json:"secret"`
 }
 
+type CreateGitTokenResult struct {
+	GitToken  *GitToken `json:"gitToken"`
+	Token     string    `json:"token"`
+	GitRemote string    `json:"gitRemote"`
+}
+
 type CreateHostedZoneResult struct {
 	ZoneID     string       `json:"zoneId"`
 	Zone       string       `json:"zone"`
@@ -58,6 +64,16 @@ type EnvVarInput struct {
 	Value string `json:"value"`
 }
 
+type GitToken struct {
+	ID         string     `json:"id"`
+	Prefix     string     `json:"prefix"`
+	Repo       *string    `json:"repo,omitempty"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
 type HostedZone struct {
 	ID         string        `json:"id"`
 	Zone       string        `json:"zone"`
@@ -106,6 +122,73 @@ type ProjectConnection struct {
 type Query struct {
 }
 
+type RedeployServiceResult struct {
+	ServiceID  string `json:"serviceId"`
+	Name       string `json:"name"`
+	WorkflowID string `json:"workflowId"`
+	Status     string `json:"status"`
+}
+
+type RepoCommit struct {
+	Sha         string    `json:"sha"`
+	AuthorName  string    `json:"authorName"`
+	AuthorEmail string    `json:"authorEmail"`
+	AuthoredAt  time.Time `json:"authoredAt"`
+	Subject     string    `json:"subject"`
+}
+
+type RepoFile struct {
+	Ref       string  `json:"ref"`
+	CommitSha string  `json:"commitSha"`
+	Path      string  `json:"path"`
+	Size      int32   `json:"size"`
+	Binary    bool    `json:"binary"`
+	Content   *string `json:"content,omitempty"`
+}
+
+type RepoLog struct {
+	Ref     string        `json:"ref"`
+	Commits []*RepoCommit `json:"commits"`
+}
+
+type RepoRef struct {
+	Name      string `json:"name"`
+	CommitSha string `json:"commitSha"`
+}
+
+type RepoRefs struct {
+	DefaultBranch *string    `json:"defaultBranch,omitempty"`
+	Branches      []*RepoRef `json:"branches"`
+	Tags          []*RepoRef `json:"tags"`
+	Truncated     bool       `json:"truncated"`
+}
+
+type RepoSettings struct {
+	Repo             string `json:"repo"`
+	SecretScanBypass bool   `json:"secretScanBypass"`
+}
+
+type RepoSize struct {
+	Repo       string     `json:"repo"`
+	SizeBytes  float64    `json:"sizeBytes"`
+	MeasuredAt *time.Time `json:"measuredAt,omitempty"`
+}
+
+type RepoTree struct {
+	Ref       string           `json:"ref"`
+	CommitSha string           `json:"commitSha"`
+	Path      string           `json:"path"`
+	Entries   []*RepoTreeEntry `json:"entries"`
+	Truncated bool             `json:"truncated"`
+}
+
+type RepoTreeEntry struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size *int32 `json:"size,omitempty"`
+}
+
 type Resource struct {
 	ID        string            `json:"id"`
 	Name      string            `json:"name"`
@@ -132,26 +215,45 @@ type ResourceMetadata struct {
 	Group    *string `json:"group,omitempty"`
 }
 
+type SSHKey struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Fingerprint string     `json:"fingerprint"`
+	PublicKey   string     `json:"publicKey"`
+	LastUsedAt  *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
 type Service struct {
-	ID                 string    `json:"id"`
-	ProjectID          string    `json:"projectId"`
-	Project            *Project  `json:"project,omitempty"`
-	Name               *string   `json:"name,omitempty"`
-	Repo               string    `json:"repo"`
-	Branch             string    `json:"branch"`
-	Status             string    `json:"status"`
-	ErrorMessage       *string   `json:"errorMessage,omitempty"`
-	EnvVars            []*EnvVar `json:"envVars"`
-	Fqdn               *string   `json:"fqdn,omitempty"`
-	Port               string    `json:"port"`
-	GitProvider        string    `json:"gitProvider"`
-	CommitHash         *string   `json:"commitHash,omitempty"`
-	Memory             string    `json:"memory"`
-	Vcpus              string    `json:"vcpus"`
-	CustomDomain       *string   `json:"customDomain,omitempty"`
-	CustomDomainStatus *string   `json:"customDomainStatus,omitempty"`
-	CreatedAt          time.Time `json:"createdAt"`
-	UpdatedAt          time.Time `json:"updatedAt"`
+	ID                 string     `json:"id"`
+	ProjectID          string     `json:"projectId"`
+	Project            *Project   `json:"project,omitempty"`
+	Name               *string    `json:"name,omitempty"`
+	Repo               string     `json:"repo"`
+	Branch             string     `json:"branch"`
+	Status             string     `json:"status"`
+	ErrorMessage       *string    `json:"errorMessage,omitempty"`
+	EnvVars            []*EnvVar  `json:"envVars"`
+	Fqdn               *string    `json:"fqdn,omitempty"`
+	Port               string     `json:"port"`
+	GitProvider        string     `json:"gitProvider"`
+	Image              *string    `json:"image,omitempty"`
+	CommitHash         *string    `json:"commitHash,omitempty"`
+	Memory             string     `json:"memory"`
+	Vcpus              string     `json:"vcpus"`
+	CustomDomain       *string    `json:"customDomain,omitempty"`
+	CustomDomainStatus *string    `json:"customDomainStatus,omitempty"`
+	SleepAfterIdle     *string    `json:"sleepAfterIdle,omitempty"`
+	DeployPolicy       string     `json:"deployPolicy"`
+	DeployDebounce     *string    `json:"deployDebounce,omitempty"`
+	WatchPaths         []string   `json:"watchPaths"`
+	TagPattern         *string    `json:"tagPattern,omitempty"`
+	DockerfileTarget   *string    `json:"dockerfileTarget,omitempty"`
+	BuildContext       *string    `json:"buildContext,omitempty"`
+	SleepingSince      *time.Time `json:"sleepingSince,omitempty"`
+	StoppedSince       *time.Time `json:"stoppedSince,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
 }
 
 type ServiceConnection struct {
@@ -160,6 +262,14 @@ type ServiceConnection struct {
 	TotalCount int32      `json:"totalCount"`
 }
 
+type ServiceLifecycleResult struct {
+	ServiceID string `json:"serviceId"`
+	Name      string `json:"name"`
+	Action    string `json:"action"`
+	EventID   string `json:"eventId"`
+	Status    string `json:"status"`
+}
+
 type ServiceMetrics struct {
 	CPUUsage                   *MetricSeries `json:"cpuUsage"`
 	MemoryUsageMb              *MetricSeries `json:"memoryUsageMB"`
@@ -185,6 +295,14 @@ type UpdateServiceInput struct {
 	PublishDirectory *string        `json:"publishDirectory,omitempty"`
 	RootDirectory    *string        `json:"rootDirectory,omitempty"`
 	DockerfilePath   *string        `json:"dockerfilePath,omitempty"`
+	SleepAfterIdle   *string        `json:"sleepAfterIdle,omitempty"`
+	DeployPolicy     *string        `json:"deployPolicy,omitempty"`
+	DeployDebounce   *string        `json:"deployDebounce,omitempty"`
+	WatchPaths       []string       `json:"watchPaths,omitempty"`
+	TagPattern       *string        `json:"tagPattern,omitempty"`
+	DockerfileTarget *string        `json:"dockerfileTarget,omitempty"`
+	BuildArgs        []*EnvVarInput `json:"buildArgs,omitempty"`
+	BuildContext     *string        `json:"buildContext,omitempty"`
 }
 
 type UpdateServiceResult struct {
@@ -0,0 +1,226 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.87
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/authz"
+	"github.com/augustdev/autoclip/internal/graph/model"
+	"github.com/augustdev/autoclip/internal/helpers"
+	"github.com/augustdev/autoclip/internal/internalgit"
+)
+
+// SetRepoSecretScanBypass is the resolver for the setRepoSecretScanBypass field.
+func (r *mutationResolver) SetRepoSecretScanBypass(ctx context.Context, repo string, project *string, bypass bool) (*model.RepoSettings, error) {
+	userID := authz.For(ctx).GetUserID()
+	internalRepo, err := r.InternalGitService.SetSecretScanBypass(ctx, userID, repo, helpers.Deref(project), bypass)
+	if err != nil {
+		return nil, err
+	}
+	return &model.RepoSettings{
+		Repo:             internalRepo.FullName,
+		SecretScanBypass: internalRepo.SecretScanBypass,
+	}, nil
+}
+
+// CreateGitToken is the resolver for the createGitToken field.
+func (r *mutationResolver) CreateGitToken(ctx context.Context, repo string, project *string, scopes []string, expiresInHours *int32) (*model.CreateGitTokenResult, error) {
+	userID := authz.For(ctx).GetUserID()
+	ttl := time.Duration(helpers.Deref(expiresInHours)) * time.Hour
+	minted, err := r.InternalGitService.MintToken(ctx, userID, repo, helpers.Deref(project), scopes, ttl)
+	if err != nil {
+		return nil, err
+	}
+	token := minted.GitToken
+	return &model.CreateGitTokenResult{
+		GitToken:  gitTokenToModel(token.ID, token.TokenPrefix, &minted.Repo, token.Scopes, token.ExpiresAt, token.LastUsedAt, token.CreatedAt),
+		Token:     minted.Token,
+		GitRemote: minted.GitRemote,
+	}, nil
+}
+
+// RevokeGitToken is the resolver for the revokeGitToken field.
+func (r *mutationResolver) RevokeGitToken(ctx context.Context, id string) (bool, error) {
+	if err := r.InternalGitService.RevokeToken(ctx, authz.For(ctx).GetUserID(), id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddSSHKey is the resolver for the addSSHKey field.
+func (r *mutationResolver) AddSSHKey(ctx context.Context, name string, publicKey string) (*model.SSHKey, error) {
+	key, err := r.InternalGitService.AddSSHKey(ctx, authz.For(ctx).GetUserID(), name, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return sshKeyToModel(key), nil
+}
+
+// DeleteSSHKey is the resolver for the deleteSSHKey field.
+func (r *mutationResolver) DeleteSSHKey(ctx context.Context, id string) (bool, error) {
+	if err := r.InternalGitService.DeleteSSHKey(ctx, authz.For(ctx).GetUserID(), id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RepoRefs is the resolver for the repoRefs field.
+func (r *queryResolver) RepoRefs(ctx context.Context, repo string, project *string) (*model.RepoRefs, error) {
+	fullName, err := resolveInternalRepo(ctx, r.InternalGitService, repo, project)
+	if err != nil {
+		return nil, err
+	}
+	refs, err := r.InternalGitService.ListRefs(ctx, fullName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list refs: %w", err)
+	}
+
+	out := &model.RepoRefs{
+		Branches:  repoRefsToModel(refs.Branches),
+		Tags:      repoRefsToModel(refs.Tags),
+		Truncated: refs.Truncated,
+	}
+	if refs.DefaultBranch != "" {
+		out.DefaultBranch = &refs.DefaultBranch
+	}
+	return out, nil
+}
+
+// RepoLog is the resolver for the repoLog field.
+func (r *queryResolver) RepoLog(ctx context.Context, repo string, project *string, ref *string, path *string, limit *int32) (*model.RepoLog, error) {
+	fullName, err := resolveInternalRepo(ctx, r.InternalGitService, repo, project)
+	if err != nil {
+		return nil, err
+	}
+	n := 0
+	if limit != nil {
+		n = int(*limit)
+		if n < 1 || n > internalgit.MaxRepoLogLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", internalgit.MaxRepoLogLimit)
+		}
+	}
+	log, err := r.InternalGitService.Log(ctx, fullName, helpers.Deref(ref), helpers.Deref(path), n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log: %w", err)
+	}
+
+	commits := make([]*model.RepoCommit, len(log.Commits))
+	for i, c := range log.Commits {
+		commits[i] = &model.RepoCommit{
+			Sha:         c.SHA,
+			AuthorName:  c.AuthorName,
+			AuthorEmail: c.AuthorEmail,
+			AuthoredAt:  c.AuthoredAt,
+			Subject:     c.Subject,
+		}
+	}
+	return &model.RepoLog{Ref: log.Ref, Commits: commits}, nil
+}
+
+// RepoTree is the resolver for the repoTree field.
+func (r *queryResolver) RepoTree(ctx context.Context, repo string, project *string, ref *string, path *string) (*model.RepoTree, error) {
+	fullName, err := resolveInternalRepo(ctx, r.InternalGitService, repo, project)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := r.InternalGitService.Tree(ctx, fullName, helpers.Deref(ref), helpers.Deref(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tree: %w", err)
+	}
+
+	entries := make([]*model.RepoTreeEntry, len(tree.Entries))
+	for i, e := range tree.Entries {
+		entries[i] = &model.RepoTreeEntry{Name: e.Name, Path: e.Path, Type: e.Type}
+		if e.Size != nil {
+			entries[i].Size = helpers.Ptr(clampInt32(*e.Size))
+		}
+	}
+	return &model.RepoTree{
+		Ref:       tree.Ref,
+		CommitSha: tree.CommitSHA,
+		Path:      tree.Path,
+		Entries:   entries,
+		Truncated: tree.Truncated,
+	}, nil
+}
+
+// RepoFile is the resolver for the repoFile field.
+func (r *queryResolver) RepoFile(ctx context.Context, repo string, project *string, ref *string, path string) (*model.RepoFile, error) {
+	fullName, err := resolveInternalRepo(ctx, r.InternalGitService, repo, project)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+	file, err := r.InternalGitService.ReadFile(ctx, fullName, helpers.Deref(ref), path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	out := &model.RepoFile{
+		Ref:       file.Ref,
+		CommitSha: file.CommitSHA,
+		Path:      file.Path,
+		Size:      clampInt32(file.Size),
+		Binary:    file.Binary,
+	}
+	if !file.Binary {
+		out.Content = &file.Content
+	}
+	return out, nil
+}
+
+// RepoSize is the resolver for the repoSize field.
+func (r *queryResolver) RepoSize(ctx context.Context, repo string, project *string) (*model.RepoSize, error) {
+	userID := authz.For(ctx).GetUserID()
+	internalRepo, err := r.InternalGitService.ResolveRepo(ctx, userID, repo, helpers.Deref(project))
+	if err != nil {
+		return nil, err
+	}
+
+	var measuredAt *time.Time
+	if internalRepo.SizeMeasuredAt.Valid {
+		t := internalRepo.SizeMeasuredAt.Time
+		measuredAt = &t
+	}
+	return &model.RepoSize{
+		Repo:       internalRepo.FullName,
+		SizeBytes:  float64(internalRepo.SizeBytes),
+		MeasuredAt: measuredAt,
+	}, nil
+}
+
+// GitTokens is the resolver for the gitTokens field.
+func (r *queryResolver) GitTokens(ctx context.Context) ([]*model.GitToken, error) {
+	tokens, err := r.InternalGitService.ListTokens(ctx, authz.For(ctx).GetUserID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list git tokens: %w", err)
+	}
+
+	result := make([]*model.GitToken, len(tokens))
+	for i, t := range tokens {
+		result[i] = gitTokenToModel(t.ID, t.TokenPrefix, t.RepoFullName, t.Scopes, t.ExpiresAt, t.LastUsedAt, t.CreatedAt)
+	}
+	return result, nil
+}
+
+// SSHKeys is the resolver for the sshKeys field.
+func (r *queryResolver) SSHKeys(ctx context.Context) ([]*model.SSHKey, error) {
+	keys, err := r.InternalGitService.ListSSHKeys(ctx, authz.For(ctx).GetUserID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ssh keys: %w", err)
+	}
+
+	result := make([]*model.SSHKey, len(keys))
+	for i, k := range keys {
+		result[i] = sshKeyToModel(k)
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/authz"
+	"github.com/augustdev/autoclip/internal/graph/model"
+	"github.com/augustdev/autoclip/internal/helpers"
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/sshkeys"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// resolveInternalRepo returns the full name of the caller's internal repo.
+func resolveInternalRepo(ctx context.Context, svc *internalgit.Service, repo string, project *string) (string, error) {
+	userID := authz.For(ctx).GetUserID()
+	internalRepo, err := svc.ResolveRepo(ctx, userID, repo, helpers.Deref(project))
+	if err != nil {
+		return "", err
+	}
+	return internalRepo.FullName, nil
+}
+
+func repoRefsToModel(refs []internalgit.RepoRef) []*model.RepoRef {
+	out := make([]*model.RepoRef, len(refs))
+	for i, ref := range refs {
+		out[i] = &model.RepoRef{Name: ref.Name, CommitSha: ref.CommitSHA}
+	}
+	return out
+}
+
+func clampInt32(n int64) int32 {
+	return int32(min(n, math.MaxInt32))
+}
+
+func gitTokenToModel(id, prefix string, repo *string, scopes []string, expiresAt, lastUsedAt, createdAt pgtype.Timestamptz) *model.GitToken {
+	return &model.GitToken{
+		ID:         id,
+		Prefix:     prefix,
+		Repo:       repo,
+		Scopes:     scopes,
+		ExpiresAt:  timestampPtr(expiresAt),
+		LastUsedAt: timestampPtr(lastUsedAt),
+		CreatedAt:  createdAt.Time,
+	}
+}
+
+func sshKeyToModel(key sshkeys.SshKey) *model.SSHKey {
+	return &model.SSHKey{
+		ID:          key.ID,
+		Name:        key.Name,
+		Fingerprint: key.Fingerprint,
+		PublicKey:   key.PublicKey,
+		LastUsedAt:  timestampPtr(key.LastUsedAt),
+		CreatedAt:   key.CreatedAt.Time,
+	}
+}
+
+func timestampPtr(ts pgtype.Timestamptz) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	t := ts.Time
+	return &t
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/augustdev/autoclip/internal/deployments"
 	"github.com/augustdev/autoclip/internal/dns"
 	"github.com/augustdev/autoclip/internal/githubapp"
+	"github.com/augustdev/autoclip/internal/internalgit"
 	"github.com/augustdev/autoclip/internal/prometheus"
 	"github.com/augustdev/autoclip/internal/storage/pg"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
@@ -16,15 +17,16 @@ import (
 )
 
 type Resolver struct {
-	Db               *pg.DB
-	Logger           *slog.Logger
-	AuthService      *auth.Service
-	DeployService    *deployments.Service
-	DNSService       *dns.Service
-	GitHubAppService *githubapp.Service
-	ServiceQueries   services.Querier
-	ProjectQueries   projects.Querier
-	ResourceQueries  resources.Querier
-	FirebaseAuth     *firebaseauth.Client
-	PrometheusClient *prometheus.Client
+	Db                 *pg.DB
+	Logger             *slog.Logger
+	AuthService        *auth.Service
+	DeployService      *deployments.Service
+	DNSService         *dns.Service
+	GitHubAppService   *githubapp.Service
+	InternalGitService *internalgit.Service
+	ServiceQueries     services.Querier
+	ProjectQueries     projects.Querier
+	ResourceQueries    resources.Querier
+	FirebaseAuth       *firebaseauth.Client
+	PrometheusClient   *prometheus.Client
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/augustdev/autoclip/internal/authz"
 	"github.com/augustdev/autoclip/internal/deployments"
@@ -65,6 +66,23 @@ func (r *mutationResolver) UpdateService(ctx context.Context, input model.Update
 		PublishDirectory: input.PublishDirectory,
 		RootDirectory:    input.RootDirectory,
 		DockerfilePath:   input.DockerfilePath,
+		SleepAfterIdle:   input.SleepAfterIdle,
+		DeployPolicy:     input.DeployPolicy,
+		DeployDebounce:   input.DeployDebounce,
+	}
+
+	if input.WatchPaths != nil {
+		depInput.WatchPaths = &input.WatchPaths
+	}
+	depInput.TagPattern = input.TagPattern
+	depInput.DockerfileTarget = input.DockerfileTarget
+	depInput.BuildContext = input.BuildContext
+	if input.BuildArgs != nil {
+		buildArgs := make([]deployments.EnvVar, len(input.BuildArgs))
+		for i, ba := range input.BuildArgs {
+			buildArgs[i] = deployments.EnvVar{Key: ba.Key, Value: ba.Value}
+		}
+		depInput.BuildArgs = &buildArgs
 	}
 
 	if input.Port != nil {
@@ -103,6 +121,63 @@ func (r *mutationResolver) UpdateService(ctx context.Context, input model.Update
 	}, nil
 }
 
+// StopService is the resolver for the stopService field.
+func (r *mutationResolver) StopService(ctx context.Context, name string, project *string) (*model.ServiceLifecycleResult, error) {
+	result, err := r.DeployService.StopService(ctx, lifecycleParams(ctx, name, project))
+	if err != nil {
+		return nil, err
+	}
+	return lifecycleResultToModel(result), nil
+}
+
+// StartService is the resolver for the startService field.
+func (r *mutationResolver) StartService(ctx context.Context, name string, project *string) (*model.ServiceLifecycleResult, error) {
+	result, err := r.DeployService.StartService(ctx, lifecycleParams(ctx, name, project))
+	if err != nil {
+		return nil, err
+	}
+	return lifecycleResultToModel(result), nil
+}
+
+// RestartService is the resolver for the restartService field.
+func (r *mutationResolver) RestartService(ctx context.Context, name string, project *string) (*model.ServiceLifecycleResult, error) {
+	result, err := r.DeployService.RestartService(ctx, lifecycleParams(ctx, name, project))
+	if err != nil {
+		return nil, err
+	}
+	return lifecycleResultToModel(result), nil
+}
+
+// RedeployService is the resolver for the redeployService field.
+func (r *mutationResolver) RedeployService(ctx context.Context, name string, project *string, ref *string) (*model.RedeployServiceResult, error) {
+	params := lifecycleParams(ctx, name, project)
+	svc, err := r.DeployService.GetServiceByName(ctx, deployments.GetServiceByNameParams{
+		Name:    params.Name,
+		Project: params.Project,
+		UserID:  params.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var workflowID string
+	if ref != nil && strings.TrimSpace(*ref) != "" {
+		workflowID, err = r.DeployService.RedeployServiceAtRef(ctx, svc.ID, *ref)
+	} else {
+		workflowID, err = r.DeployService.RedeployService(ctx, svc.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RedeployServiceResult{
+		ServiceID:  svc.ID,
+		Name:       name,
+		WorkflowID: workflowID,
+		Status:     "queued",
+	}, nil
+}
+
 // ListServices is the resolver for the listServices field.
 func (r *queryResolver) ListServices(ctx context.Context, first *int32, after *string) (*model.ServiceConnection, error) {
 	userID := authz.For(ctx).GetUserID()
@@ -175,7 +250,7 @@ func (r *serviceResolver) Status(ctx context.Context, obj *model.Service) (strin
 	if err != nil || dep == nil {
 		return "pending", nil
 	}
-	return dep.Status, nil
+	return deployments.ServiceStatus(dep.Status, obj.SleepingSince != nil, obj.StoppedSince != nil), nil
 }
 
 // ErrorMessage is the resolver for the errorMessage field.
@@ -196,6 +271,15 @@ func (r *serviceResolver) CommitHash(ctx context.Context, obj *model.Service) (*
 	return dep.CommitHash, nil
 }
 
+// GitTag is the resolver for the gitTag field.
+func (r *serviceResolver) GitTag(ctx context.Context, obj *model.Service) (*string, error) {
+	dep, err := dataloader.For(ctx).LatestDeploymentByServiceID.Load(ctx, obj.ID)
+	if err != nil || dep == nil {
+		return nil, nil
+	}
+	return dep.GitTag, nil
+}
+
 // CustomDomain is the resolver for the customDomain field.
 func (r *serviceResolver) CustomDomain(ctx context.Context, obj *model.Service) (*string, error) {
 	domain, err := dataloader.For(ctx).CustomDomainByServiceID.Load(ctx, obj.ID)
@@ -1,9 +1,13 @@
 package graph
 
 import (
+	"context"
 	"encoding/json"
 
+	"github.com/augustdev/autoclip/internal/authz"
+	"github.com/augustdev/autoclip/internal/deployments"
 	"github.com/augustdev/autoclip/internal/graph/model"
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
 )
 
@@ -28,20 +32,71 @@ func dbServiceToModel(dbService *services.Service) *model.Service {
 		envVars = []*model.EnvVar{}
 	}
 
-	return &model.Service{
-		ID:          dbService.ID,
-		ProjectID:   dbService.ProjectID,
-		Name:        dbService.Name,
-		Repo:        dbService.Repo,
-		Branch:      dbService.Branch,
-		EnvVars:     envVars,
-		Fqdn:        dbService.Fqdn,
-		Port:        dbService.Port,
-		GitProvider: dbService.GitProvider,
-		Memory:      dbService.Memory,
-		Vcpus:       dbService.Vcpus,
-		CreatedAt:   dbService.CreatedAt.Time,
-		UpdatedAt:   dbService.UpdatedAt.Time,
+	watchPaths := deployments.ServiceWatchPaths(*dbService)
+	if watchPaths == nil {
+		watchPaths = []string{}
 	}
+
+	var bc k8sdeployments.BuildConfig
+	if len(dbService.BuildConfig) > 0 {
+		_ = json.Unmarshal(dbService.BuildConfig, &bc)
+	}
+
+	svc := &model.Service{
+		ID:               dbService.ID,
+		ProjectID:        dbService.ProjectID,
+		Name:             dbService.Name,
+		Repo:             dbService.Repo,
+		Branch:           dbService.Branch,
+		EnvVars:          envVars,
+		Fqdn:             dbService.Fqdn,
+		Port:             dbService.Port,
+		GitProvider:      dbService.GitProvider,
+		Image:            dbService.Image,
+		Memory:           dbService.Memory,
+		Vcpus:            dbService.Vcpus,
+		DeployPolicy:     dbService.DeployPolicy,
+		WatchPaths:       watchPaths,
+		TagPattern:       dbService.TagPattern,
+		DockerfileTarget: strPtr(bc.DockerfileTarget),
+		BuildContext:     strPtr(bc.BuildContext),
+		CreatedAt:        dbService.CreatedAt.Time,
+		UpdatedAt:        dbService.UpdatedAt.Time,
+	}
+	if debounce := deployments.FormatDeployDebounce(dbService.DeployPolicy, dbService.DeployDebounce); debounce != "" {
+		svc.DeployDebounce = &debounce
+	}
+	if dbService.SleepAfterIdle != nil {
+		sleepAfterIdle := deployments.FormatSleepAfterIdle(dbService.SleepAfterIdle)
+		svc.SleepAfterIdle = &sleepAfterIdle
+	}
+	if dbService.SleepingAt.Valid {
+		svc.SleepingSince = &dbService.SleepingAt.Time
+	}
+	if dbService.StoppedAt.Valid {
+		svc.StoppedSince = &dbService.StoppedAt.Time
+	}
+	return svc
 }
 
+func lifecycleParams(ctx context.Context, name string, project *string) deployments.ServiceLifecycleParams {
+	projectRef := "default"
+	if project != nil && *project != "" {
+		projectRef = *project
+	}
+	return deployments.ServiceLifecycleParams{
+		Name:    name,
+		Project: projectRef,
+		UserID:  authz.For(ctx).GetUserID(),
+	}
+}
+
+func lifecycleResultToModel(result *deployments.ServiceLifecycleResult) *model.ServiceLifecycleResult {
+	return &model.ServiceLifecycleResult{
+		ServiceID: result.ServiceID,
+		Name:      result.Name,
+		Action:    result.Action,
+		EventID:   result.EventID,
+		Status:    result.Status,
+	}
+}
@@ -0,0 +1,88 @@
+package internalgit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// The browse methods read a repo through the git server's browse API.
+// They take the repo's full name (owner/name-slug) and don't check who
+// owns it; resolve it with ResolveRepo first.
+
+func (s *Service) ListRefs(ctx context.Context, fullName string) (*RepoRefs, error) {
+	var out RepoRefs
+	return &out, s.browse(ctx, fullName, "refs", nil, &out)
+}
+
+// Log returns up to limit commits reachable from ref (HEAD when empty),
+// only those touching path when it is set.
+func (s *Service) Log(ctx context.Context, fullName, ref, path string, limit int) (*RepoLog, error) {
+	q := url.Values{"ref": {ref}, "path": {path}}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	var out RepoLog
+	return &out, s.browse(ctx, fullName, "log", q, &out)
+}
+
+// Tree lists the directory at path ("" for the root) at ref.
+func (s *Service) Tree(ctx context.Context, fullName, ref, path string) (*RepoTree, error) {
+	var out RepoTree
+	return &out, s.browse(ctx, fullName, "tree", url.Values{"ref": {ref}, "path": {path}}, &out)
+}
+
+// ReadFile returns the file at path at ref. Files over MaxRepoFileSize
+// are refused.
+func (s *Service) ReadFile(ctx context.Context, fullName, ref, path string) (*RepoFile, error) {
+	var out RepoFile
+	return &out, s.browse(ctx, fullName, "blob", url.Values{"ref": {ref}, "path": {path}}, &out)
+}
+
+func (s *Service) browse(ctx context.Context, fullName, kind string, q url.Values, out any) error {
+	owner, repo := splitFullName(fullName)
+	if owner == "" || repo == "" {
+		return fmt.Errorf("invalid repo full name: %s", fullName)
+	}
+	if s.config.GitServerURL == "" || s.config.GitServerAdminToken == "" {
+		return fmt.Errorf("repo browsing is not configured on this server")
+	}
+
+	u, err := url.Parse(s.config.GitServerURL)
+	if err != nil {
+		return fmt.Errorf("invalid git server URL: %w", err)
+	}
+	u.Path = fmt.Sprintf("/%s/%s.git/browse/%s", owner, repo, kind)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("x-admin-token", s.config.GitServerAdminToken)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("git server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		msg := strings.TrimSpace(string(body))
+		if resp.StatusCode >= http.StatusInternalServerError || msg == "" {
+			return fmt.Errorf("git server returned %s", resp.Status)
+		}
+		return fmt.Errorf("%s", msg)
+	}
+	// A file at the size limit is at most ~6x larger once JSON-escaped
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 8*MaxRepoFileSize)).Decode(out); err != nil {
+		return fmt.Errorf("decode git server response: %w", err)
+	}
+	return nil
+}
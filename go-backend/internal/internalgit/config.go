@@ -4,9 +4,18 @@ import "time"
 
 type Config struct {
 	PublicGitURL string // e.g. https://git.ml.ink
+	// GitServerURL reaches the git server over the cluster network, where
+	// it accepts GitServerAdminToken. Used to browse repos.
+	GitServerURL        string // e.g. http://git-server.dp-system.svc:3000
+	GitServerAdminToken string
+	// UploadSigningKey signs source upload URLs; the git server must
+	// have the same key.
+	UploadSigningKey string
 }
 
 const (
 	DefaultTimeout       = 30 * time.Second
 	DefaultTokenDuration = 1 * time.Hour
+	// MaxTokenDuration bounds minted tokens, e.g. deploy keys for CI
+	MaxTokenDuration = 365 * 24 * time.Hour
 )
@@ -0,0 +1,139 @@
+package internalgit
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// The git server runs imports and mirror pushes on its own task queue,
+// since the bare repos only exist on its volume. Workflows are started by
+// name so this package doesn't depend on the git server.
+const (
+	GitServerTaskQueue     = "git-server"
+	ImportRepoWorkflowName = "ImportRepoWorkflow"
+	MirrorRepoWorkflowName = "MirrorRepoWorkflow"
+)
+
+// importWaitTimeout bounds how long ImportFromGitHub waits for the clone.
+// A larger import keeps going in the background.
+const importWaitTimeout = 5 * time.Minute
+
+var githubRepoPattern = regexp.MustCompile(`^[A-Za-z0-9-]+/[A-Za-z0-9._-]+$`)
+
+type ImportRepoInput struct {
+	RepoFullName string
+	GitHubRepo   string // owner/name
+}
+
+type ImportRepoResult struct {
+	DefaultBranch string
+	Branches      int
+	Tags          int
+}
+
+type MirrorRepoInput struct {
+	RepoFullName string
+}
+
+// ImportedRepo is returned after a GitHub repo is imported.
+type ImportedRepo struct {
+	Repo          internalrepos.InternalRepo
+	GitRemote     string
+	DefaultBranch string
+	Branches      int
+	Tags          int
+	Pending       bool // still cloning after importWaitTimeout
+}
+
+// ValidGitHubRepo reports whether repo is an owner/name pair.
+func ValidGitHubRepo(repo string) bool {
+	return githubRepoPattern.MatchString(repo)
+}
+
+// ImportFromGitHub creates an internal repo and fills it with the
+// branches and tags of a GitHub repo the user's app installation can
+// read. The repo must be new or still empty.
+func (s *Service) ImportFromGitHub(ctx context.Context, userID, projectID, name, githubRepo string) (*ImportedRepo, error) {
+	if !ValidGitHubRepo(githubRepo) {
+		return nil, fmt.Errorf("invalid GitHub repo %q, expected owner/name", githubRepo)
+	}
+	created, err := s.CreateRepo(ctx, userID, projectID, name, "", true)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.GetRepoByProjectAndName(ctx, projectID, name)
+	if err != nil {
+		return nil, fmt.Errorf("repo not found after create: %w", err)
+	}
+
+	run, err := s.temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                       "import-" + repo.FullName,
+		TaskQueue:                GitServerTaskQueue,
+		WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+	}, ImportRepoWorkflowName, ImportRepoInput{
+		RepoFullName: repo.FullName,
+		GitHubRepo:   githubRepo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start import: %w", err)
+	}
+
+	imported := &ImportedRepo{Repo: repo, GitRemote: created.GitRemote}
+	waitCtx, cancel := context.WithTimeout(ctx, importWaitTimeout)
+	defer cancel()
+	var result ImportRepoResult
+	if err := run.Get(waitCtx, &result); err != nil {
+		if waitCtx.Err() != nil && ctx.Err() == nil {
+			imported.Pending = true
+			return imported, nil
+		}
+		return nil, fmt.Errorf("import %s: %w", githubRepo, err)
+	}
+	imported.DefaultBranch = result.DefaultBranch
+	imported.Branches = result.Branches
+	imported.Tags = result.Tags
+	return imported, nil
+}
+
+// SetMirror makes the git server push every branch and tag of the repo
+// to githubRepo after each accepted push, and once right away. An empty
+// githubRepo stops mirroring.
+func (s *Service) SetMirror(ctx context.Context, userID, repo, projectRef, githubRepo string) (internalrepos.InternalRepo, error) {
+	if githubRepo != "" && !ValidGitHubRepo(githubRepo) {
+		return internalrepos.InternalRepo{}, fmt.Errorf("invalid GitHub repo %q, expected owner/name", githubRepo)
+	}
+	internalRepo, err := s.ResolveRepo(ctx, userID, repo, projectRef)
+	if err != nil {
+		return internalrepos.InternalRepo{}, err
+	}
+
+	var mirror *string
+	if githubRepo != "" {
+		mirror = &githubRepo
+	}
+	updated, err := s.repoQueries.UpdateInternalRepoMirror(ctx, internalrepos.UpdateInternalRepoMirrorParams{
+		ID:               internalRepo.ID,
+		MirrorGithubRepo: mirror,
+	})
+	if err != nil {
+		return internalrepos.InternalRepo{}, fmt.Errorf("update repo: %w", err)
+	}
+	if mirror == nil {
+		return updated, nil
+	}
+
+	if _, err := s.temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                       "mirror-" + updated.FullName,
+		TaskQueue:                GitServerTaskQueue,
+		WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+	}, MirrorRepoWorkflowName, MirrorRepoInput{RepoFullName: updated.FullName}); err != nil {
+		return updated, fmt.Errorf("mirror configured, but the first push failed to start: %w", err)
+	}
+	return updated, nil
+}
@@ -8,15 +8,20 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/augustdev/autoclip/internal/storage/pg"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/gittokens"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/sshkeys"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/users"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/lithammer/shortuuid/v4"
+	"go.temporal.io/sdk/client"
 )
 
 const (
@@ -31,9 +36,14 @@ type Service struct {
 	repoQueries internalrepos.Querier
 	tokenQ      gittokens.Querier
 	userQueries users.Querier
+	projectQ    projects.Querier
+	sshKeyQ     sshkeys.Querier
+	httpClient  *http.Client
+
+	temporalClient client.Client
 }
 
-func NewService(config Config, db *pg.DB) (*Service, error) {
+func NewService(config Config, db *pg.DB, temporalClient client.Client) (*Service, error) {
 	if config.PublicGitURL == "" {
 		return nil, fmt.Errorf("internalgit: PublicGitURL is required")
 	}
@@ -43,6 +53,11 @@ func NewService(config Config, db *pg.DB) (*Service, error) {
 		repoQueries: internalrepos.New(db.Pool),
 		tokenQ:      gittokens.New(db.Pool),
 		userQueries: users.New(db.Pool),
+		projectQ:    projects.New(db.Pool),
+		sshKeyQ:     sshkeys.New(db.Pool),
+		httpClient:  &http.Client{Timeout: DefaultTimeout},
+
+		temporalClient: temporalClient,
 	}, nil
 }
 
@@ -187,10 +202,74 @@ func (s *Service) GetRepoByProjectAndName(ctx context.Context, projectID, name s
 	})
 }
 
+// ResolveRepo finds the user's internal repo from what create_repo
+// returned: "ink/<name>" or "<name>" within projectRef, or the full
+// "<owner>/<name>-<slug>".
+func (s *Service) ResolveRepo(ctx context.Context, userID, repo, projectRef string) (internalrepos.InternalRepo, error) {
+	cleaned := repo
+	if strings.HasPrefix(cleaned, "ink/") {
+		cleaned = strings.TrimPrefix(cleaned, "ink/")
+	} else if strings.HasPrefix(cleaned, "ml.ink/") {
+		cleaned = strings.TrimPrefix(cleaned, "ml.ink/")
+	}
+	if projectRef == "" {
+		projectRef = "default"
+	}
+
+	var internalRepo internalrepos.InternalRepo
+	if strings.Contains(cleaned, "/") {
+		// Has slash → treat as full_name (owner/repo-slug)
+		r, err := s.repoQueries.GetInternalRepoByFullName(ctx, cleaned)
+		if err != nil {
+			return internalRepo, fmt.Errorf("repo '%s' not found. Create it first with create_repo", cleaned)
+		}
+		internalRepo = r
+	} else {
+		// No slash → treat as project-scoped name
+		project, err := s.projectQ.GetProjectByRef(ctx, projects.GetProjectByRefParams{UserID: userID, Ref: projectRef})
+		if err != nil {
+			return internalRepo, fmt.Errorf("project not found: %s", projectRef)
+		}
+		r, err := s.GetRepoByProjectAndName(ctx, project.ID, cleaned)
+		if err != nil {
+			return internalRepo, fmt.Errorf("repo '%s' not found in project '%s'. Create it first with create_repo", cleaned, projectRef)
+		}
+		internalRepo = r
+	}
+	if internalRepo.UserID != userID {
+		return internalrepos.InternalRepo{}, fmt.Errorf("repo belongs to another user")
+	}
+	return internalRepo, nil
+}
+
+// SetSecretScanBypass lets pushes to a repo through even when the git
+// server finds secrets in them. The findings are still recorded.
+func (s *Service) SetSecretScanBypass(ctx context.Context, userID, repo, projectRef string, bypass bool) (internalrepos.InternalRepo, error) {
+	internalRepo, err := s.ResolveRepo(ctx, userID, repo, projectRef)
+	if err != nil {
+		return internalrepos.InternalRepo{}, err
+	}
+	updated, err := s.repoQueries.UpdateInternalRepoSecretScanBypass(ctx, internalrepos.UpdateInternalRepoSecretScanBypassParams{
+		ID:               internalRepo.ID,
+		SecretScanBypass: bypass,
+	})
+	if err != nil {
+		return internalrepos.InternalRepo{}, fmt.Errorf("update repo: %w", err)
+	}
+	return updated, nil
+}
+
 func (s *Service) createToken(ctx context.Context, userID string, repoID *string, expiresAt *time.Time) (string, error) {
+	_, rawToken, err := s.storeToken(ctx, userID, repoID, []string{ScopePush, ScopePull}, expiresAt)
+	return rawToken, err
+}
+
+// storeToken generates a token and stores its hash. The raw token is only
+// ever returned here.
+func (s *Service) storeToken(ctx context.Context, userID string, repoID *string, scopes []string, expiresAt *time.Time) (gittokens.GitToken, string, error) {
 	rawBytes := make([]byte, 32)
 	if _, err := rand.Read(rawBytes); err != nil {
-		return "", fmt.Errorf("generate random token: %w", err)
+		return gittokens.GitToken{}, "", fmt.Errorf("generate random token: %w", err)
 	}
 	rawToken := tokenPrefix + base64.RawURLEncoding.EncodeToString(rawBytes)
 
@@ -204,20 +283,20 @@ func (s *Service) createToken(ctx context.Context, userID string, repoID *string
 		expiresAtPg = pgtype.Timestamptz{Time: *expiresAt, Valid: true}
 	}
 
-	_, err := s.tokenQ.CreateToken(ctx, gittokens.CreateTokenParams{
+	token, err := s.tokenQ.CreateToken(ctx, gittokens.CreateTokenParams{
 		ID:          shortuuid.New(),
 		TokenHash:   hashStr,
 		TokenPrefix: prefix,
 		UserID:      userID,
 		RepoID:      repoID,
-		Scopes:      []string{"push", "pull"},
+		Scopes:      scopes,
 		ExpiresAt:   expiresAtPg,
 	})
 	if err != nil {
-		return "", fmt.Errorf("store token: %w", err)
+		return gittokens.GitToken{}, "", fmt.Errorf("store token: %w", err)
 	}
 
-	return rawToken, nil
+	return token, rawToken, nil
 }
 
 func (s *Service) cloneURL(owner, repoName, token string) string {
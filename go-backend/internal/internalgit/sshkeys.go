@@ -0,0 +1,67 @@
+package internalgit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/sshkeys"
+	"github.com/jackc/pgx/v5"
+	"github.com/lithammer/shortuuid/v4"
+	"golang.org/x/crypto/ssh"
+)
+
+const maxSSHKeyNameLen = 100
+
+// AddSSHKey stores a public key in authorized_keys format for the git
+// server's SSH listener. A key can belong to only one user.
+func (s *Service) AddSSHKey(ctx context.Context, userID, name, publicKey string) (sshkeys.SshKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxSSHKeyNameLen {
+		return sshkeys.SshKey{}, fmt.Errorf("name must be 1-%d characters", maxSSHKeyNameLen)
+	}
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(publicKey)))
+	if err != nil {
+		return sshkeys.SshKey{}, fmt.Errorf("invalid public key: %w", err)
+	}
+	fingerprint := ssh.FingerprintSHA256(key)
+
+	_, err = s.sshKeyQ.GetSSHKeyByFingerprint(ctx, fingerprint)
+	if err == nil {
+		return sshkeys.SshKey{}, fmt.Errorf("ssh key %s is already in use", fingerprint)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return sshkeys.SshKey{}, fmt.Errorf("failed to look up ssh key: %w", err)
+	}
+
+	created, err := s.sshKeyQ.CreateSSHKey(ctx, sshkeys.CreateSSHKeyParams{
+		ID:          shortuuid.New(),
+		UserID:      userID,
+		Name:        name,
+		PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))),
+		Fingerprint: fingerprint,
+	})
+	if err != nil {
+		return sshkeys.SshKey{}, fmt.Errorf("failed to store ssh key: %w", err)
+	}
+	return created, nil
+}
+
+// ListSSHKeys returns the user's SSH keys, newest first.
+func (s *Service) ListSSHKeys(ctx context.Context, userID string) ([]sshkeys.SshKey, error) {
+	return s.sshKeyQ.ListSSHKeysByUserID(ctx, userID)
+}
+
+// DeleteSSHKey removes one of the user's SSH keys. Sessions already
+// open with it are not closed.
+func (s *Service) DeleteSSHKey(ctx context.Context, userID, keyID string) error {
+	n, err := s.sshKeyQ.DeleteSSHKey(ctx, sshkeys.DeleteSSHKeyParams{ID: keyID, UserID: userID})
+	if err != nil {
+		return fmt.Errorf("delete ssh key: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("ssh key %s not found", keyID)
+	}
+	return nil
+}
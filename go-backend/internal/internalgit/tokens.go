@@ -0,0 +1,94 @@
+package internalgit
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/gittokens"
+)
+
+// Token scopes checked by the git server: pull for clone and fetch, push
+// for push.
+const (
+	ScopePull = "pull"
+	ScopePush = "push"
+)
+
+// MintedToken is a new token for one repo. Token is not stored and can't
+// be shown again.
+type MintedToken struct {
+	gittokens.GitToken
+	Repo      string // full name
+	Token     string
+	GitRemote string
+}
+
+// NormalizeScopes validates requested scopes. No scopes means read-only.
+func NormalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return []string{ScopePull}, nil
+	}
+	var out []string
+	for _, scope := range scopes {
+		if scope != ScopePull && scope != ScopePush {
+			return nil, fmt.Errorf("invalid scope %q (valid: %s, %s)", scope, ScopePull, ScopePush)
+		}
+		if !slices.Contains(out, scope) {
+			out = append(out, scope)
+		}
+	}
+	return out, nil
+}
+
+// MintToken creates a token for one of the user's repos with the given
+// scopes, valid for ttl (DefaultTokenDuration when zero).
+func (s *Service) MintToken(ctx context.Context, userID, repo, projectRef string, scopes []string, ttl time.Duration) (*MintedToken, error) {
+	scopes, err := NormalizeScopes(scopes)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == 0 {
+		ttl = DefaultTokenDuration
+	}
+	if ttl < 0 || ttl > MaxTokenDuration {
+		return nil, fmt.Errorf("token lifetime must be at most %d hours", int(MaxTokenDuration.Hours()))
+	}
+	internalRepo, err := s.ResolveRepo(ctx, userID, repo, projectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	token, rawToken, err := s.storeToken(ctx, userID, &internalRepo.ID, scopes, &expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	owner, name := splitFullName(internalRepo.FullName)
+	return &MintedToken{
+		GitToken:  token,
+		Repo:      internalRepo.FullName,
+		Token:     rawToken,
+		GitRemote: s.cloneURL(owner, name, rawToken),
+	}, nil
+}
+
+// ListTokens returns the user's tokens that are neither revoked nor
+// expired, newest first.
+func (s *Service) ListTokens(ctx context.Context, userID string) ([]gittokens.ListActiveByUserIDRow, error) {
+	return s.tokenQ.ListActiveByUserID(ctx, userID)
+}
+
+// RevokeToken revokes one of the user's tokens. The git server rejects
+// it from the next request on.
+func (s *Service) RevokeToken(ctx context.Context, userID, tokenID string) error {
+	n, err := s.tokenQ.RevokeUserToken(ctx, gittokens.RevokeUserTokenParams{ID: tokenID, UserID: userID})
+	if err != nil {
+		return fmt.Errorf("revoke token: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("token %s not found", tokenID)
+	}
+	return nil
+}
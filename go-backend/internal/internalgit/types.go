@@ -1,5 +1,7 @@
 package internalgit
 
+import "time"
+
 // CreateRepoResult is returned after creating a repo
 type CreateRepoResult struct {
 	Repo      string `json:"repo"`
@@ -13,3 +15,88 @@ type GetPushTokenResult struct {
 	GitRemote string `json:"git_remote"`
 	ExpiresAt string `json:"expires_at"`
 }
+
+// Limits on what the repo browsing API returns. The git server enforces
+// them; callers can ask for less.
+const (
+	MaxRepoRefs         = 200 // per kind (branches, tags)
+	MaxRepoTreeEntries  = 1000
+	MaxRepoFileSize     = 1 << 20
+	DefaultRepoLogLimit = 20
+	MaxRepoLogLimit     = 100
+)
+
+// RepoRef is a branch or tag and the commit it points at.
+type RepoRef struct {
+	Name      string `json:"name"`
+	CommitSHA string `json:"commit_sha"`
+}
+
+type RepoRefs struct {
+	DefaultBranch string    `json:"default_branch,omitempty"`
+	Branches      []RepoRef `json:"branches"`
+	Tags          []RepoRef `json:"tags"`
+	Truncated     bool      `json:"truncated,omitempty"`
+}
+
+type RepoCommit struct {
+	SHA         string    `json:"sha"`
+	AuthorName  string    `json:"author_name"`
+	AuthorEmail string    `json:"author_email"`
+	AuthoredAt  time.Time `json:"authored_at"`
+	Subject     string    `json:"subject"`
+}
+
+type RepoLog struct {
+	Ref     string       `json:"ref"`
+	Commits []RepoCommit `json:"commits"`
+}
+
+type RepoTreeEntry struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Type string `json:"type"` // "file", "dir" or "submodule"
+	Size *int64 `json:"size,omitempty"`
+}
+
+type RepoTree struct {
+	Ref       string          `json:"ref"`
+	CommitSHA string          `json:"commit_sha"`
+	Path      string          `json:"path"`
+	Entries   []RepoTreeEntry `json:"entries"`
+	Truncated bool            `json:"truncated,omitempty"`
+}
+
+type RepoFile struct {
+	Ref       string `json:"ref"`
+	CommitSHA string `json:"commit_sha"`
+	Path      string `json:"path"`
+	Size      int64  `json:"size"`
+	// Binary files are reported without content.
+	Binary  bool   `json:"binary"`
+	Content string `json:"content,omitempty"`
+}
+
+// Limits on source uploads (deploy_source). The git server enforces them.
+const (
+	SourceUploadTTL            = 15 * time.Minute
+	MaxSourceUploadSize        = 100 << 20 // compressed
+	MaxSourceUnpackedSize      = 512 << 20
+	MaxSourceUploadFiles       = 20000
+	DefaultSourceUploadMessage = "Upload source"
+)
+
+// SourceUpload is a presigned URL that commits a tar.gz to a branch.
+type SourceUpload struct {
+	URL       string    `json:"url"`
+	Method    string    `json:"method"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SourceUploadResult is what the git server returns for an upload.
+type SourceUploadResult struct {
+	Branch    string `json:"branch"`
+	CommitSHA string `json:"commit_sha"`
+	Files     int    `json:"files"`
+	Unchanged bool   `json:"unchanged,omitempty"`
+}
@@ -0,0 +1,57 @@
+package internalgit
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SourceUploadURL returns a presigned URL that accepts a tar.gz via PUT
+// and commits its contents to branch of the repo, replacing the tree.
+// Anyone holding the URL can upload until it expires, so it is only
+// handed to the repo's owner.
+func (s *Service) SourceUploadURL(fullName, branch, message string) (*SourceUpload, error) {
+	owner, repo := splitFullName(fullName)
+	if owner == "" || repo == "" {
+		return nil, fmt.Errorf("invalid repo full name: %s", fullName)
+	}
+	if s.config.UploadSigningKey == "" {
+		return nil, fmt.Errorf("source uploads are not configured on this server")
+	}
+	if message == "" {
+		message = DefaultSourceUploadMessage
+	}
+
+	expiresAt := time.Now().Add(SourceUploadTTL).Truncate(time.Second)
+	q := url.Values{
+		"branch":  {branch},
+		"message": {message},
+		"expires": {strconv.FormatInt(expiresAt.Unix(), 10)},
+	}
+	q.Set("sig", SignSourceUpload(s.config.UploadSigningKey, fullName, q))
+
+	return &SourceUpload{
+		URL:       fmt.Sprintf("%s/%s/%s.git/upload?%s", strings.TrimRight(s.config.PublicGitURL, "/"), owner, repo, q.Encode()),
+		Method:    "PUT",
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// SignSourceUpload computes the signature of an upload URL from the repo
+// full name and every query parameter except sig.
+func SignSourceUpload(key, fullName string, q url.Values) string {
+	signed := url.Values{}
+	for k, v := range q {
+		if k != "sig" {
+			signed[k] = v
+		}
+	}
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(fullName + "?" + signed.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
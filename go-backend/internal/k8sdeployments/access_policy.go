@@ -0,0 +1,149 @@
+package k8sdeployments
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var ingressRouteGVR = schema.GroupVersionResource{
+	Group:    "traefik.io",
+	Version:  "v1alpha1",
+	Resource: "ingressroutes",
+}
+
+var middlewareGVR = schema.GroupVersionResource{
+	Group:    "traefik.io",
+	Version:  "v1alpha1",
+	Resource: "middlewares",
+}
+
+// AccessPolicy is the per-service ingress access control stored in
+// services.access_policy. Passwords are stored as bcrypt hashes only.
+type AccessPolicy struct {
+	BasicAuth   []BasicAuthUser `json:"basic_auth,omitempty"`
+	IPAllowList []string        `json:"ip_allowlist,omitempty"`
+}
+
+type BasicAuthUser struct {
+	Username     string `json:"username"`
+	PasswordHash string `json:"password_hash"`
+}
+
+func (p AccessPolicy) IsPublic() bool {
+	return len(p.BasicAuth) == 0 && len(p.IPAllowList) == 0
+}
+
+// ParseAccessPolicy decodes a stored policy. An unreadable policy is an
+// error rather than public access.
+func ParseAccessPolicy(raw []byte) (AccessPolicy, error) {
+	var p AccessPolicy
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return AccessPolicy{}, fmt.Errorf("invalid access policy: %w", err)
+		}
+	}
+	return p, nil
+}
+
+func basicAuthMiddlewareName(name string) string {
+	return name + "-basic-auth"
+}
+
+func ipAllowListMiddlewareName(name string) string {
+	return name + "-ip-allowlist"
+}
+
+func basicAuthSecretName(name string) string {
+	return name + "-basic-auth"
+}
+
+// AccessMiddlewares returns the Traefik Middleware names (in the service's
+// namespace) enforcing the policy. The allowlist runs first so blocked
+// clients never see an auth prompt.
+func AccessMiddlewares(name string, p AccessPolicy) []string {
+	var mws []string
+	if len(p.IPAllowList) > 0 {
+		mws = append(mws, ipAllowListMiddlewareName(name))
+	}
+	if len(p.BasicAuth) > 0 {
+		mws = append(mws, basicAuthMiddlewareName(name))
+	}
+	return mws
+}
+
+// ingressMiddlewareAnnotation formats middlewares for the Traefik
+// router.middlewares annotation on a networking/v1 Ingress.
+func ingressMiddlewareAnnotation(namespace string, middlewares []string) string {
+	refs := make([]string, len(middlewares))
+	for i, mw := range middlewares {
+		refs[i] = fmt.Sprintf("%s-%s@kubernetescrd", namespace, mw)
+	}
+	return strings.Join(refs, ",")
+}
+
+func buildBasicAuthSecret(namespace, name string, users []BasicAuthUser) *corev1.Secret {
+	lines := make([]string, len(users))
+	for i, u := range users {
+		lines[i] = u.Username + ":" + u.PasswordHash
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      basicAuthSecretName(name),
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			"users": strings.Join(lines, "\n") + "\n",
+		},
+	}
+}
+
+func buildBasicAuthMiddleware(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "traefik.io/v1alpha1",
+			"kind":       "Middleware",
+			"metadata": map[string]any{
+				"name":      basicAuthMiddlewareName(name),
+				"namespace": namespace,
+			},
+			"spec": map[string]any{
+				"basicAuth": map[string]any{
+					"secret":       basicAuthSecretName(name),
+					"removeHeader": true,
+				},
+			},
+		},
+	}
+}
+
+func buildIPAllowListMiddleware(namespace, name string, sourceRange []string) *unstructured.Unstructured {
+	ranges := make([]any, len(sourceRange))
+	for i, r := range sourceRange {
+		ranges[i] = r
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "traefik.io/v1alpha1",
+			"kind":       "Middleware",
+			"metadata": map[string]any{
+				"name":      ipAllowListMiddlewareName(name),
+				"namespace": namespace,
+			},
+			"spec": map[string]any{
+				"ipAllowList": map[string]any{
+					"sourceRange": ranges,
+				},
+			},
+		},
+	}
+}
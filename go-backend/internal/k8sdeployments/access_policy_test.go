@@ -0,0 +1,17 @@
+package k8sdeployments
+
+import "testing"
+
+func TestParseAccessPolicy(t *testing.T) {
+	p, err := ParseAccessPolicy(nil)
+	if err != nil || !p.IsPublic() {
+		t.Errorf("ParseAccessPolicy(nil) = %+v, %v, want public", p, err)
+	}
+	p, err = ParseAccessPolicy([]byte(`{"ip_allowlist":["10.0.0.0/8"]}`))
+	if err != nil || len(p.IPAllowList) != 1 {
+		t.Errorf("ParseAccessPolicy() = %+v, %v", p, err)
+	}
+	if _, err := ParseAccessPolicy([]byte(`{"ip_allowlist":"10.0.0.0/8"}`)); err == nil {
+		t.Error("expected an error for a malformed policy")
+	}
+}
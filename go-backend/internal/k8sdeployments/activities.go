@@ -3,11 +3,14 @@ package k8sdeployments
 import (
 	"log/slog"
 
+	"github.com/augustdev/autoclip/internal/auth"
 	"github.com/augustdev/autoclip/internal/githubapp"
+	"github.com/augustdev/autoclip/internal/prometheus"
 	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/users"
+	"go.temporal.io/sdk/client"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
@@ -21,6 +24,9 @@ type Activities struct {
 	deploymentsQ deploymentsdb.Querier
 	projectsQ    projects.Querier
 	usersQ       users.Querier
+	prometheus   *prometheus.Client
+	temporal     client.Client
+	authConfig   auth.Config
 	config       Config
 }
 
@@ -33,6 +39,9 @@ func NewActivities(
 	deploymentsQ deploymentsdb.Querier,
 	projectsQ projects.Querier,
 	usersQ users.Querier,
+	prometheusClient *prometheus.Client,
+	temporalClient client.Client,
+	authConfig auth.Config,
 	config Config,
 ) *Activities {
 	return &Activities{
@@ -44,6 +53,9 @@ func NewActivities(
 		deploymentsQ: deploymentsQ,
 		projectsQ:    projectsQ,
 		usersQ:       usersQ,
+		prometheus:   prometheusClient,
+		temporal:     temporalClient,
+		authConfig:   authConfig,
 		config:       config,
 	}
 }
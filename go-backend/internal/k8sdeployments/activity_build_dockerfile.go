@@ -9,7 +9,9 @@ import (
 func (a *Activities) DockerfileBuild(ctx context.Context, input BuildImageInput) (*BuildImageResult, error) {
 	a.logger.Info("DockerfileBuild activity started",
 		"imageRef", input.ImageRef,
-		"sourcePath", input.SourcePath)
+		"sourcePath", input.SourcePath,
+		"buildContextPath", input.BuildContextPath,
+		"target", input.DockerfileTarget)
 
 	if _, err := os.Stat(input.SourcePath); err != nil {
 		if isPathMissingErr(err) {
@@ -34,6 +36,9 @@ func (a *Activities) DockerfileBuild(ctx context.Context, input BuildImageInput)
 		CacheRef:       cacheRef,
 		LokiLogger:     lokiLogger,
 		DockerfilePath: input.DockerfilePath,
+		ContextPath:    input.BuildContextPath,
+		Target:         input.DockerfileTarget,
+		BuildArgs:      input.BuildArgs,
 	}, input.EnvVars)
 	if err != nil {
 		if isPathMissingErr(err) {
@@ -22,6 +22,17 @@ func (a *Activities) RailpackStaticBuild(ctx context.Context, input BuildImageIn
 
 	lokiLogger := a.newBuildLokiLogger(input.Name, input.Namespace)
 
+	// The built publish directory only exists inside the image, so
+	// _redirects/_headers come from the source: the committed publish
+	// directory, public/ (copied there by Vite and friends) or the root.
+	nginxConf, err := staticNginxConf(input.StaticSite,
+		filepath.Join(input.SourcePath, input.PublishDirectory),
+		filepath.Join(input.SourcePath, "public"),
+		input.SourcePath)
+	if err != nil {
+		return nil, invalidStaticConfigError(err)
+	}
+
 	// Phase 1: Build with railpack into a temporary "-build" image
 	buildImageRef := input.ImageRef + "-build"
 	buildInput := BuildImageInput{
@@ -61,17 +72,6 @@ COPY --chown=nginx:nginx nginx.conf /etc/nginx/conf.d/default.conf
 		return nil, fmt.Errorf("write Dockerfile: %w", err)
 	}
 
-	nginxConf := `server {
-    listen 8080;
-    server_name _;
-    root /usr/share/nginx/html;
-    index index.html;
-
-    location / {
-        try_files $uri $uri/ /index.html;
-    }
-}
-`
 	if err := os.WriteFile(filepath.Join(tmpDir, "nginx.conf"), []byte(nginxConf), 0o644); err != nil {
 		return nil, fmt.Errorf("write nginx.conf: %w", err)
 	}
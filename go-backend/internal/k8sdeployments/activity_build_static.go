@@ -24,7 +24,7 @@ func (a *Activities) StaticBuild(ctx context.Context, input BuildImageInput) (*B
 	dockerfile := `FROM nginx:alpine AS build
 WORKDIR /site
 COPY . .
-RUN rm -f nginx.conf Dockerfile docker-compose.yaml docker-compose.yml .env
+RUN rm -f nginx.conf Dockerfile docker-compose.yaml docker-compose.yml .env _redirects _headers
 
 FROM nginxinc/nginx-unprivileged:alpine
 COPY --from=build --chown=nginx:nginx /site/ /usr/share/nginx/html/
@@ -37,17 +37,10 @@ COPY --chown=nginx:nginx nginx.conf /etc/nginx/conf.d/default.conf
 		return nil, fmt.Errorf("write static Dockerfile: %w", err)
 	}
 
-	nginxConf := `server {
-    listen 8080;
-    server_name _;
-    root /usr/share/nginx/html;
-    index index.html;
-
-    location / {
-        try_files $uri $uri/ /index.html;
-    }
-}
-`
+	nginxConf, err := staticNginxConf(input.StaticSite, input.SourcePath)
+	if err != nil {
+		return nil, invalidStaticConfigError(err)
+	}
 	if err := os.WriteFile(filepath.Join(input.SourcePath, "nginx.conf"), []byte(nginxConf), 0o644); err != nil {
 		if isPathMissingErr(err) {
 			return nil, sourcePathMissingError(input.SourcePath, err)
@@ -61,7 +54,7 @@ COPY --chown=nginx:nginx nginx.conf /etc/nginx/conf.d/default.conf
 
 	lokiLogger.Log("Building static site image with nginx...")
 
-	err := buildWithDockerfile(ctx, buildkitSolveOpts{
+	err = buildWithDockerfile(ctx, buildkitSolveOpts{
 		BuildkitHost: a.config.BuildkitHost,
 		SourcePath:   input.SourcePath,
 		ImageRef:     input.ImageRef,
@@ -0,0 +1,59 @@
+package k8sdeployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+)
+
+const workflowClosedPollInterval = 2 * time.Second
+
+// WaitForWorkflowsClosed blocks until every workflow has closed, so a delete
+// only starts once cancelled deploys have stopped touching the cluster.
+// Workflows Temporal no longer knows about count as closed.
+func (a *Activities) WaitForWorkflowsClosed(ctx context.Context, input WaitForWorkflowsClosedInput) error {
+	pending := append([]string(nil), input.WorkflowIDs...)
+	ticker := time.NewTicker(workflowClosedPollInterval)
+	defer ticker.Stop()
+
+	for {
+		var running []string
+		for _, id := range pending {
+			open, err := a.workflowRunning(ctx, id)
+			if err != nil {
+				return err
+			}
+			if open {
+				running = append(running, id)
+			}
+		}
+		if len(running) == 0 {
+			a.logger.Info("In-flight workflows closed", "workflowIDs", input.WorkflowIDs)
+			return nil
+		}
+		pending = running
+		recordHeartbeat(ctx, pending)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *Activities) workflowRunning(ctx context.Context, workflowID string) (bool, error) {
+	resp, err := a.temporal.DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("describe workflow %s: %w", workflowID, err)
+	}
+	return resp.GetWorkflowExecutionInfo().GetStatus() == enums.WORKFLOW_EXECUTION_STATUS_RUNNING, nil
+}
@@ -15,6 +15,7 @@ func (a *Activities) CloneRepo(ctx context.Context, input CloneRepoInput) (*Clon
 		"serviceID", input.ServiceID,
 		"repo", input.Repo,
 		"branch", input.Branch,
+		"ref", input.Ref,
 		"gitProvider", input.GitProvider)
 
 	dir, err := os.MkdirTemp("/tmp", fmt.Sprintf("build-%s-", input.ServiceID))
@@ -28,23 +29,26 @@ func (a *Activities) CloneRepo(ctx context.Context, input CloneRepoInput) (*Clon
 		return nil, fmt.Errorf("resolve clone URL: %w", err)
 	}
 
-	args := []string{"clone", "--depth", "1"}
-	if input.Branch != "" {
-		args = append(args, "--branch", input.Branch)
-	}
-	args = append(args, cloneURL, dir)
-
 	recordHeartbeat(ctx, "cloning")
-	cmd := exec.CommandContext(ctx, "git", args...)
-	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
-	output, err := cmd.CombinedOutput()
+	if input.Ref != "" {
+		err = fetchRef(ctx, dir, cloneURL, input.Ref)
+	} else {
+		args := []string{"clone", "--depth", "1"}
+		if input.Branch != "" {
+			args = append(args, "--branch", input.Branch)
+		}
+		args = append(args, cloneURL, dir)
+		err = runGit(ctx, "", args...)
+	}
 	if err != nil {
 		os.RemoveAll(dir)
-		return nil, fmt.Errorf("git clone failed: %w\noutput: %s", err, redactToken(string(output)))
+		return nil, err
 	}
 
+	// A fetched ref resolves to whatever it points at now, so always read
+	// the SHA back from the checkout
 	commitSHA := input.CommitSHA
-	if commitSHA == "" {
+	if commitSHA == "" || input.Ref != "" {
 		revCmd := exec.CommandContext(ctx, "git", "-C", dir, "rev-parse", "HEAD")
 		out, err := revCmd.Output()
 		if err != nil {
@@ -68,6 +72,30 @@ func (a *Activities) CloneRepo(ctx context.Context, input CloneRepoInput) (*Clon
 	}, nil
 }
 
+// fetchRef checks out exactly one commit, tag or branch into dir. Unlike
+// clone --branch it also accepts a full commit SHA.
+func fetchRef(ctx context.Context, dir, cloneURL, ref string) error {
+	if err := runGit(ctx, "", "init", "--quiet", dir); err != nil {
+		return err
+	}
+	if err := runGit(ctx, dir, "fetch", "--depth", "1", "--no-tags", cloneURL, ref); err != nil {
+		return fmt.Errorf("fetch ref %q: %w", ref, err)
+	}
+	return runGit(ctx, dir, "checkout", "--quiet", "--detach", "FETCH_HEAD")
+}
+
+// runGit runs a git command in dir (the current directory when empty).
+func runGit(ctx context.Context, dir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git %s failed: %w\noutput: %s", args[0], err, redactToken(string(output)))
+	}
+	return nil
+}
+
 func (a *Activities) resolveCloneURL(ctx context.Context, input CloneRepoInput) (string, error) {
 	switch input.GitProvider {
 	case "github":
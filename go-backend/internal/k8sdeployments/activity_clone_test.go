@@ -0,0 +1,79 @@
+package k8sdeployments
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestFetchRef(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	origin := t.TempDir()
+	gitOutput(t, origin, "init", "--quiet", "--initial-branch", "main")
+	commit := func(content string) string {
+		if err := os.WriteFile(filepath.Join(origin, "VERSION"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		gitOutput(t, origin, "add", "VERSION")
+		gitOutput(t, origin, "commit", "--quiet", "-m", content)
+		return gitOutput(t, origin, "rev-parse", "HEAD")
+	}
+	first := commit("1")
+	gitOutput(t, origin, "tag", "v1")
+	second := commit("2")
+	gitOutput(t, origin, "branch", "release")
+	head := commit("3")
+
+	tests := []struct {
+		ref     string
+		want    string
+		content string
+	}{
+		{ref: "v1", want: first, content: "1"},
+		{ref: second, want: second, content: "2"},
+		{ref: "release", want: second, content: "2"},
+		{ref: "main", want: head, content: "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "src")
+			if err := fetchRef(context.Background(), dir, "file://"+origin, tt.ref); err != nil {
+				t.Fatalf("fetchRef(%q): %v", tt.ref, err)
+			}
+			if got := gitOutput(t, dir, "rev-parse", "HEAD"); got != tt.want {
+				t.Errorf("HEAD = %s, want %s", got, tt.want)
+			}
+			data, err := os.ReadFile(filepath.Join(dir, "VERSION"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.content {
+				t.Errorf("VERSION = %q, want %q", data, tt.content)
+			}
+		})
+	}
+
+	if err := fetchRef(context.Background(), filepath.Join(t.TempDir(), "src"), "file://"+origin, "v9"); err == nil {
+		t.Error("fetchRef of a missing ref should fail")
+	}
+}
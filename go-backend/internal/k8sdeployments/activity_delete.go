@@ -28,6 +28,26 @@ func (a *Activities) DeleteService(ctx context.Context, input DeleteServiceInput
 		a.logger.Warn("Failed to delete custom domain TLS secret", "name", input.Name+"-cd-tls", "error", delErr)
 	}
 
+	// Access policy + wake middlewares and basic auth secret (no-op if none exist)
+	for _, mw := range []string{basicAuthMiddlewareName(input.Name), ipAllowListMiddlewareName(input.Name), wakeMiddlewareName(input.Name)} {
+		if delErr := a.deleteMiddleware(ctx, input.Namespace, mw); delErr != nil {
+			a.logger.Warn("Failed to delete middleware", "name", mw, "error", delErr)
+		}
+	}
+	if delErr := a.k8s.CoreV1().Secrets(input.Namespace).Delete(ctx, basicAuthSecretName(input.Name), metav1.DeleteOptions{}); delErr != nil && !apierrors.IsNotFound(delErr) {
+		a.logger.Warn("Failed to delete basic auth secret", "name", basicAuthSecretName(input.Name), "error", delErr)
+	}
+
+	// Registry pull secret for prebuilt images (no-op if none exists)
+	if delErr := a.k8s.CoreV1().Secrets(input.Namespace).Delete(ctx, pullSecretName(input.Name), metav1.DeleteOptions{}); delErr != nil && !apierrors.IsNotFound(delErr) {
+		a.logger.Warn("Failed to delete registry pull secret", "name", pullSecretName(input.Name), "error", delErr)
+	}
+
+	// NodePort service + network policy for TCP/UDP ports (no-op if none exist)
+	if delErr := a.deleteExternalPorts(ctx, input.Namespace, input.Name); delErr != nil {
+		a.logger.Warn("Failed to delete external ports", "name", externalServiceName(input.Name), "error", delErr)
+	}
+
 	// Delete Service
 	err = a.k8s.CoreV1().Services(input.Namespace).Delete(ctx, input.Name, metav1.DeleteOptions{})
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"go.temporal.io/sdk/temporal"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 )
 
 func (a *Activities) Deploy(ctx context.Context, input DeployInput) (*DeployResult, error) {
@@ -20,7 +24,35 @@ func (a *Activities) Deploy(ctx context.Context, input DeployInput) (*DeployResu
 		return nil, err
 	}
 
+	// Routes and custom domains address the Service by the row's port, so
+	// it stays put when ink.yaml or EXPOSE moves the container port
+	servicePort := EffectivePort(id.Service.BuildPack, id.Service.Port, id.Service.BuildConfig)
 	bc := parseBuildConfig(id.Service.BuildConfig)
+
+	// A cancelled deploy must not re-create resources a delete is removing
+	var manifest *ServiceManifest
+	if input.DeploymentID != "" {
+		deployment, err := a.deploymentsQ.GetDeploymentByID(ctx, input.DeploymentID)
+		if err != nil {
+			return nil, fmt.Errorf("get deployment: %w", err)
+		}
+		if deployment.Status == "cancelled" {
+			return nil, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("deployment %s was cancelled", input.DeploymentID), "deployment_cancelled", nil)
+		}
+		var fromSnapshot bool
+		manifest, fromSnapshot, err = a.deploymentManifest(ctx, deployment, input.CommitSHA)
+		if err != nil {
+			return nil, err
+		}
+		applyManifest(manifest, &id.Service, &bc)
+		if manifest != nil && !fromSnapshot {
+			if err := a.recordEffectiveConfig(ctx, input.DeploymentID, id.Service, bc, manifest); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Prefer port resolved during build phase (carries EXPOSE detection).
 	// Fall back to DB value for in-flight workflows that predate the Port field.
 	appPort := input.Port
@@ -29,6 +61,10 @@ func (a *Activities) Deploy(ctx context.Context, input DeployInput) (*DeployResu
 	}
 	port := effectiveAppPort(id.Service.BuildPack, appPort, bc.PublishDirectory)
 	portInt := ParsePortString(port)
+	var healthCheck *HealthCheck
+	if manifest != nil {
+		healthCheck = manifest.HealthCheck
+	}
 
 	envVars := parseEnvVars(id.Service.EnvVars)
 	envVars["PORT"] = port
@@ -43,22 +79,66 @@ func (a *Activities) Deploy(ctx context.Context, input DeployInput) (*DeployResu
 		return nil, fmt.Errorf("apply secret: %w", err)
 	}
 
-	// Apply Deployment
-	if err := a.applyDeployment(ctx, id.Namespace, id.Name, input.ImageRef, portInt, id.Service.Memory, id.Service.Vcpus); err != nil {
+	extraPorts := ParseServicePorts(id.Service.Ports)
+
+	// Apply registry pull secret for prebuilt images from private registries
+	pullSecret, err := a.applyPullSecret(ctx, id.Namespace, id.Name, id.Service)
+	if err != nil {
+		return nil, fmt.Errorf("apply pull secret: %w", err)
+	}
+
+	// Apply Deployment. A stopped service takes the new image but stays at
+	// zero replicas until it is started again.
+	replicas := int32(1)
+	if id.Service.StoppedAt.Valid {
+		replicas = 0
+	}
+	if err := a.applyDeployment(ctx, id.Namespace, id.Name, input.ImageRef, portInt, extraPorts, id.Service.Memory, id.Service.Vcpus, pullSecret, healthCheck, replicas); err != nil {
 		return nil, fmt.Errorf("apply deployment: %w", err)
 	}
+	if err := clearSleepingAnnotation(ctx, a.k8s, id.Namespace, id.Name); err != nil {
+		a.logger.Warn("Failed to clear sleeping annotation", "serviceID", input.ServiceID, "error", err)
+	}
 
 	// Apply Service
-	if err := a.applyService(ctx, id.Namespace, id.Name, portInt); err != nil {
+	if err := a.applyService(ctx, id.Namespace, id.Name, servicePort, portInt); err != nil {
 		return nil, fmt.Errorf("apply service: %w", err)
 	}
 
+	// Apply NodePort Service for TCP/UDP ports
+	if err := a.applyExternalPorts(ctx, id.Namespace, id.Name, extraPorts); err != nil {
+		return nil, fmt.Errorf("apply external ports: %w", err)
+	}
+
+	// Apply access policy middlewares
+	policy, err := ParseAccessPolicy(id.Service.AccessPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.applyAccessPolicy(ctx, id.Namespace, id.Name, policy); err != nil {
+		return nil, fmt.Errorf("apply access policy: %w", err)
+	}
+	middlewares := AccessMiddlewares(id.Name, policy)
+
+	// Errors middleware that routes requests for a sleeping service to the waker
+	if err := a.applyWakeMiddleware(ctx, id.Namespace, id.Name, id.Service.SleepAfterIdle); err != nil {
+		return nil, fmt.Errorf("apply wake middleware: %w", err)
+	}
+	middlewares = append(middlewares, WakeMiddlewares(id.Name, id.Service.SleepAfterIdle)...)
+
 	// Apply Ingress
 	host := fmt.Sprintf("%s.%s", id.Name, input.AppsDomain)
-	if err := a.applyIngress(ctx, id.Namespace, id.Name, host, portInt); err != nil {
+	if err := a.applyIngress(ctx, id.Namespace, id.Name, host, servicePort, middlewares); err != nil {
 		return nil, fmt.Errorf("apply ingress: %w", err)
 	}
 
+	// Custom domain routes are owned by the DNS worker; keep their
+	// middleware chain in step with the current policy.
+	if err := a.syncCustomDomainMiddlewares(ctx, id.Namespace, id.Name, middlewares); err != nil {
+		a.logger.Warn("Failed to sync custom domain middlewares",
+			"serviceID", input.ServiceID, "error", err)
+	}
+
 	url := fmt.Sprintf("https://%s", host)
 	a.logger.Info("Deploy completed",
 		"serviceID", input.ServiceID,
@@ -127,23 +207,25 @@ func (a *Activities) applySecret(ctx context.Context, namespace, name string, en
 	return err
 }
 
-func (a *Activities) applyDeployment(ctx context.Context, namespace, name, imageRef string, port int32, memory, vcpus string) error {
+func (a *Activities) applyDeployment(ctx context.Context, namespace, name, imageRef string, port int32, extraPorts []ServicePort, memory, vcpus, pullSecret string, healthCheck *HealthCheck, replicas int32) error {
 	if err := validateResourceLimits(memory, vcpus); err != nil {
 		return err
 	}
-	deployment := buildDeployment(namespace, name, imageRef, port, memory, vcpus)
+	deployment := buildDeployment(namespace, name, imageRef, port, extraPorts, memory, vcpus, pullSecret, healthCheck, replicas)
 	data, err := json.Marshal(deployment)
 	if err != nil {
 		return fmt.Errorf("marshal deployment: %w", err)
 	}
+	// Force takes replicas back from the idle sleeper so a new rollout
+	// always wakes a sleeping service.
 	_, err = a.k8s.AppsV1().Deployments(namespace).Patch(ctx, name,
 		types.ApplyPatchType, data,
-		metav1.PatchOptions{FieldManager: "temporal-worker"})
+		metav1.PatchOptions{FieldManager: "temporal-worker", Force: ptr.To(true)})
 	return err
 }
 
-func (a *Activities) applyService(ctx context.Context, namespace, name string, port int32) error {
-	svc := buildService(namespace, name, port)
+func (a *Activities) applyService(ctx context.Context, namespace, name string, port, targetPort int32) error {
+	svc := buildService(namespace, name, port, targetPort)
 	data, err := json.Marshal(svc)
 	if err != nil {
 		return fmt.Errorf("marshal service: %w", err)
@@ -154,8 +236,51 @@ func (a *Activities) applyService(ctx context.Context, namespace, name string, p
 	return err
 }
 
-func (a *Activities) applyIngress(ctx context.Context, namespace, name, host string, port int32) error {
-	ingress := buildIngress(namespace, name, host, port)
+func (a *Activities) applyExternalPorts(ctx context.Context, namespace, name string, ports []ServicePort) error {
+	if len(ports) == 0 {
+		return a.deleteExternalPorts(ctx, namespace, name)
+	}
+
+	svc := buildExternalService(namespace, name, ports)
+	data, err := json.Marshal(svc)
+	if err != nil {
+		return fmt.Errorf("marshal external service: %w", err)
+	}
+	_, err = a.k8s.CoreV1().Services(namespace).Patch(ctx, svc.Name,
+		types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: "temporal-worker"})
+	if err != nil {
+		return fmt.Errorf("apply external service: %w", err)
+	}
+
+	np := buildExternalNetworkPolicy(namespace, name, ports)
+	data, err = json.Marshal(np)
+	if err != nil {
+		return fmt.Errorf("marshal external network policy: %w", err)
+	}
+	_, err = a.k8s.NetworkingV1().NetworkPolicies(namespace).Patch(ctx, np.Name,
+		types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: "temporal-worker"})
+	if err != nil {
+		return fmt.Errorf("apply external network policy: %w", err)
+	}
+	return nil
+}
+
+func (a *Activities) deleteExternalPorts(ctx context.Context, namespace, name string) error {
+	err := a.k8s.CoreV1().Services(namespace).Delete(ctx, externalServiceName(name), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete external service: %w", err)
+	}
+	err = a.k8s.NetworkingV1().NetworkPolicies(namespace).Delete(ctx, externalServiceName(name), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete external network policy: %w", err)
+	}
+	return nil
+}
+
+func (a *Activities) applyIngress(ctx context.Context, namespace, name, host string, port int32, middlewares []string) error {
+	ingress := buildIngress(namespace, name, host, port, middlewares)
 	data, err := json.Marshal(ingress)
 	if err != nil {
 		return fmt.Errorf("marshal ingress: %w", err)
@@ -165,3 +290,92 @@ func (a *Activities) applyIngress(ctx context.Context, namespace, name, host str
 		metav1.PatchOptions{FieldManager: "temporal-worker"})
 	return err
 }
+
+func (a *Activities) applyAccessPolicy(ctx context.Context, namespace, name string, policy AccessPolicy) error {
+	if len(policy.BasicAuth) > 0 {
+		secret := buildBasicAuthSecret(namespace, name, policy.BasicAuth)
+		data, err := json.Marshal(secret)
+		if err != nil {
+			return fmt.Errorf("marshal basic auth secret: %w", err)
+		}
+		if _, err := a.k8s.CoreV1().Secrets(namespace).Patch(ctx, secret.Name,
+			types.ApplyPatchType, data,
+			metav1.PatchOptions{FieldManager: "temporal-worker"}); err != nil {
+			return fmt.Errorf("apply basic auth secret: %w", err)
+		}
+		if err := a.applyMiddleware(ctx, buildBasicAuthMiddleware(namespace, name)); err != nil {
+			return err
+		}
+	} else {
+		if err := a.deleteMiddleware(ctx, namespace, basicAuthMiddlewareName(name)); err != nil {
+			return err
+		}
+		err := a.k8s.CoreV1().Secrets(namespace).Delete(ctx, basicAuthSecretName(name), metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("delete basic auth secret: %w", err)
+		}
+	}
+
+	if len(policy.IPAllowList) > 0 {
+		return a.applyMiddleware(ctx, buildIPAllowListMiddleware(namespace, name, policy.IPAllowList))
+	}
+	return a.deleteMiddleware(ctx, namespace, ipAllowListMiddlewareName(name))
+}
+
+func (a *Activities) applyMiddleware(ctx context.Context, mw *unstructured.Unstructured) error {
+	data, err := json.Marshal(mw)
+	if err != nil {
+		return fmt.Errorf("marshal middleware: %w", err)
+	}
+	_, err = a.dynClient.Resource(middlewareGVR).Namespace(mw.GetNamespace()).Patch(ctx, mw.GetName(),
+		types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: "temporal-worker"})
+	if err != nil {
+		return fmt.Errorf("apply middleware %s: %w", mw.GetName(), err)
+	}
+	return nil
+}
+
+func (a *Activities) deleteMiddleware(ctx context.Context, namespace, name string) error {
+	err := a.dynClient.Resource(middlewareGVR).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete middleware %s: %w", name, err)
+	}
+	return nil
+}
+
+// syncCustomDomainMiddlewares rewrites the middleware chain on the service's
+// custom domain IngressRoute (<name>-dz), if one exists.
+func (a *Activities) syncCustomDomainMiddlewares(ctx context.Context, namespace, name string, middlewares []string) error {
+	routes := a.dynClient.Resource(ingressRouteGVR).Namespace(namespace)
+	ir, err := routes.Get(ctx, name+"-dz", metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get custom domain ingressroute: %w", err)
+	}
+
+	chain := []any{map[string]any{"name": "redirect-https"}}
+	for _, mw := range middlewares {
+		chain = append(chain, map[string]any{"name": mw})
+	}
+
+	specRoutes, _, _ := unstructured.NestedSlice(ir.Object, "spec", "routes")
+	for i, r := range specRoutes {
+		route, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		route["middlewares"] = chain
+		specRoutes[i] = route
+	}
+	if err := unstructured.SetNestedSlice(ir.Object, specRoutes, "spec", "routes"); err != nil {
+		return fmt.Errorf("set ingressroute routes: %w", err)
+	}
+
+	if _, err := routes.Update(ctx, ir, metav1.UpdateOptions{FieldManager: "temporal-worker"}); err != nil {
+		return fmt.Errorf("update custom domain ingressroute: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package k8sdeployments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/distribution/reference"
+	"go.temporal.io/sdk/temporal"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// BuildPackImage deploys a prebuilt container image; nothing is cloned or
+// built, the tag is pinned to a digest at deploy time instead.
+const BuildPackImage = "image"
+
+// ResolveImage pins the service's image to the digest its tag points at
+// right now. The digest reference is recorded as the deployment's image_ref
+// so that a rollback runs exactly the same image.
+func (a *Activities) ResolveImage(ctx context.Context, input ResolveImageInput) (*ResolveImageResult, error) {
+	a.logger.Info("ResolveImage activity started", "serviceID", input.ServiceID)
+
+	svc, err := a.servicesQ.GetServiceByID(ctx, input.ServiceID)
+	if err != nil {
+		return nil, fmt.Errorf("get service: %w", err)
+	}
+	if svc.Image == nil || *svc.Image == "" {
+		return nil, temporal.NewNonRetryableApplicationError(
+			"service has no image configured",
+			"image_missing",
+			nil,
+		)
+	}
+
+	creds, err := a.registryCredentials(svc)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "registry_credentials_invalid", err)
+	}
+
+	imageRef, err := ResolveImageDigest(ctx, *svc.Image, creds, a.config)
+	if errors.Is(err, ErrRegistryNotAllowed) {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "registry_not_allowed", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolve image digest: %w", err)
+	}
+
+	a.logger.Info("Resolved image", "serviceID", input.ServiceID, "image", *svc.Image, "imageRef", imageRef)
+	return &ResolveImageResult{ImageRef: imageRef}, nil
+}
+
+// CheckImage verifies that a user image exists and can be pulled with the
+// given credentials. It goes through the same registry restrictions as
+// ResolveImage, so create_service and update_service can fail early without
+// the API contacting registries itself.
+func (a *Activities) CheckImage(ctx context.Context, input CheckImageInput) error {
+	var creds *RegistryCredentials
+	if input.RegistryCredentials != nil && *input.RegistryCredentials != "" {
+		var err error
+		creds, err = DecryptRegistryCredentials(*input.RegistryCredentials, a.authConfig.APIKeyEncryptionKey)
+		if err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "registry_credentials_invalid", err)
+		}
+	}
+
+	_, err := ResolveImageDigest(ctx, input.Image, creds, a.config)
+	if errors.Is(err, ErrRegistryNotAllowed) {
+		return temporal.NewNonRetryableApplicationError(err.Error(), "registry_not_allowed", err)
+	}
+	return err
+}
+
+func (a *Activities) registryCredentials(svc services.Service) (*RegistryCredentials, error) {
+	if svc.RegistryCredentials == nil || *svc.RegistryCredentials == "" {
+		return nil, nil
+	}
+	return DecryptRegistryCredentials(*svc.RegistryCredentials, a.authConfig.APIKeyEncryptionKey)
+}
+
+// applyPullSecret keeps the <name>-registry Secret in line with the
+// service's registry credentials and returns its name, or "" when the image
+// is pulled anonymously.
+func (a *Activities) applyPullSecret(ctx context.Context, namespace, name string, svc services.Service) (string, error) {
+	var creds *RegistryCredentials
+	if svc.BuildPack == BuildPackImage && svc.Image != nil {
+		var err error
+		creds, err = a.registryCredentials(svc)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if creds == nil {
+		err := a.k8s.CoreV1().Secrets(namespace).Delete(ctx, pullSecretName(name), metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("delete pull secret: %w", err)
+		}
+		return "", nil
+	}
+
+	named, err := ParseImage(*svc.Image)
+	if err != nil {
+		return "", err
+	}
+	secret, err := buildPullSecret(namespace, name, reference.Domain(named), *creds)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(secret)
+	if err != nil {
+		return "", fmt.Errorf("marshal pull secret: %w", err)
+	}
+	if _, err := a.k8s.CoreV1().Secrets(namespace).Patch(ctx, secret.Name,
+		types.ApplyPatchType, data,
+		metav1.PatchOptions{FieldManager: "temporal-worker"}); err != nil {
+		return "", err
+	}
+	return secret.Name, nil
+}
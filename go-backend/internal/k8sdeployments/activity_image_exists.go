@@ -3,10 +3,7 @@ package k8sdeployments
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"strings"
-	"time"
 
 	"github.com/distribution/reference"
 )
@@ -24,53 +21,11 @@ func (a *Activities) ImageExists(ctx context.Context, imageRef string) (bool, er
 		return false, err
 	}
 
-	url := fmt.Sprintf("%s/v2/%s/manifests/%s", baseURL, repository, tag)
-
-	client := &http.Client{Timeout: 8 * time.Second}
-	headReq, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
-	if err != nil {
-		return false, fmt.Errorf("create HEAD request: %w", err)
-	}
-	headReq.Header.Set("Accept", registryManifestAccept)
-
-	resp, err := client.Do(headReq)
+	result, err := newRegistryClient(nil).headManifest(ctx, baseURL, repository, tag)
 	if err != nil {
-		return false, fmt.Errorf("registry HEAD manifest: %w", err)
-	}
-	defer resp.Body.Close()
-
-	switch resp.StatusCode {
-	case http.StatusOK:
-		return true, nil
-	case http.StatusNotFound:
-		return false, nil
-	case http.StatusMethodNotAllowed:
-		// Fallback for registries that don't support HEAD.
-	default:
-		return false, fmt.Errorf("registry HEAD manifest unexpected status: %d", resp.StatusCode)
-	}
-
-	getReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return false, fmt.Errorf("create GET request: %w", err)
-	}
-	getReq.Header.Set("Accept", registryManifestAccept)
-
-	getResp, err := client.Do(getReq)
-	if err != nil {
-		return false, fmt.Errorf("registry GET manifest: %w", err)
-	}
-	defer getResp.Body.Close()
-	_, _ = io.Copy(io.Discard, getResp.Body)
-
-	switch getResp.StatusCode {
-	case http.StatusOK:
-		return true, nil
-	case http.StatusNotFound:
-		return false, nil
-	default:
-		return false, fmt.Errorf("registry GET manifest unexpected status: %d", getResp.StatusCode)
+		return false, err
 	}
+	return result.Exists, nil
 }
 
 func resolveRegistryManifestTarget(registryHost, imageRef string) (repository, tag, baseURL string, _ error) {
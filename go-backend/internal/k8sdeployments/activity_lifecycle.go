@@ -0,0 +1,68 @@
+package k8sdeployments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ApplyServiceLifecycle patches the running Deployment for a stop, start or
+// restart and records the resulting state on the service row.
+func (a *Activities) ApplyServiceLifecycle(ctx context.Context, input ServiceLifecycleWorkflowInput) error {
+	patch, err := lifecyclePatch(input.Action, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.k8s.AppsV1().Deployments(input.Namespace).Patch(ctx, input.Name,
+		types.MergePatchType, patch,
+		metav1.PatchOptions{FieldManager: "service-lifecycle"}); err != nil {
+		return fmt.Errorf("%s deployment: %w", input.Action, err)
+	}
+
+	switch input.Action {
+	case LifecycleStop:
+		if err := a.servicesQ.SetServiceStopped(ctx, input.ServiceID); err != nil {
+			return fmt.Errorf("mark service stopped: %w", err)
+		}
+	case LifecycleStart:
+		if err := a.servicesQ.ClearServiceStopped(ctx, input.ServiceID); err != nil {
+			return fmt.Errorf("clear service stopped: %w", err)
+		}
+		if err := a.servicesQ.ClearServiceSleeping(ctx, input.ServiceID); err != nil {
+			return fmt.Errorf("clear service sleeping: %w", err)
+		}
+	}
+
+	a.logger.Info("Applied service lifecycle action",
+		"serviceID", input.ServiceID,
+		"action", input.Action,
+		"namespace", input.Namespace,
+		"name", input.Name)
+	return nil
+}
+
+func (a *Activities) MarkServiceEventCompleted(ctx context.Context, input MarkServiceEventCompletedInput) error {
+	if err := a.deploymentsQ.MarkServiceEventCompleted(ctx, input.EventID); err != nil {
+		return fmt.Errorf("mark service event completed: %w", err)
+	}
+	a.logger.Info("Service event → completed", "eventID", input.EventID)
+	return nil
+}
+
+func (a *Activities) MarkServiceEventFailed(ctx context.Context, input MarkServiceEventFailedInput) error {
+	if err := a.deploymentsQ.MarkServiceEventFailed(ctx, deploymentsdb.MarkServiceEventFailedParams{
+		ID:           input.EventID,
+		ErrorMessage: &input.ErrorMessage,
+	}); err != nil {
+		return fmt.Errorf("mark service event failed: %w", err)
+	}
+	a.logger.Info("Service event → failed",
+		"eventID", input.EventID,
+		"error", input.ErrorMessage)
+	return nil
+}
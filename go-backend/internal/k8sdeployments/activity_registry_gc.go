@@ -0,0 +1,220 @@
+package k8sdeployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
+	"github.com/distribution/reference"
+	"github.com/jackc/pgx/v5"
+)
+
+// FindRegistryGCTargets lists, per service in the region, the in-cluster
+// registry repositories to clean and the image refs to keep: the active
+// deployment's image plus the last RegistryKeepImages successful ones.
+// Deleted services get their image and cache repositories purged.
+func (a *Activities) FindRegistryGCTargets(ctx context.Context, input FindRegistryGCTargetsInput) ([]RegistryGCTarget, error) {
+	candidates, err := a.servicesQ.ListRegistryGCCandidatesByRegion(ctx, input.Region)
+	if err != nil {
+		return nil, fmt.Errorf("list registry gc candidates: %w", err)
+	}
+
+	type candidate struct {
+		serviceID  string
+		repository string
+		deleted    bool
+	}
+	var resolved []candidate
+	live := map[string]bool{}
+	for _, svc := range candidates {
+		id, err := a.serviceIdentityFor(ctx, svc)
+		if err != nil {
+			a.logger.Warn("Failed to resolve registry gc candidate", "serviceID", svc.ID, "error", err)
+			continue
+		}
+		repository := id.Namespace + "/" + id.Name
+		if !svc.IsDeleted {
+			live[repository] = true
+		}
+		resolved = append(resolved, candidate{serviceID: svc.ID, repository: repository, deleted: svc.IsDeleted})
+	}
+
+	var targets []RegistryGCTarget
+	for _, c := range resolved {
+		if c.deleted {
+			target := RegistryGCTarget{ServiceID: c.serviceID, Purge: true}
+			// A new service with the same name took over the repository
+			if !live[c.repository] {
+				target.Repositories = []string{c.repository, "cache/" + c.repository}
+			}
+			targets = append(targets, target)
+			continue
+		}
+
+		keep, err := a.retainedImageRefs(ctx, c.serviceID, c.repository)
+		if err != nil {
+			a.logger.Warn("Failed to list retained images", "serviceID", c.serviceID, "error", err)
+			continue
+		}
+		targets = append(targets, RegistryGCTarget{
+			ServiceID:    c.serviceID,
+			Repositories: []string{c.repository},
+			Keep:         keep,
+		})
+	}
+
+	a.logger.Info("Found registry gc targets",
+		"region", input.Region,
+		"candidates", len(candidates),
+		"targets", len(targets))
+	return targets, nil
+}
+
+// retainedImageRefs returns the tags and digests in repository that recent
+// deployments of the service point at.
+func (a *Activities) retainedImageRefs(ctx context.Context, serviceID, repository string) ([]string, error) {
+	var imageRefs []string
+	active, err := a.deploymentsQ.GetActiveDeploymentByServiceID(ctx, serviceID)
+	switch {
+	case err == nil:
+		if active.ImageRef != nil {
+			imageRefs = append(imageRefs, *active.ImageRef)
+		}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("get active deployment: %w", err)
+	}
+
+	recent, err := a.deploymentsQ.ListRecentImageRefsByServiceID(ctx, deploymentsdb.ListRecentImageRefsByServiceIDParams{
+		ServiceID: serviceID,
+		Limit:     int32(max(a.config.RegistryKeepImages, 0)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list recent image refs: %w", err)
+	}
+	for _, ref := range recent {
+		if ref != nil {
+			imageRefs = append(imageRefs, *ref)
+		}
+	}
+
+	var keep []string
+	for _, imageRef := range imageRefs {
+		named, err := reference.ParseNormalizedNamed(imageRef)
+		if err != nil || reference.Path(named) != repository {
+			continue
+		}
+		switch r := named.(type) {
+		case reference.Digested:
+			keep = append(keep, r.Digest().String())
+		case reference.Tagged:
+			keep = append(keep, r.Tag())
+		}
+	}
+	return keep, nil
+}
+
+// CollectRegistryGarbage deletes the manifests of a target's repositories
+// that are not kept, and marks deleted services as purged.
+func (a *Activities) CollectRegistryGarbage(ctx context.Context, target RegistryGCTarget) (*CollectRegistryGarbageResult, error) {
+	result := &CollectRegistryGarbageResult{}
+	baseURL := strings.TrimRight(strings.TrimSpace(a.config.RegistryHost), "/")
+	if baseURL == "" {
+		a.logger.Info("Registry gc disabled: no registry host", "serviceID", target.ServiceID)
+		return result, nil
+	}
+
+	// A running build may have pushed a tag no deployment points at yet
+	if !target.Purge {
+		latest, err := a.deploymentsQ.GetLatestDeploymentByServiceID(ctx, target.ServiceID)
+		if err == nil && isDeploymentInFlight(latest.Status) {
+			a.logger.Info("Skipping registry gc: deployment in flight", "serviceID", target.ServiceID, "deploymentID", latest.ID)
+			return result, nil
+		}
+	}
+
+	client := newRegistryClient(nil)
+	for _, repository := range target.Repositories {
+		deleted, reclaimed, err := a.collectRepository(ctx, client, baseURL, repository, target.Keep)
+		if err != nil {
+			return nil, fmt.Errorf("collect %s: %w", repository, err)
+		}
+		result.ManifestsDeleted += deleted
+		result.BytesReclaimed += reclaimed
+	}
+
+	if target.Purge {
+		if err := a.servicesQ.MarkServiceRegistryPurged(ctx, target.ServiceID); err != nil {
+			return nil, fmt.Errorf("mark service registry purged: %w", err)
+		}
+	}
+
+	if result.ManifestsDeleted > 0 {
+		a.logger.Info("Registry gc collected service images",
+			"serviceID", target.ServiceID,
+			"purge", target.Purge,
+			"manifestsDeleted", result.ManifestsDeleted,
+			"bytesReclaimed", result.BytesReclaimed)
+	}
+	return result, nil
+}
+
+func (a *Activities) collectRepository(ctx context.Context, client *registryClient, baseURL, repository string, keep []string) (int, int64, error) {
+	tags, err := client.listTags(ctx, baseURL, repository)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(tags) == 0 {
+		return 0, 0, nil
+	}
+
+	tagDigests := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		recordHeartbeat(ctx, repository, tag)
+		res, err := client.headManifest(ctx, baseURL, repository, tag)
+		if err != nil {
+			return 0, 0, err
+		}
+		if res.Exists {
+			tagDigests[tag] = res.Digest
+		}
+	}
+
+	remove, kept := planRegistryGC(tagDigests, keep)
+	if len(remove) == 0 {
+		return 0, 0, nil
+	}
+
+	// Sizes are best effort; a failed lookup only understates the metric
+	keptBlobs := map[string]int64{}
+	for _, digest := range tagDigests {
+		if kept[digest] {
+			if err := client.manifestBlobs(ctx, baseURL, repository, digest, keptBlobs); err != nil {
+				a.logger.Warn("Failed to size kept manifest", "repository", repository, "digest", digest, "error", err)
+			}
+		}
+	}
+
+	removedBlobs := map[string]int64{}
+	deleted := 0
+	for _, digest := range remove {
+		recordHeartbeat(ctx, repository, digest)
+		if err := client.manifestBlobs(ctx, baseURL, repository, digest, removedBlobs); err != nil {
+			a.logger.Warn("Failed to size manifest", "repository", repository, "digest", digest, "error", err)
+		}
+		if err := client.deleteManifest(ctx, baseURL, repository, digest); err != nil {
+			return 0, 0, err
+		}
+		deleted++
+	}
+	return deleted, reclaimableBytes(removedBlobs, keptBlobs), nil
+}
+
+func isDeploymentInFlight(status string) bool {
+	switch status {
+	case "queued", "building", "deploying":
+		return true
+	}
+	return false
+}
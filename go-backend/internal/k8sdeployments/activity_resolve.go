@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
 	"go.temporal.io/sdk/temporal"
@@ -16,13 +17,41 @@ import (
 func buildImageTag(commitSHA string, svc services.Service) string {
 	bc := parseBuildConfig(svc.BuildConfig)
 	envHash := hashEnvVarsRaw(svc.EnvVars)
-	if svc.BuildPack == "railpack" && bc.PublishDirectory == "" && bc.RootDirectory == "" && bc.BuildCommand == "" && bc.StartCommand == "" && envHash == "" {
+	if svc.BuildPack == "railpack" && bc.PublishDirectory == "" && bc.RootDirectory == "" && bc.BuildCommand == "" && bc.StartCommand == "" && envHash == "" && dockerfileOptionsKey(bc) == "" && bc.StaticSiteConfig.isZero() {
 		return commitSHA
 	}
-	h := sha256.Sum256([]byte(svc.BuildPack + "\x00" + bc.PublishDirectory + "\x00" + bc.RootDirectory + "\x00" + bc.DockerfilePath + "\x00" + bc.BuildCommand + "\x00" + bc.StartCommand + "\x00" + envHash))
+	key := svc.BuildPack + "\x00" + bc.PublishDirectory + "\x00" + bc.RootDirectory + "\x00" + bc.DockerfilePath + "\x00" + bc.BuildCommand + "\x00" + bc.StartCommand + "\x00" + envHash
+	// Only appended when set, so tags of services without them don't change
+	if opts := dockerfileOptionsKey(bc); opts != "" {
+		key += "\x00" + opts
+	}
+	if !bc.StaticSiteConfig.isZero() {
+		static, _ := json.Marshal(bc.StaticSiteConfig)
+		key += "\x00static=" + string(static)
+	}
+	h := sha256.Sum256([]byte(key))
 	return fmt.Sprintf("%s-%x", commitSHA, h[:4])
 }
 
+// dockerfileOptionsKey serializes the Dockerfile target, build args and
+// build context for buildImageTag; empty when none are set.
+func dockerfileOptionsKey(bc BuildConfig) string {
+	if bc.DockerfileTarget == "" && bc.BuildContext == "" && len(bc.BuildArgs) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(bc.BuildArgs))
+	for k := range bc.BuildArgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString("target=" + bc.DockerfileTarget + "\x00context=" + bc.BuildContext)
+	for _, k := range keys {
+		b.WriteString("\x00arg:" + k + "=" + bc.BuildArgs[k])
+	}
+	return b.String()
+}
+
 func hashEnvVarsRaw(raw json.RawMessage) string {
 	envVars := parseEnvVars(raw)
 	if len(envVars) == 0 {
@@ -98,9 +127,53 @@ func (a *Activities) ResolveBuildContext(ctx context.Context, input ResolveBuild
 		}
 	}
 
+	// build_context narrows or widens what a Dockerfile build can COPY from
+	buildContextPath := ""
+	if bc.BuildContext != "" {
+		buildContextPath = filepath.Join(effectiveSourcePath, bc.BuildContext)
+		if rel, err := filepath.Rel(input.SourcePath, buildContextPath); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("build_context %q is outside the repo", bc.BuildContext),
+				"invalid_build_context",
+				nil,
+			)
+		}
+		if _, err := os.Stat(buildContextPath); err != nil {
+			if os.IsNotExist(err) {
+				return nil, temporal.NewNonRetryableApplicationError(
+					fmt.Sprintf("build_context %q not found in repo", bc.BuildContext),
+					"source_path_missing",
+					err,
+				)
+			}
+			return nil, fmt.Errorf("stat build context: %w", err)
+		}
+	}
+
 	tag := buildImageTag(input.CommitSHA, id.Service)
 	imageRef := fmt.Sprintf("%s/%s/%s:%s", a.config.RegistryAddress, id.Namespace, id.Name, tag)
 
+	// ink.yaml (root_directory first, then the repo root) wins over the
+	// service row. The tag stays keyed on the row: the manifest is part of
+	// the commit.
+	manifest, err := loadManifest(effectiveSourcePath, input.SourcePath)
+	if err != nil {
+		return nil, err
+	}
+	applyManifest(manifest, &id.Service, &bc)
+	if missing := missingManifestEnv(manifest, parseEnvVars(id.Service.EnvVars)); len(missing) > 0 {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("%s requires env vars that are not set on the service: %s", ManifestFileName, strings.Join(missing, ", ")),
+			"invalid_manifest",
+			nil,
+		)
+	}
+	if manifest != nil && input.DeploymentID != "" {
+		if err := a.recordEffectiveConfig(ctx, input.DeploymentID, id.Service, bc, manifest); err != nil {
+			return nil, err
+		}
+	}
+
 	// Determine build pack
 	buildPack := id.Service.BuildPack
 	switch buildPack {
@@ -156,7 +229,8 @@ func (a *Activities) ResolveBuildContext(ctx context.Context, input ResolveBuild
 		"name", id.Name,
 		"buildPack", buildPack,
 		"imageRef", imageRef,
-		"effectiveSourcePath", effectiveSourcePath)
+		"effectiveSourcePath", effectiveSourcePath,
+		"manifest", manifest != nil)
 
 	return &ResolveBuildContextResult{
 		BuildPack:           buildPack,
@@ -168,8 +242,12 @@ func (a *Activities) ResolveBuildContext(ctx context.Context, input ResolveBuild
 		PublishDirectory:    bc.PublishDirectory,
 		EffectiveSourcePath: effectiveSourcePath,
 		DockerfilePath:      bc.DockerfilePath,
+		DockerfileTarget:    bc.DockerfileTarget,
+		BuildArgs:           bc.BuildArgs,
+		BuildContextPath:    buildContextPath,
 		BuildCommand:        bc.BuildCommand,
 		StartCommand:        bc.StartCommand,
+		StaticSite:          bc.StaticSiteConfig,
 	}, nil
 }
 
@@ -205,7 +283,12 @@ func (a *Activities) resolveServiceIdentity(ctx context.Context, serviceID strin
 	if err != nil {
 		return nil, fmt.Errorf("get service: %w", err)
 	}
+	return a.serviceIdentityFor(ctx, svc)
+}
 
+// serviceIdentityFor is resolveServiceIdentity for a row already at hand,
+// including soft-deleted services.
+func (a *Activities) serviceIdentityFor(ctx context.Context, svc services.Service) (*serviceIdentity, error) {
 	project, err := a.projectsQ.GetProjectByID(ctx, svc.ProjectID)
 	if err != nil {
 		return nil, fmt.Errorf("get project: %w", err)
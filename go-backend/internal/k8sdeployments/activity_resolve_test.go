@@ -155,3 +155,41 @@ func TestBuildImageTag_DockerfilePathDrivesTag(t *testing.T) {
 		t.Fatalf("dockerfile_path vs default should produce different tags")
 	}
 }
+
+func TestBuildImageTag_DockerfileOptionsDriveTag(t *testing.T) {
+	commit := "0123456789abcdef"
+	tagFor := func(bc BuildConfig) string {
+		return buildImageTag(commit, services.Service{BuildPack: "dockerfile", BuildConfig: mustMarshalBuildConfig(bc)})
+	}
+
+	base := tagFor(BuildConfig{})
+	if got := tagFor(BuildConfig{BuildArgs: map[string]string{}}); got != base {
+		t.Fatalf("empty build_args changed tag: %s != %s", got, base)
+	}
+
+	variants := []BuildConfig{
+		{DockerfileTarget: "prod"},
+		{DockerfileTarget: "dev"},
+		{BuildContext: ".."},
+		{BuildArgs: map[string]string{"VERSION": "1"}},
+		{BuildArgs: map[string]string{"VERSION": "2"}},
+	}
+	seen := map[string]int{}
+	for i, bc := range variants {
+		tag := tagFor(bc)
+		if tag == base {
+			t.Errorf("variant %d should differ from the default tag", i)
+		}
+		if j, ok := seen[tag]; ok {
+			t.Errorf("variants %d and %d produced the same tag %s", j, i, tag)
+		}
+		seen[tag] = i
+	}
+
+	a := tagFor(BuildConfig{BuildArgs: map[string]string{"A": "1", "B": "2"}})
+	for i := 0; i < 10; i++ {
+		if b := tagFor(BuildConfig{BuildArgs: map[string]string{"B": "2", "A": "1"}}); b != a {
+			t.Fatalf("build_args order changed tag: %s != %s", b, a)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package k8sdeployments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SyncProjectRoutes converges the namespace's path-routing IngressRoutes and
+// strip-prefix middlewares to input.Routes, deleting anything stale.
+func (a *Activities) SyncProjectRoutes(ctx context.Context, input SyncProjectRoutesInput) (*SyncProjectRoutesResult, error) {
+	a.logger.Info("SyncProjectRoutes activity started",
+		"projectID", input.ProjectID,
+		"namespace", input.Namespace,
+		"routes", len(input.Routes))
+
+	wantRoutes := make(map[string]bool)
+	wantMiddlewares := make(map[string]bool)
+
+	for _, r := range input.Routes {
+		if !r.StripPrefix || r.PathPrefix == "/" {
+			continue
+		}
+		mw := buildStripPrefixMiddleware(input.Namespace, r)
+		if err := a.applyMiddleware(ctx, mw); err != nil {
+			return nil, err
+		}
+		wantMiddlewares[mw.GetName()] = true
+	}
+
+	hosts, byHost := groupRoutesByHost(input.Routes)
+	for _, host := range hosts {
+		ir := buildRouteIngressRoute(input.Namespace, host, byHost[host])
+		data, err := json.Marshal(ir)
+		if err != nil {
+			return nil, fmt.Errorf("marshal route ingressroute: %w", err)
+		}
+		_, err = a.dynClient.Resource(ingressRouteGVR).Namespace(input.Namespace).Patch(ctx, ir.GetName(),
+			types.ApplyPatchType, data,
+			metav1.PatchOptions{FieldManager: "temporal-worker"})
+		if err != nil {
+			return nil, fmt.Errorf("apply route ingressroute %s: %w", ir.GetName(), err)
+		}
+		wantRoutes[ir.GetName()] = true
+	}
+
+	if err := a.pruneRouteObjects(ctx, ingressRouteGVR, input.Namespace, wantRoutes); err != nil {
+		return nil, err
+	}
+	if err := a.pruneRouteObjects(ctx, middlewareGVR, input.Namespace, wantMiddlewares); err != nil {
+		return nil, err
+	}
+
+	a.logger.Info("SyncProjectRoutes completed",
+		"projectID", input.ProjectID,
+		"namespace", input.Namespace,
+		"hosts", len(hosts))
+
+	return &SyncProjectRoutesResult{Hosts: len(hosts)}, nil
+}
+
+func (a *Activities) pruneRouteObjects(ctx context.Context, gvr schema.GroupVersionResource, namespace string, keep map[string]bool) error {
+	res := a.dynClient.Resource(gvr).Namespace(namespace)
+	list, err := res.List(ctx, metav1.ListOptions{LabelSelector: routesLabel + "=true"})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("list %s: %w", gvr.Resource, err)
+	}
+	for _, item := range list.Items {
+		if keep[item.GetName()] {
+			continue
+		}
+		if err := res.Delete(ctx, item.GetName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("delete %s %s: %w", gvr.Resource, item.GetName(), err)
+		}
+	}
+	return nil
+}
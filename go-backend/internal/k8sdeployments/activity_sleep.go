@@ -0,0 +1,137 @@
+package k8sdeployments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+func (a *Activities) FindIdleServices(ctx context.Context, input FindIdleServicesInput) ([]IdleService, error) {
+	if a.prometheus == nil {
+		a.logger.Info("Idle sleeping disabled: no prometheus client", "region", input.Region)
+		return nil, nil
+	}
+
+	candidates, err := a.servicesQ.ListSleepCandidatesByRegion(ctx, input.Region)
+	if err != nil {
+		return nil, fmt.Errorf("list sleep candidates: %w", err)
+	}
+
+	var idle []IdleService
+	for _, svc := range candidates {
+		if svc.SleepAfterIdle == nil {
+			continue
+		}
+		window := time.Duration(*svc.SleepAfterIdle) * time.Second
+
+		// A fresh rollout has had no chance to receive traffic yet.
+		active, err := a.deploymentsQ.GetActiveDeploymentByServiceID(ctx, svc.ID)
+		if err != nil {
+			continue
+		}
+		if active.FinishedAt.Valid && time.Since(active.FinishedAt.Time) < window {
+			continue
+		}
+
+		id, err := a.resolveServiceIdentity(ctx, svc.ID)
+		if err != nil {
+			a.logger.Warn("Failed to resolve sleep candidate", "serviceID", svc.ID, "error", err)
+			continue
+		}
+
+		// Never sleep a service on missing data: a failed query or no series
+		// at all (never scraped, metric renamed) skips it.
+		requests, ok, err := a.prometheus.GetServiceRequestCount(ctx, id.Namespace, id.Name, window)
+		if err != nil {
+			a.logger.Warn("Failed to read request count", "serviceID", svc.ID, "error", err)
+			continue
+		}
+		if !ok {
+			a.logger.Info("No request metrics for sleep candidate", "serviceID", svc.ID)
+			continue
+		}
+		if requests > 0 {
+			continue
+		}
+
+		idle = append(idle, IdleService{
+			ServiceID: svc.ID,
+			Namespace: id.Namespace,
+			Name:      id.Name,
+		})
+	}
+
+	a.logger.Info("Found idle services",
+		"region", input.Region,
+		"candidates", len(candidates),
+		"idle", len(idle))
+	return idle, nil
+}
+
+// SleepService scales the Deployment to zero and marks it for the waker.
+// Everything else (Service, Ingress, middlewares) stays in place so the
+// first request can find its way to the waker.
+func (a *Activities) SleepService(ctx context.Context, input SleepServiceInput) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				sleepingAnnotation:  time.Now().UTC().Format(time.RFC3339),
+				serviceIDAnnotation: input.ServiceID,
+			},
+		},
+		"spec": map[string]any{
+			"replicas": 0,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal sleep patch: %w", err)
+	}
+
+	if _, err := a.k8s.AppsV1().Deployments(input.Namespace).Patch(ctx, input.Name,
+		types.MergePatchType, patch,
+		metav1.PatchOptions{FieldManager: "idle-sleeper"}); err != nil {
+		return fmt.Errorf("scale deployment to zero: %w", err)
+	}
+
+	if err := a.servicesQ.MarkServiceSleeping(ctx, input.ServiceID); err != nil {
+		return fmt.Errorf("mark service sleeping: %w", err)
+	}
+
+	a.logger.Info("Service → sleeping",
+		"serviceID", input.ServiceID,
+		"namespace", input.Namespace,
+		"name", input.Name)
+	return nil
+}
+
+// clearSleepingAnnotation drops the waker marker once a Deployment is
+// running again, so a later scale-down by anything but the sleeper is not
+// undone by the waker.
+func clearSleepingAnnotation(ctx context.Context, k8s kubernetes.Interface, namespace, name string) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				sleepingAnnotation: nil,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal wake patch: %w", err)
+	}
+	_, err = k8s.AppsV1().Deployments(namespace).Patch(ctx, name,
+		types.MergePatchType, patch,
+		metav1.PatchOptions{FieldManager: "idle-sleeper"})
+	return err
+}
+
+func (a *Activities) applyWakeMiddleware(ctx context.Context, namespace, name string, sleepAfterIdle *int32) error {
+	if sleepAfterIdle == nil {
+		return a.deleteMiddleware(ctx, namespace, wakeMiddlewareName(name))
+	}
+	return a.applyMiddleware(ctx, buildWakeMiddleware(namespace, name, a.config))
+}
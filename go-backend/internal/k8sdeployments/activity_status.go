@@ -24,6 +24,25 @@ func (a *Activities) UpdateDeploymentDeploying(ctx context.Context, input Update
 	return nil
 }
 
+func (a *Activities) MarkDeploymentCancelled(ctx context.Context, input MarkDeploymentCancelledInput) error {
+	if err := a.deploymentsQ.MarkDeploymentCancelled(ctx, input.DeploymentID); err != nil {
+		return fmt.Errorf("mark deployment cancelled: %w", err)
+	}
+	a.logger.Info("Deployment status → cancelled", "deploymentID", input.DeploymentID)
+	return nil
+}
+
+// RecordDeploymentRunID stores the run of a deploy the coordinator started.
+func (a *Activities) RecordDeploymentRunID(ctx context.Context, input RecordDeploymentRunIDInput) error {
+	if err := a.deploymentsQ.UpdateDeploymentWorkflowRunID(ctx, deploymentsdb.UpdateDeploymentWorkflowRunIDParams{
+		ID:            input.DeploymentID,
+		WorkflowRunID: &input.RunID,
+	}); err != nil {
+		return fmt.Errorf("update deployment workflow run id: %w", err)
+	}
+	return nil
+}
+
 func (a *Activities) MarkDeploymentActive(ctx context.Context, input MarkDeploymentActiveInput) error {
 	// Supersede the currently-active deployment (if any)
 	if err := a.deploymentsQ.SupersedeActiveDeployment(ctx, input.ServiceID); err != nil {
@@ -47,6 +66,12 @@ func (a *Activities) MarkDeploymentActive(ctx context.Context, input MarkDeploym
 		return fmt.Errorf("set current deployment id: %w", err)
 	}
 
+	// A fresh rollout wakes a sleeping service. A stopped one was deployed
+	// at zero replicas and stays stopped until it is started.
+	if err := a.servicesQ.ClearServiceSleeping(ctx, input.ServiceID); err != nil {
+		a.logger.Warn("Failed to clear sleeping state", "serviceID", input.ServiceID, "error", err)
+	}
+
 	// Update service FQDN
 	if input.URL != "" {
 		if err := a.servicesQ.SetServiceFQDN(ctx, services.SetServiceFQDNParams{
@@ -118,6 +143,10 @@ func (a *Activities) MarkDeploymentRemoved(ctx context.Context, input MarkDeploy
 }
 
 func (a *Activities) SoftDeleteService(ctx context.Context, serviceID string) error {
+	// The NodePort service is gone by now, so its public ports can be reused.
+	if err := a.servicesQ.ReleaseServicePorts(ctx, serviceID); err != nil {
+		return fmt.Errorf("release service ports: %w", err)
+	}
 	_, err := a.servicesQ.SoftDeleteService(ctx, serviceID)
 	if err != nil {
 		return fmt.Errorf("soft delete service: %w", err)
@@ -8,6 +8,17 @@ type BuildConfig struct {
 	PublishDirectory string `json:"publish_directory,omitempty"`
 	BuildCommand     string `json:"build_command,omitempty"`
 	StartCommand     string `json:"start_command,omitempty"`
+	// WatchPaths are globs relative to the repo root; a push redeploys the
+	// service only if it changes a matching file.
+	WatchPaths []string `json:"watch_paths,omitempty"`
+	// Dockerfile builds only. BuildContext is relative to RootDirectory and
+	// may reach above it (e.g. ".." for shared code) but not out of the repo;
+	// DockerfilePath stays relative to RootDirectory.
+	DockerfileTarget string            `json:"dockerfile_target,omitempty"`
+	BuildArgs        map[string]string `json:"build_args,omitempty"`
+	BuildContext     string            `json:"build_context,omitempty"`
+	// Static sites (build_pack=static or railpack with publish_directory)
+	StaticSiteConfig
 }
 
 func parseBuildConfig(raw []byte) BuildConfig {
@@ -20,6 +20,11 @@ type buildkitSolveOpts struct {
 	CacheRef       string
 	LokiLogger     *LokiLogger
 	DockerfilePath string
+	// Dockerfile builds: ContextPath is the build context when it differs
+	// from SourcePath, which then only supplies the Dockerfile
+	ContextPath string
+	Target      string
+	BuildArgs   map[string]string
 }
 
 type railpackFrontendOpts struct {
@@ -39,20 +44,34 @@ func buildWithDockerfile(ctx context.Context, opts buildkitSolveOpts, envVars ma
 	if err != nil {
 		return fmt.Errorf("create fs for source: %w", err)
 	}
+	contextFS := srcFS
+	if opts.ContextPath != "" && opts.ContextPath != opts.SourcePath {
+		contextFS, err = fsutil.NewFS(opts.ContextPath)
+		if err != nil {
+			return fmt.Errorf("create fs for build context: %w", err)
+		}
+	}
 
 	frontendAttrs := map[string]string{}
 	if opts.DockerfilePath != "" {
 		frontendAttrs["filename"] = opts.DockerfilePath
 	}
+	if opts.Target != "" {
+		frontendAttrs["target"] = opts.Target
+	}
 	for k, v := range envVars {
 		frontendAttrs["build-arg:"+k] = v
 	}
+	// Explicit build args win over env vars of the same name
+	for k, v := range opts.BuildArgs {
+		frontendAttrs["build-arg:"+k] = v
+	}
 
 	solveOpt := client.SolveOpt{
 		Frontend:      "dockerfile.v0",
 		FrontendAttrs: frontendAttrs,
 		LocalMounts: map[string]fsutil.FS{
-			"context":    srcFS,
+			"context":    contextFS,
 			"dockerfile": srcFS,
 		},
 		Exports: []client.ExportEntry{
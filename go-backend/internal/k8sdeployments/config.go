@@ -8,4 +8,11 @@ type Config struct {
 	LokiQueryURL        string
 	GitServerAdminToken string
 	GitServerCloneHost  string
+	// WakerService is the Service in dp-system fronting this worker's wake
+	// endpoint on WakerPort.
+	WakerService string
+	WakerPort    string
+	// RegistryKeepImages is how many successful deployments' images the
+	// registry GC keeps per service, on top of the active one.
+	RegistryKeepImages int
 }
@@ -0,0 +1,105 @@
+package k8sdeployments
+
+import (
+	"strings"
+	"time"
+)
+
+// Deploy policies decide what a new trigger does while a deploy of the same
+// service is in flight.
+const (
+	// DeployPolicyCancelPrevious cancels the running deploy and starts the
+	// new one as soon as it has stopped.
+	DeployPolicyCancelPrevious = "cancel_previous"
+	// DeployPolicyQueue lets the running deploy finish, then deploys the
+	// latest trigger; triggers in between are collapsed.
+	DeployPolicyQueue = "queue"
+	// DeployPolicyDebounce waits until no trigger arrived for the debounce
+	// window, then queues the latest one.
+	DeployPolicyDebounce = "debounce"
+)
+
+const (
+	// DeploySignal carries a DeployRequest to a service's DeployCoordinatorWorkflow.
+	DeploySignal = "deploy"
+
+	DefaultDeployDebounce = 30 * time.Second
+
+	// deployCoordinatorIdleTimeout ends a coordinator with nothing to do;
+	// the next trigger starts a fresh one.
+	deployCoordinatorIdleTimeout = time.Hour
+)
+
+// DeployCoordinatorWorkflowID is the ID of the single coordinator workflow
+// that serializes a service's deploys.
+func DeployCoordinatorWorkflowID(serviceID string) string {
+	return "deploys-" + serviceID
+}
+
+// DeployWorkflowID is the ID of the workflow that runs one deployment.
+func DeployWorkflowID(deploymentID string) string {
+	return "deploy-" + deploymentID
+}
+
+// DeploymentIDFromWorkflowID is the inverse of DeployWorkflowID.
+func DeploymentIDFromWorkflowID(workflowID string) string {
+	return strings.TrimPrefix(workflowID, "deploy-")
+}
+
+// deployQueue is the coordinator's bookkeeping, kept free of workflow calls
+// so the policy rules can be tested directly.
+type deployQueue struct {
+	running    string // deployment ID of the running deploy
+	cancelling bool
+	pending    *DeployRequest
+}
+
+// enqueueResult tells the coordinator what a new request requires.
+type enqueueResult struct {
+	// collapsed is a pending deployment the new request replaces.
+	collapsed string
+	// cancelRunning asks for the running deploy to be cancelled.
+	cancelRunning bool
+	// debounce restarts the quiet-period timer; zero clears it.
+	debounce time.Duration
+}
+
+func (q *deployQueue) enqueue(req DeployRequest) enqueueResult {
+	var res enqueueResult
+	if q.pending != nil {
+		res.collapsed = q.pending.Deploy.DeploymentID
+	}
+	q.pending = &req
+
+	switch req.Policy {
+	case DeployPolicyQueue:
+	case DeployPolicyDebounce:
+		res.debounce = req.Debounce
+		if res.debounce <= 0 {
+			res.debounce = DefaultDeployDebounce
+		}
+	default:
+		if q.running != "" && !q.cancelling {
+			q.cancelling = true
+			res.cancelRunning = true
+		}
+	}
+	return res
+}
+
+// next hands out the pending request once nothing is running and no
+// debounce window is open.
+func (q *deployQueue) next(debouncing bool) *DeployRequest {
+	if q.running != "" || q.pending == nil || debouncing {
+		return nil
+	}
+	req := q.pending
+	q.pending = nil
+	q.running = req.Deploy.DeploymentID
+	return req
+}
+
+func (q *deployQueue) finish() {
+	q.running = ""
+	q.cancelling = false
+}
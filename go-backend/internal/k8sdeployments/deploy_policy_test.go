@@ -0,0 +1,114 @@
+package k8sdeployments
+
+import (
+	"testing"
+	"time"
+)
+
+func deployReq(id, policy string) DeployRequest {
+	return DeployRequest{Policy: policy, Deploy: DeployServiceInput{DeploymentID: id}}
+}
+
+func TestDeployQueueEnqueue(t *testing.T) {
+	tests := []struct {
+		name       string
+		running    string
+		cancelling bool
+		pending    string
+		req        DeployRequest
+		want       enqueueResult
+	}{
+		{
+			name: "idle starts right away",
+			req:  deployReq("d2", DeployPolicyCancelPrevious),
+			want: enqueueResult{},
+		},
+		{
+			name:    "cancel_previous cancels the running deploy",
+			running: "d1",
+			req:     deployReq("d2", DeployPolicyCancelPrevious),
+			want:    enqueueResult{cancelRunning: true},
+		},
+		{
+			name:    "empty policy behaves like cancel_previous",
+			running: "d1",
+			req:     deployReq("d2", ""),
+			want:    enqueueResult{cancelRunning: true},
+		},
+		{
+			name:       "cancel is only requested once",
+			running:    "d1",
+			cancelling: true,
+			pending:    "d2",
+			req:        deployReq("d3", DeployPolicyCancelPrevious),
+			want:       enqueueResult{collapsed: "d2"},
+		},
+		{
+			name:    "queue waits and collapses intermediate requests",
+			running: "d1",
+			pending: "d2",
+			req:     deployReq("d3", DeployPolicyQueue),
+			want:    enqueueResult{collapsed: "d2"},
+		},
+		{
+			name: "debounce defaults the window",
+			req:  deployReq("d2", DeployPolicyDebounce),
+			want: enqueueResult{debounce: DefaultDeployDebounce},
+		},
+		{
+			name:    "debounce restarts the window",
+			running: "d1",
+			pending: "d2",
+			req: DeployRequest{
+				Policy:   DeployPolicyDebounce,
+				Debounce: 10 * time.Second,
+				Deploy:   DeployServiceInput{DeploymentID: "d3"},
+			},
+			want: enqueueResult{collapsed: "d2", debounce: 10 * time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &deployQueue{running: tt.running, cancelling: tt.cancelling}
+			if tt.pending != "" {
+				p := deployReq(tt.pending, DeployPolicyQueue)
+				q.pending = &p
+			}
+			got := q.enqueue(tt.req)
+			if got != tt.want {
+				t.Fatalf("enqueue = %+v, want %+v", got, tt.want)
+			}
+			if q.pending == nil || q.pending.Deploy.DeploymentID != tt.req.Deploy.DeploymentID {
+				t.Fatalf("pending = %+v, want %s", q.pending, tt.req.Deploy.DeploymentID)
+			}
+		})
+	}
+}
+
+func TestDeployQueueNext(t *testing.T) {
+	q := &deployQueue{}
+	if req := q.next(false); req != nil {
+		t.Fatalf("next on empty queue = %+v", req)
+	}
+
+	q.enqueue(deployReq("d1", DeployPolicyDebounce))
+	if req := q.next(true); req != nil {
+		t.Fatalf("next while debouncing = %+v", req)
+	}
+	if req := q.next(false); req == nil || req.Deploy.DeploymentID != "d1" {
+		t.Fatalf("next = %+v, want d1", req)
+	}
+
+	q.enqueue(deployReq("d2", DeployPolicyQueue))
+	if req := q.next(false); req != nil {
+		t.Fatalf("next while d1 runs = %+v", req)
+	}
+
+	q.finish()
+	if req := q.next(false); req == nil || req.Deploy.DeploymentID != "d2" {
+		t.Fatalf("next after finish = %+v, want d2", req)
+	}
+	if q.running != "d2" || q.pending != nil {
+		t.Fatalf("queue = %+v, want d2 running and nothing pending", q)
+	}
+}
@@ -148,7 +148,7 @@ func buildSecret(namespace, name string, envVars map[string]string) *corev1.Secr
 	}
 }
 
-func buildDeployment(namespace, name, imageRef string, port int32, memory, vcpus string) *appsv1.Deployment {
+func buildDeployment(namespace, name, imageRef string, port int32, extraPorts []ServicePort, memory, vcpus, pullSecret string, healthCheck *HealthCheck, replicas int32) *appsv1.Deployment {
 	memLimit := resource.MustParse(memory)
 	cpuLimit := resource.MustParse(vcpus)
 
@@ -160,7 +160,7 @@ func buildDeployment(namespace, name, imageRef string, port int32, memory, vcpus
 			Labels:    map[string]string{"app": name},
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: ptr.To(int32(1)),
+			Replicas: ptr.To(replicas),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{"app": name},
 			},
@@ -172,13 +172,12 @@ func buildDeployment(namespace, name, imageRef string, port int32, memory, vcpus
 					RuntimeClassName:             ptr.To("gvisor"),
 					AutomountServiceAccountToken: ptr.To(false),
 					SecurityContext:              &corev1.PodSecurityContext{},
+					ImagePullSecrets:             imagePullSecrets(pullSecret),
 					Containers: []corev1.Container{
 						{
 							Name:  name,
 							Image: imageRef,
-							Ports: []corev1.ContainerPort{
-								{ContainerPort: port},
-							},
+							Ports: containerPorts(port, extraPorts),
 							EnvFrom: []corev1.EnvFromSource{
 								{SecretRef: &corev1.SecretEnvSource{
 									LocalObjectReference: corev1.LocalObjectReference{Name: name + "-env"},
@@ -201,17 +200,7 @@ func buildDeployment(namespace, name, imageRef string, port int32, memory, vcpus
 								AllowPrivilegeEscalation: ptr.To(false),
 								ReadOnlyRootFilesystem:   ptr.To(false),
 							},
-							ReadinessProbe: &corev1.Probe{
-								ProbeHandler: corev1.ProbeHandler{
-									TCPSocket: &corev1.TCPSocketAction{
-										Port: intstr.FromInt32(port),
-									},
-								},
-								InitialDelaySeconds: 1,
-								PeriodSeconds:       2,
-								TimeoutSeconds:      3,
-								FailureThreshold:    3,
-							},
+							ReadinessProbe: readinessProbe(port, healthCheck),
 						},
 					},
 				},
@@ -220,7 +209,52 @@ func buildDeployment(namespace, name, imageRef string, port int32, memory, vcpus
 	}
 }
 
-func buildService(namespace, name string, port int32) *corev1.Service {
+// readinessProbe is a TCP check on the app port unless ink.yaml declares
+// an HTTP health_check.
+func readinessProbe(port int32, hc *HealthCheck) *corev1.Probe {
+	probe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{
+				Port: intstr.FromInt32(port),
+			},
+		},
+		InitialDelaySeconds: 1,
+		PeriodSeconds:       2,
+		TimeoutSeconds:      3,
+		FailureThreshold:    3,
+	}
+	if hc == nil {
+		return probe
+	}
+	probe.ProbeHandler = corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path: hc.Path,
+			Port: intstr.FromInt32(port),
+		},
+	}
+	if hc.InitialDelaySeconds > 0 {
+		probe.InitialDelaySeconds = hc.InitialDelaySeconds
+	}
+	if hc.PeriodSeconds > 0 {
+		probe.PeriodSeconds = hc.PeriodSeconds
+	}
+	if hc.TimeoutSeconds > 0 {
+		probe.TimeoutSeconds = hc.TimeoutSeconds
+	}
+	if hc.FailureThreshold > 0 {
+		probe.FailureThreshold = hc.FailureThreshold
+	}
+	return probe
+}
+
+func imagePullSecrets(name string) []corev1.LocalObjectReference {
+	if name == "" {
+		return nil
+	}
+	return []corev1.LocalObjectReference{{Name: name}}
+}
+
+func buildService(namespace, name string, port, targetPort int32) *corev1.Service {
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
@@ -232,22 +266,30 @@ func buildService(namespace, name string, port int32) *corev1.Service {
 			Ports: []corev1.ServicePort{
 				{
 					Port:       port,
-					TargetPort: intstr.FromInt32(port),
+					TargetPort: intstr.FromInt32(targetPort),
 				},
 			},
 		},
 	}
 }
 
-func buildIngress(namespace, name, host string, port int32) *networkingv1.Ingress {
+func buildIngress(namespace, name, host string, port int32, middlewares []string) *networkingv1.Ingress {
 	pathType := networkingv1.PathTypePrefix
 	ingressClassName := "traefik"
 
+	var annotations map[string]string
+	if len(middlewares) > 0 {
+		annotations = map[string]string{
+			"traefik.ingress.kubernetes.io/router.middlewares": ingressMiddlewareAnnotation(namespace, middlewares),
+		}
+	}
+
 	return &networkingv1.Ingress{
 		TypeMeta: metav1.TypeMeta{Kind: "Ingress", APIVersion: "networking.k8s.io/v1"},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: annotations,
 		},
 		Spec: networkingv1.IngressSpec{
 			IngressClassName: &ingressClassName,
@@ -0,0 +1,74 @@
+package k8sdeployments
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Lifecycle actions run by ServiceLifecycleWorkflow. They change how the
+// current deployment runs without building or rolling out a new image.
+const (
+	LifecycleStop    = "stop"
+	LifecycleStart   = "start"
+	LifecycleRestart = "restart"
+)
+
+// restartedAtAnnotation is the pod template annotation `kubectl rollout
+// restart` sets; bumping it rolls every pod onto the same spec.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// stopPatch scales a Deployment to zero and drops the sleeping marker so
+// the waker leaves a stopped service alone.
+func stopPatch() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				sleepingAnnotation: nil,
+			},
+		},
+		"spec": map[string]any{
+			"replicas": 0,
+		},
+	})
+}
+
+func startPatch() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				sleepingAnnotation: nil,
+			},
+		},
+		"spec": map[string]any{
+			"replicas": 1,
+		},
+	})
+}
+
+func restartPatch(at time.Time) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]any{
+						restartedAtAnnotation: at.UTC().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	})
+}
+
+func lifecyclePatch(action string, now time.Time) ([]byte, error) {
+	switch action {
+	case LifecycleStop:
+		return stopPatch()
+	case LifecycleStart:
+		return startPatch()
+	case LifecycleRestart:
+		return restartPatch(now)
+	default:
+		return nil, fmt.Errorf("unknown lifecycle action %q", action)
+	}
+}
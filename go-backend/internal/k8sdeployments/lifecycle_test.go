@@ -0,0 +1,44 @@
+package k8sdeployments
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLifecyclePatch(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		action string
+		want   string
+	}{
+		{action: LifecycleStop, want: `{"metadata":{"annotations":{"dp.ml.ink/sleeping":null}},"spec":{"replicas":0}}`},
+		{action: LifecycleStart, want: `{"metadata":{"annotations":{"dp.ml.ink/sleeping":null}},"spec":{"replicas":1}}`},
+		{action: LifecycleRestart, want: `{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"2025-03-01T12:00:00Z"}}}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			got, err := lifecyclePatch(tt.action, now)
+			if err != nil {
+				t.Fatalf("lifecyclePatch(%q) unexpected error: %v", tt.action, err)
+			}
+			if string(got) != tt.want {
+				t.Fatalf("lifecyclePatch(%q) = %s, want %s", tt.action, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := lifecyclePatch("pause", now); err == nil {
+		t.Fatal("lifecyclePatch(pause) expected error")
+	}
+}
+
+func TestBuildDeployment_StoppedServiceStaysAtZero(t *testing.T) {
+	for _, replicas := range []int32{0, 1} {
+		d := buildDeployment("dp-u1-default", "web", "registry/web:abc", 8080, nil, "256Mi", "0.25", "", nil, replicas)
+		if got := *d.Spec.Replicas; got != replicas {
+			t.Fatalf("replicas = %d, want %d", got, replicas)
+		}
+	}
+}
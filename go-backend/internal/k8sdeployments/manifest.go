@@ -0,0 +1,265 @@
+package k8sdeployments
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	deploymentsdb "github.com/augustdev/autoclip/internal/storage/pg/generated/deployments"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/jackc/pgx/v5"
+	"go.temporal.io/sdk/temporal"
+	"sigs.k8s.io/yaml"
+)
+
+// ManifestFileName is the service manifest committed with the code, read
+// from root_directory or else the repo root.
+const ManifestFileName = "ink.yaml"
+
+const (
+	maxManifestSize     = 64 << 10
+	maxHealthCheckDelay = 120
+)
+
+// ServiceManifest is configuration-as-code for a service. Every field it
+// sets wins over the service row, so changes to it go through code review;
+// fields it leaves out keep the row's value. Env var values never live in
+// the repo: Env only lists names that must be set on the service.
+type ServiceManifest struct {
+	BuildPack        string         `json:"build_pack,omitempty"`
+	BuildCommand     string         `json:"build_command,omitempty"`
+	StartCommand     string         `json:"start_command,omitempty"`
+	DockerfilePath   string         `json:"dockerfile_path,omitempty"`
+	PublishDirectory string         `json:"publish_directory,omitempty"`
+	Port             manifestScalar `json:"port,omitempty"`
+	Memory           string         `json:"memory,omitempty"`
+	VCPUs            manifestScalar `json:"vcpus,omitempty"`
+	HealthCheck      *HealthCheck   `json:"health_check,omitempty"`
+	Env              []string       `json:"env,omitempty"`
+}
+
+// HealthCheck replaces the default TCP readiness probe with an HTTP GET.
+// Zero timings fall back to the TCP probe's.
+type HealthCheck struct {
+	Path                string `json:"path"`
+	InitialDelaySeconds int32  `json:"initial_delay_seconds,omitempty"`
+	PeriodSeconds       int32  `json:"period_seconds,omitempty"`
+	TimeoutSeconds      int32  `json:"timeout_seconds,omitempty"`
+	FailureThreshold    int32  `json:"failure_threshold,omitempty"`
+}
+
+// manifestScalar accepts YAML numbers as well as strings, so "port: 8080"
+// and "vcpus: 0.5" work unquoted.
+type manifestScalar string
+
+func (s *manifestScalar) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		*s = manifestScalar(str)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("expected a string or number, got %s", data)
+	}
+	*s = manifestScalar(n.String())
+	return nil
+}
+
+var (
+	manifestEnvNameRe  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	manifestBuildPacks = map[string]bool{"railpack": true, "dockerfile": true, "static": true}
+	healthCheckPathRe  = regexp.MustCompile(`^/[^\s]*$`)
+)
+
+// ParseManifest parses and validates an ink.yaml. Unknown keys are errors
+// so typos don't silently fall back to the service row.
+func ParseManifest(data []byte) (*ServiceManifest, error) {
+	if len(data) > maxManifestSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", ManifestFileName, maxManifestSize)
+	}
+	var m ServiceManifest
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := yaml.UnmarshalStrict(data, &m); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", ManifestFileName, err)
+		}
+	}
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ManifestFileName, err)
+	}
+	return &m, nil
+}
+
+func (m *ServiceManifest) validate() error {
+	if m.BuildPack != "" && !manifestBuildPacks[m.BuildPack] {
+		return fmt.Errorf("build_pack %q must be one of railpack, dockerfile, static", m.BuildPack)
+	}
+	if m.Port != "" {
+		p, err := strconv.Atoi(string(m.Port))
+		if err != nil || p < 1 || p > 65535 {
+			return fmt.Errorf("port %q must be between 1 and 65535", m.Port)
+		}
+	}
+	if m.Memory != "" && !allowedMemory[m.Memory] {
+		return fmt.Errorf("memory %q must be one of 256Mi, 512Mi, 1024Mi, 2048Mi, 4096Mi", m.Memory)
+	}
+	if m.VCPUs != "" && !allowedVCPUs[string(m.VCPUs)] {
+		return fmt.Errorf("vcpus %q must be one of 0.5, 1, 2, 4", m.VCPUs)
+	}
+	for _, p := range []string{m.DockerfilePath, m.PublishDirectory} {
+		if clean := filepath.Clean(p); filepath.IsAbs(p) || clean == ".." || strings.HasPrefix(clean, "../") {
+			return fmt.Errorf("path %q must stay inside root_directory", p)
+		}
+	}
+	if hc := m.HealthCheck; hc != nil {
+		if !healthCheckPathRe.MatchString(hc.Path) {
+			return fmt.Errorf("health_check.path %q must start with /", hc.Path)
+		}
+		if hc.InitialDelaySeconds < 0 || hc.InitialDelaySeconds > maxHealthCheckDelay {
+			return fmt.Errorf("health_check.initial_delay_seconds must be between 0 and %d", maxHealthCheckDelay)
+		}
+		if hc.PeriodSeconds < 0 || hc.PeriodSeconds > 60 || hc.TimeoutSeconds < 0 || hc.TimeoutSeconds > 60 {
+			return fmt.Errorf("health_check period_seconds and timeout_seconds must be at most 60")
+		}
+		if hc.FailureThreshold < 0 || hc.FailureThreshold > 30 {
+			return fmt.Errorf("health_check.failure_threshold must be at most 30")
+		}
+	}
+	for _, name := range m.Env {
+		if !manifestEnvNameRe.MatchString(name) {
+			return fmt.Errorf("env name %q is not a valid variable name", name)
+		}
+	}
+	return nil
+}
+
+// loadManifest reads ink.yaml from the first dir that has one. It returns
+// nil when there is none; an invalid manifest fails without retries.
+func loadManifest(dirs ...string) (*ServiceManifest, error) {
+	for _, dir := range dirs {
+		data, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", ManifestFileName, err)
+		}
+		m, err := ParseManifest(data)
+		if err != nil {
+			return nil, temporal.NewNonRetryableApplicationError(err.Error(), "invalid_manifest", err)
+		}
+		return m, nil
+	}
+	return nil, nil
+}
+
+// applyManifest merges m over the service row and its build config.
+func applyManifest(m *ServiceManifest, svc *services.Service, bc *BuildConfig) {
+	if m == nil {
+		return
+	}
+	if m.BuildPack != "" {
+		svc.BuildPack = m.BuildPack
+	}
+	if m.Port != "" {
+		svc.Port = string(m.Port)
+	}
+	if m.Memory != "" {
+		svc.Memory = m.Memory
+	}
+	if m.VCPUs != "" {
+		svc.Vcpus = string(m.VCPUs)
+	}
+	if m.BuildCommand != "" {
+		bc.BuildCommand = m.BuildCommand
+	}
+	if m.StartCommand != "" {
+		bc.StartCommand = m.StartCommand
+	}
+	if m.DockerfilePath != "" {
+		bc.DockerfilePath = m.DockerfilePath
+	}
+	if m.PublishDirectory != "" {
+		bc.PublishDirectory = m.PublishDirectory
+	}
+}
+
+// missingManifestEnv lists the env names m declares that envVars lacks.
+func missingManifestEnv(m *ServiceManifest, envVars map[string]string) []string {
+	if m == nil {
+		return nil
+	}
+	var missing []string
+	for _, name := range m.Env {
+		if _, ok := envVars[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// recordEffectiveConfig stores the manifest and the merged config in the
+// deployment's snapshot columns.
+func (a *Activities) recordEffectiveConfig(ctx context.Context, deploymentID string, svc services.Service, bc BuildConfig, m *ServiceManifest) error {
+	bcJSON, err := json.Marshal(bc)
+	if err != nil {
+		return fmt.Errorf("marshal build config: %w", err)
+	}
+	manifestJSON, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+	if err := a.deploymentsQ.SetDeploymentEffectiveConfig(ctx, deploymentsdb.SetDeploymentEffectiveConfigParams{
+		ID:          deploymentID,
+		BuildPack:   svc.BuildPack,
+		BuildConfig: bcJSON,
+		Port:        svc.Port,
+		Memory:      svc.Memory,
+		Vcpus:       svc.Vcpus,
+		Manifest:    manifestJSON,
+	}); err != nil {
+		return fmt.Errorf("record effective config: %w", err)
+	}
+	return nil
+}
+
+// deploymentManifest returns the manifest recorded for a deployment. A
+// deployment whose build was skipped has none yet, so it reuses the one
+// recorded for an earlier build of the same commit. fromSnapshot reports
+// whether it came from the deployment itself.
+func (a *Activities) deploymentManifest(ctx context.Context, dep deploymentsdb.Deployment, commitSHA string) (m *ServiceManifest, fromSnapshot bool, err error) {
+	raw := dep.Manifest
+	fromSnapshot = len(raw) > 0
+	if !fromSnapshot {
+		if commitSHA == "" {
+			return nil, false, nil
+		}
+		raw, err = a.deploymentsQ.GetCommitManifest(ctx, deploymentsdb.GetCommitManifestParams{
+			ServiceID:  dep.ServiceID,
+			CommitHash: &commitSHA,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("get commit manifest: %w", err)
+		}
+	}
+	m = &ServiceManifest{}
+	if err := json.Unmarshal(raw, m); err != nil {
+		return nil, false, fmt.Errorf("decode manifest: %w", err)
+	}
+	return m, fromSnapshot, nil
+}
@@ -0,0 +1,133 @@
+package k8sdeployments
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+)
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		want    ServiceManifest
+		wantErr bool
+	}{
+		{name: "empty", yaml: "", want: ServiceManifest{}},
+		{
+			name: "unquoted numbers",
+			yaml: "build_pack: dockerfile\nport: 8080\nmemory: 512Mi\nvcpus: 0.5\nenv: [DATABASE_URL, API_KEY]\n",
+			want: ServiceManifest{BuildPack: "dockerfile", Port: "8080", Memory: "512Mi", VCPUs: "0.5", Env: []string{"DATABASE_URL", "API_KEY"}},
+		},
+		{
+			name: "health check",
+			yaml: "health_check:\n  path: /healthz\n  period_seconds: 5\n",
+			want: ServiceManifest{HealthCheck: &HealthCheck{Path: "/healthz", PeriodSeconds: 5}},
+		},
+		{name: "unknown key", yaml: "buildpack: railpack\n", wantErr: true},
+		{name: "image build pack", yaml: "build_pack: image\n", wantErr: true},
+		{name: "port out of range", yaml: "port: 70000\n", wantErr: true},
+		{name: "memory not allowed", yaml: "memory: 3Gi\n", wantErr: true},
+		{name: "vcpus not allowed", yaml: "vcpus: 3\n", wantErr: true},
+		{name: "dockerfile outside root", yaml: "dockerfile_path: ../Dockerfile\n", wantErr: true},
+		{name: "relative health path", yaml: "health_check:\n  path: healthz\n", wantErr: true},
+		{name: "bad env name", yaml: "env: [\"API-KEY\"]\n", wantErr: true},
+		{name: "env values", yaml: "env:\n  API_KEY: secret\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseManifest([]byte(tt.yaml))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseManifest(%q) expected error, got %+v", tt.yaml, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseManifest(%q) unexpected error: %v", tt.yaml, err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("ParseManifest(%q) = %+v, want %+v", tt.yaml, *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadManifest_RootDirectoryFirst(t *testing.T) {
+	repo := t.TempDir()
+	app := filepath.Join(repo, "services", "api")
+	if err := os.MkdirAll(app, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := loadManifest(app, repo)
+	if err != nil || m != nil {
+		t.Fatalf("loadManifest without ink.yaml = %+v, %v; want nil, nil", m, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(repo, ManifestFileName), []byte("port: 4000\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = loadManifest(app, repo); err != nil || m.Port != "4000" {
+		t.Fatalf("loadManifest fell back to repo root = %+v, %v", m, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(app, ManifestFileName), []byte("port: 5000\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = loadManifest(app, repo); err != nil || m.Port != "5000" {
+		t.Fatalf("loadManifest in root_directory = %+v, %v", m, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(app, ManifestFileName), []byte("port: [\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = loadManifest(app, repo); err == nil {
+		t.Fatal("loadManifest should fail on invalid YAML")
+	}
+}
+
+func TestApplyManifest(t *testing.T) {
+	svc := services.Service{BuildPack: "railpack", Port: "3000", Memory: "256Mi", Vcpus: "0.5"}
+	bc := BuildConfig{RootDirectory: "api", BuildCommand: "npm run build", StartCommand: "npm start"}
+	m := &ServiceManifest{BuildPack: "dockerfile", Port: "8080", VCPUs: "1", StartCommand: "node server.js"}
+
+	applyManifest(m, &svc, &bc)
+
+	if svc.BuildPack != "dockerfile" || svc.Port != "8080" || svc.Vcpus != "1" {
+		t.Errorf("manifest fields should win: %+v", svc)
+	}
+	if svc.Memory != "256Mi" {
+		t.Errorf("memory = %q, want the row's 256Mi", svc.Memory)
+	}
+	if bc.StartCommand != "node server.js" || bc.BuildCommand != "npm run build" || bc.RootDirectory != "api" {
+		t.Errorf("unexpected build config %+v", bc)
+	}
+
+	missing := missingManifestEnv(&ServiceManifest{Env: []string{"B", "A", "SET"}}, map[string]string{"SET": ""})
+	if !reflect.DeepEqual(missing, []string{"A", "B"}) {
+		t.Errorf("missingManifestEnv = %v, want [A B]", missing)
+	}
+}
+
+func TestReadinessProbe(t *testing.T) {
+	tcp := readinessProbe(8080, nil)
+	if tcp.TCPSocket == nil || tcp.HTTPGet != nil || tcp.PeriodSeconds != 2 {
+		t.Fatalf("default probe = %+v, want TCP every 2s", tcp)
+	}
+
+	http := readinessProbe(8080, &HealthCheck{Path: "/healthz", InitialDelaySeconds: 10})
+	if http.HTTPGet == nil || http.TCPSocket != nil {
+		t.Fatalf("health_check probe = %+v, want HTTP GET", http)
+	}
+	if http.HTTPGet.Path != "/healthz" || http.HTTPGet.Port.IntVal != 8080 {
+		t.Errorf("HTTPGet = %+v", http.HTTPGet)
+	}
+	if http.InitialDelaySeconds != 10 || http.PeriodSeconds != 2 || http.FailureThreshold != 3 {
+		t.Errorf("timings = %d/%d/%d, want 10/2/3", http.InitialDelaySeconds, http.PeriodSeconds, http.FailureThreshold)
+	}
+}
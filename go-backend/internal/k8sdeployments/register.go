@@ -5,13 +5,21 @@ import "go.temporal.io/sdk/worker"
 func RegisterWorkflowsAndActivities(w worker.Worker, activities *Activities) {
 	w.RegisterWorkflow(CreateServiceWorkflow)
 	w.RegisterWorkflow(RedeployServiceWorkflow)
+	w.RegisterWorkflow(DeployCoordinatorWorkflow)
 	w.RegisterWorkflow(DeleteServiceWorkflow)
 	w.RegisterWorkflow(BuildServiceWorkflow)
+	w.RegisterWorkflow(SyncProjectRoutesWorkflow)
+	w.RegisterWorkflow(SleepIdleServicesWorkflow)
+	w.RegisterWorkflow(ServiceLifecycleWorkflow)
+	w.RegisterWorkflow(RegistryGCWorkflow)
+	w.RegisterWorkflow(CheckImageWorkflow)
 
 	w.RegisterActivity(activities.CloneRepo)
 	w.RegisterActivity(activities.ResolveImageRef)
 	w.RegisterActivity(activities.ResolveBuildContext)
 	w.RegisterActivity(activities.ImageExists)
+	w.RegisterActivity(activities.ResolveImage)
+	w.RegisterActivity(activities.CheckImage)
 	w.RegisterActivity(activities.RailpackBuild)
 	w.RegisterActivity(activities.RailpackStaticBuild)
 	w.RegisterActivity(activities.DockerfileBuild)
@@ -20,10 +28,21 @@ func RegisterWorkflowsAndActivities(w worker.Worker, activities *Activities) {
 	w.RegisterActivity(activities.Deploy)
 	w.RegisterActivity(activities.WaitForRollout)
 	w.RegisterActivity(activities.DeleteService)
+	w.RegisterActivity(activities.WaitForWorkflowsClosed)
 	w.RegisterActivity(activities.UpdateDeploymentBuilding)
 	w.RegisterActivity(activities.UpdateDeploymentDeploying)
 	w.RegisterActivity(activities.MarkDeploymentActive)
 	w.RegisterActivity(activities.MarkDeploymentFailed)
+	w.RegisterActivity(activities.MarkDeploymentCancelled)
+	w.RegisterActivity(activities.RecordDeploymentRunID)
 	w.RegisterActivity(activities.UpdateDeploymentBuildProgress)
 	w.RegisterActivity(activities.SoftDeleteService)
+	w.RegisterActivity(activities.SyncProjectRoutes)
+	w.RegisterActivity(activities.FindIdleServices)
+	w.RegisterActivity(activities.SleepService)
+	w.RegisterActivity(activities.ApplyServiceLifecycle)
+	w.RegisterActivity(activities.MarkServiceEventCompleted)
+	w.RegisterActivity(activities.MarkServiceEventFailed)
+	w.RegisterActivity(activities.FindRegistryGCTargets)
+	w.RegisterActivity(activities.CollectRegistryGarbage)
 }
@@ -0,0 +1,374 @@
+package k8sdeployments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/distribution/reference"
+)
+
+const (
+	dockerHubDomain      = "docker.io"
+	dockerHubRegistryURL = "https://registry-1.docker.io"
+	dockerHubAuthHost    = "auth.docker.io"
+)
+
+// ErrRegistryNotAllowed is returned for user images that point at the
+// in-cluster registry or at a private address.
+var ErrRegistryNotAllowed = errors.New("registry not allowed")
+
+// cgnatPrefix is shared address space (RFC 6598), used for node and pod
+// networks by some clusters.
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// registryClient speaks the registry v2 manifest API. Anonymous requests
+// are used for the in-cluster registry; credentials are only needed for
+// private third-party registries and go through the usual Basic or Bearer
+// token challenge.
+type registryClient struct {
+	http  *http.Client
+	creds *RegistryCredentials
+}
+
+func newRegistryClient(creds *RegistryCredentials) *registryClient {
+	return &registryClient{
+		http:  &http.Client{Timeout: 8 * time.Second},
+		creds: creds,
+	}
+}
+
+// newPublicRegistryClient is for registries named by users. It refuses to
+// connect to anything but public addresses, including after redirects and
+// for token realms, so an image reference can't reach in-cluster services.
+func newPublicRegistryClient(creds *RegistryCredentials) *registryClient {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !isPublicAddr(addr) {
+				return fmt.Errorf("%w: %s is not a public address", ErrRegistryNotAllowed, addr)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &registryClient{
+		http:  &http.Client{Timeout: 8 * time.Second, Transport: transport},
+		creds: creds,
+	}
+}
+
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !cgnatPrefix.Contains(addr)
+}
+
+// manifestResult is the outcome of a manifest lookup. Digest is only set
+// when the manifest exists.
+type manifestResult struct {
+	Exists bool
+	Digest string
+}
+
+// headManifest looks up repository:reference under baseURL. It falls back to
+// GET for registries that reject HEAD, and computes the digest from the body
+// when the registry omits Docker-Content-Digest.
+func (c *registryClient) headManifest(ctx context.Context, baseURL, repository, ref string) (manifestResult, error) {
+	manifestURL := fmt.Sprintf("%s/v2/%s/manifests/%s", baseURL, repository, ref)
+
+	resp, err := c.do(ctx, http.MethodHead, manifestURL, repository)
+	if err != nil {
+		return manifestResult{}, fmt.Errorf("registry HEAD manifest: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+			return manifestResult{Exists: true, Digest: digest}, nil
+		}
+	case http.StatusNotFound:
+		return manifestResult{}, nil
+	case http.StatusMethodNotAllowed:
+		// Fallback for registries that don't support HEAD.
+	default:
+		return manifestResult{}, fmt.Errorf("registry HEAD manifest unexpected status: %d", resp.StatusCode)
+	}
+
+	getResp, err := c.do(ctx, http.MethodGet, manifestURL, repository)
+	if err != nil {
+		return manifestResult{}, fmt.Errorf("registry GET manifest: %w", err)
+	}
+	defer getResp.Body.Close()
+
+	switch getResp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		_, _ = io.Copy(io.Discard, getResp.Body)
+		return manifestResult{}, nil
+	default:
+		_, _ = io.Copy(io.Discard, getResp.Body)
+		return manifestResult{}, fmt.Errorf("registry GET manifest unexpected status: %d", getResp.StatusCode)
+	}
+
+	digest := getResp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		h := sha256.New()
+		if _, err := io.Copy(h, getResp.Body); err != nil {
+			return manifestResult{}, fmt.Errorf("read manifest: %w", err)
+		}
+		digest = "sha256:" + hex.EncodeToString(h.Sum(nil))
+	} else {
+		_, _ = io.Copy(io.Discard, getResp.Body)
+	}
+	return manifestResult{Exists: true, Digest: digest}, nil
+}
+
+// do sends the request and answers one auth challenge if the registry
+// asks for it.
+func (c *registryClient) do(ctx context.Context, method, rawURL, repository string) (*http.Response, error) {
+	resp, err := c.send(ctx, method, rawURL, "")
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	resp.Body.Close()
+
+	registry, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse registry url: %w", err)
+	}
+	authorization, err := c.authorize(ctx, challenge, &url.URL{Scheme: registry.Scheme, Host: registry.Host}, repository)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(ctx, method, rawURL, authorization)
+}
+
+func (c *registryClient) send(ctx context.Context, method, rawURL, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create %s request: %w", method, err)
+	}
+	req.Header.Set("Accept", registryManifestAccept)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	return c.http.Do(req)
+}
+
+func (c *registryClient) authorize(ctx context.Context, challenge string, registry *url.URL, repository string) (string, error) {
+	scheme, params := parseAuthChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.creds == nil {
+			return "", fmt.Errorf("registry requires credentials")
+		}
+		return "Basic " + c.creds.basicAuth(), nil
+	case "bearer":
+		token, err := c.fetchToken(ctx, params, registry, repository)
+		if err != nil {
+			return "", err
+		}
+		return "Bearer " + token, nil
+	default:
+		return "", fmt.Errorf("unsupported registry auth challenge %q", challenge)
+	}
+}
+
+// fetchToken gets a bearer token from the challenge's realm. Credentials
+// are only sent to a realm on the registry's own host (or Docker Hub's
+// auth server), never to wherever a registry points them.
+func (c *registryClient) fetchToken(ctx context.Context, params map[string]string, registry *url.URL, repository string) (string, error) {
+	realm := params["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("registry auth challenge has no realm")
+	}
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("parse token realm: %w", err)
+	}
+	if c.creds != nil && !realmTrusted(tokenURL, registry) {
+		return "", fmt.Errorf("registry token realm %s is not on the registry host %s", tokenURL.Host, registry.Host)
+	}
+	q := tokenURL.Query()
+	if service := params["service"]; service != "" {
+		q.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", repository)
+	}
+	q.Set("scope", scope)
+	tokenURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("create token request: %w", err)
+	}
+	if c.creds != nil {
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetch registry token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetch registry token: unexpected status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("decode registry token: %w", err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", fmt.Errorf("registry token response has no token")
+}
+
+// realmTrusted reports whether credentials for registry may be sent to the
+// token realm: same host and scheme, or Docker Hub's own auth server.
+func realmTrusted(realm, registry *url.URL) bool {
+	if realm.Scheme != registry.Scheme {
+		return false
+	}
+	if strings.EqualFold(realm.Hostname(), registry.Hostname()) {
+		return true
+	}
+	return registry.String() == dockerHubRegistryURL && strings.EqualFold(realm.Host, dockerHubAuthHost)
+}
+
+// parseAuthChallenge splits a WWW-Authenticate header such as
+// `Bearer realm="https://auth.docker.io/token",service="registry.docker.io"`.
+func parseAuthChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			params[key] = value
+		}
+	}
+	return scheme, params
+}
+
+// ParseImage normalizes a user-supplied image reference, defaulting the tag
+// to latest. Digest references are kept as-is.
+func ParseImage(image string) (reference.Named, error) {
+	named, err := reference.ParseNormalizedNamed(strings.TrimSpace(image))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image %q: %w", image, err)
+	}
+	return reference.TagNameOnly(named), nil
+}
+
+// registryBaseURL maps an image's registry domain to its API endpoint.
+// The in-cluster registry is reached over registryHost rather than the
+// address baked into image names.
+func registryBaseURL(domain, registryAddress, registryHost string) string {
+	switch {
+	case registryAddress != "" && domain == registryAddress && registryHost != "":
+		return strings.TrimRight(registryHost, "/")
+	case domain == dockerHubDomain:
+		return dockerHubRegistryURL
+	default:
+		return "https://" + domain
+	}
+}
+
+// ResolveImageDigest pins a user-supplied image to the digest its tag
+// currently points at, e.g. docker.io/library/nginx:1.27 →
+// docker.io/library/nginx@sha256:…. Images in the in-cluster registry and
+// registries on private addresses are rejected with ErrRegistryNotAllowed.
+func ResolveImageDigest(ctx context.Context, image string, creds *RegistryCredentials, cfg Config) (string, error) {
+	named, err := ParseImage(image)
+	if err != nil {
+		return "", err
+	}
+	domain := reference.Domain(named)
+	if isInternalRegistry(domain, cfg) {
+		return "", fmt.Errorf("%w: %s is the platform's internal registry", ErrRegistryNotAllowed, domain)
+	}
+	return resolveImageDigest(ctx, newPublicRegistryClient(creds), named, registryBaseURL(domain, "", ""))
+}
+
+func resolveImageDigest(ctx context.Context, c *registryClient, named reference.Named, baseURL string) (string, error) {
+	ref := ""
+	switch r := named.(type) {
+	case reference.Digested:
+		ref = r.Digest().String()
+	case reference.Tagged:
+		ref = r.Tag()
+	}
+
+	result, err := c.headManifest(ctx, baseURL, reference.Path(named), ref)
+	if err != nil {
+		return "", fmt.Errorf("resolve %s: %w", named, err)
+	}
+	if !result.Exists {
+		return "", fmt.Errorf("image %s not found", named)
+	}
+	return named.Name() + "@" + result.Digest, nil
+}
+
+// isInternalRegistry matches domain against the registry address baked
+// into built image names and the host the cluster reaches it on.
+func isInternalRegistry(domain string, cfg Config) bool {
+	host := domain
+	if h, _, err := net.SplitHostPort(domain); err == nil {
+		host = h
+	}
+	for _, internal := range []string{cfg.RegistryAddress, cfg.RegistryHost} {
+		internal = strings.TrimSpace(internal)
+		if internal == "" {
+			continue
+		}
+		if u, err := url.Parse(internal); err == nil && u.Host != "" {
+			internal = u.Host
+		}
+		internalHost := internal
+		if h, _, err := net.SplitHostPort(internal); err == nil {
+			internalHost = h
+		}
+		if strings.EqualFold(domain, internal) || strings.EqualFold(host, internalHost) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package k8sdeployments
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+func TestParseAuthChallenge(t *testing.T) {
+	scheme, params := parseAuthChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/nginx:pull"`)
+	if scheme != "Bearer" {
+		t.Fatalf("scheme = %q, want Bearer", scheme)
+	}
+	want := map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/nginx:pull",
+	}
+	for k, v := range want {
+		if params[k] != v {
+			t.Errorf("params[%q] = %q, want %q", k, params[k], v)
+		}
+	}
+
+	scheme, params = parseAuthChallenge(`Basic realm=registry`)
+	if scheme != "Basic" || params["realm"] != "registry" {
+		t.Errorf("got %q %v, want Basic realm=registry", scheme, params)
+	}
+}
+
+func TestRegistryBaseURL(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+	}{
+		{domain: "registry.internal:5000", want: "http://registry.registry.svc:5000"},
+		{domain: "docker.io", want: "https://registry-1.docker.io"},
+		{domain: "ghcr.io", want: "https://ghcr.io"},
+	}
+	for _, tt := range tests {
+		if got := registryBaseURL(tt.domain, "registry.internal:5000", "http://registry.registry.svc:5000/"); got != tt.want {
+			t.Errorf("registryBaseURL(%q) = %q, want %q", tt.domain, got, tt.want)
+		}
+	}
+}
+
+func TestResolveImageDigest(t *testing.T) {
+	const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "bot" || pass != "s3cret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/v2/acme/api/manifests/v2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", digest)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	creds := &RegistryCredentials{Username: "bot", Password: "s3cret"}
+	resolve := func(image string, creds *RegistryCredentials) (string, error) {
+		named, err := ParseImage(image)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resolveImageDigest(context.Background(), newRegistryClient(creds), named, srv.URL)
+	}
+
+	got, err := resolve("ghcr.io/acme/api:v2", creds)
+	if err != nil {
+		t.Fatalf("resolveImageDigest: %v", err)
+	}
+	if want := "ghcr.io/acme/api@" + digest; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if _, err := resolve("ghcr.io/acme/api:missing", creds); err == nil {
+		t.Error("expected an error for a missing tag")
+	}
+	if _, err := resolve("ghcr.io/acme/api:v2", nil); err == nil {
+		t.Error("expected an error without credentials")
+	}
+}
+
+func TestResolveImageDigest_RejectsInternalRegistries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	defer srv.Close()
+
+	cfg := Config{RegistryAddress: "registry.internal:5000", RegistryHost: "http://registry.registry.svc:5000"}
+	for _, image := range []string{
+		"registry.internal:5000/dp-u1-default/web:abc",
+		"registry.registry.svc:5000/dp-u1-default/web:abc",
+		srv.Listener.Addr().String() + "/acme/api:v2",
+		"169.254.169.254/latest/meta-data:v1",
+		"10.43.0.10:5000/acme/api:v2",
+	} {
+		if _, err := ResolveImageDigest(context.Background(), image, nil, cfg); !errors.Is(err, ErrRegistryNotAllowed) {
+			t.Errorf("ResolveImageDigest(%q) err = %v, want ErrRegistryNotAllowed", image, err)
+		}
+	}
+}
+
+func TestCheckImage_InternalRegistryIsNotRetried(t *testing.T) {
+	a := &Activities{config: Config{RegistryAddress: "registry.internal:5000"}}
+	err := a.CheckImage(context.Background(), CheckImageInput{Image: "registry.internal:5000/dp-u1-default/web:abc"})
+	var appErr *temporal.ApplicationError
+	if !errors.As(err, &appErr) || !appErr.NonRetryable() {
+		t.Fatalf("CheckImage err = %v, want a non-retryable application error", err)
+	}
+}
+
+func TestFetchToken_KeepsCredentialsOnRegistryHost(t *testing.T) {
+	var gotAuth bool
+	realm := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, gotAuth = r.BasicAuth()
+		w.Write([]byte(`{"token":"t"}`))
+	}))
+	defer realm.Close()
+
+	c := newRegistryClient(&RegistryCredentials{Username: "bot", Password: "s3cret"})
+	registry, _ := url.Parse("https://ghcr.io")
+	if _, err := c.fetchToken(context.Background(), map[string]string{"realm": realm.URL + "/token"}, registry, "acme/api"); err == nil {
+		t.Error("expected credentials for ghcr.io to be refused by a foreign realm")
+	}
+	if gotAuth {
+		t.Error("credentials were sent to a foreign realm")
+	}
+
+	registry, _ = url.Parse(realm.URL)
+	if _, err := c.fetchToken(context.Background(), map[string]string{"realm": realm.URL + "/token"}, registry, "acme/api"); err != nil || !gotAuth {
+		t.Errorf("realm on the registry host: err = %v, sent credentials = %v", err, gotAuth)
+	}
+}
@@ -0,0 +1,123 @@
+package k8sdeployments
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const dockerHubAuthKey = "https://index.docker.io/v1/"
+
+// RegistryCredentials authenticate pulls of a prebuilt image from a private
+// registry. They are stored encrypted on the service row and only decrypted
+// by the worker when rendering the imagePullSecret.
+type RegistryCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func (c RegistryCredentials) basicAuth() string {
+	return base64.StdEncoding.EncodeToString([]byte(c.Username + ":" + c.Password))
+}
+
+func EncryptRegistryCredentials(creds RegistryCredentials, encryptionKey string) (string, error) {
+	plaintext, err := json.Marshal(creds)
+	if err != nil {
+		return "", fmt.Errorf("marshal registry credentials: %w", err)
+	}
+
+	key := sha256.Sum256([]byte(encryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return "", fmt.Errorf("create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", fmt.Errorf("create GCM: %w", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+
+	ciphertext := gcm.Seal(nonce, nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func DecryptRegistryCredentials(encrypted, encryptionKey string) (*RegistryCredentials, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("decode registry credentials: %w", err)
+	}
+
+	key := sha256.Sum256([]byte(encryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create GCM: %w", err)
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("registry credentials ciphertext too short")
+	}
+
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt registry credentials: %w", err)
+	}
+
+	var creds RegistryCredentials
+	if err := json.Unmarshal(plaintext, &creds); err != nil {
+		return nil, fmt.Errorf("unmarshal registry credentials: %w", err)
+	}
+	return &creds, nil
+}
+
+func pullSecretName(name string) string {
+	return name + "-registry"
+}
+
+// buildPullSecret renders a kubernetes.io/dockerconfigjson Secret for the
+// registry serving the image.
+func buildPullSecret(namespace, name, registryDomain string, creds RegistryCredentials) (*corev1.Secret, error) {
+	authKey := registryDomain
+	if registryDomain == dockerHubDomain {
+		authKey = dockerHubAuthKey
+	}
+	config, err := json.Marshal(map[string]any{
+		"auths": map[string]any{
+			authKey: map[string]string{
+				"username": creds.Username,
+				"password": creds.Password,
+				"auth":     creds.basicAuth(),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal docker config: %w", err)
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pullSecretName(name),
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: config,
+		},
+	}, nil
+}
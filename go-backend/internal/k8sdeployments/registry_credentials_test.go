@@ -0,0 +1,56 @@
+package k8sdeployments
+
+import (
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestRegistryCredentialsRoundTrip(t *testing.T) {
+	creds := RegistryCredentials{Username: "bot", Password: "s3cret"}
+	encrypted, err := EncryptRegistryCredentials(creds, "key")
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	got, err := DecryptRegistryCredentials(encrypted, "key")
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	if *got != creds {
+		t.Errorf("got %+v, want %+v", *got, creds)
+	}
+	if _, err := DecryptRegistryCredentials(encrypted, "other-key"); err == nil {
+		t.Error("expected an error with the wrong key")
+	}
+}
+
+func TestBuildPullSecret(t *testing.T) {
+	tests := []struct {
+		domain  string
+		wantKey string
+	}{
+		{domain: "docker.io", wantKey: "https://index.docker.io/v1/"},
+		{domain: "ghcr.io", wantKey: "ghcr.io"},
+	}
+	for _, tt := range tests {
+		secret, err := buildPullSecret("dp-u-default", "api", tt.domain, RegistryCredentials{Username: "bot", Password: "s3cret"})
+		if err != nil {
+			t.Fatalf("buildPullSecret(%q): %v", tt.domain, err)
+		}
+		if secret.Name != "api-registry" || secret.Type != corev1.SecretTypeDockerConfigJson {
+			t.Errorf("unexpected secret %s of type %s", secret.Name, secret.Type)
+		}
+		var config struct {
+			Auths map[string]struct {
+				Auth string `json:"auth"`
+			} `json:"auths"`
+		}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			t.Fatalf("unmarshal docker config: %v", err)
+		}
+		if got := config.Auths[tt.wantKey].Auth; got != "Ym90OnMzY3JldA==" {
+			t.Errorf("auth for %q = %q", tt.wantKey, got)
+		}
+	}
+}
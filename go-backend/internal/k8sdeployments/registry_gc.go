@@ -0,0 +1,172 @@
+package k8sdeployments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// registryDescriptor points at a blob or child manifest.
+type registryDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+// registryManifest covers both image manifests (config + layers) and
+// indexes (manifests), which buildkit pushes for attestations.
+type registryManifest struct {
+	MediaType string               `json:"mediaType"`
+	Config    *registryDescriptor  `json:"config,omitempty"`
+	Layers    []registryDescriptor `json:"layers,omitempty"`
+	Manifests []registryDescriptor `json:"manifests,omitempty"`
+}
+
+var registryNextLink = regexp.MustCompile(`<([^>]+)>;\s*rel="?next"?`)
+
+// listTags returns every tag in repository, following pagination. A
+// repository the registry does not know has no tags.
+func (c *registryClient) listTags(ctx context.Context, baseURL, repository string) ([]string, error) {
+	var tags []string
+	next := fmt.Sprintf("%s/v2/%s/tags/list?n=1000", baseURL, repository)
+	for next != "" {
+		resp, err := c.do(ctx, http.MethodGet, next, repository)
+		if err != nil {
+			return nil, fmt.Errorf("registry list tags: %w", err)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			resp.Body.Close()
+			return tags, nil
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("registry list tags unexpected status: %d", resp.StatusCode)
+		}
+
+		var page struct {
+			Tags []string `json:"tags"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("decode tag list: %w", err)
+		}
+		tags = append(tags, page.Tags...)
+
+		next = ""
+		if m := registryNextLink.FindStringSubmatch(resp.Header.Get("Link")); m != nil {
+			next = m[1]
+			if strings.HasPrefix(next, "/") {
+				next = baseURL + next
+			}
+		}
+	}
+	return tags, nil
+}
+
+// getManifest fetches a manifest and returns it with its own size in bytes.
+func (c *registryClient) getManifest(ctx context.Context, baseURL, repository, ref string) (*registryManifest, int64, error) {
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/v2/%s/manifests/%s", baseURL, repository, ref), repository)
+	if err != nil {
+		return nil, 0, fmt.Errorf("registry GET manifest: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("registry GET manifest unexpected status: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read manifest: %w", err)
+	}
+	var m registryManifest
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, 0, fmt.Errorf("decode manifest: %w", err)
+	}
+	return &m, int64(len(body)), nil
+}
+
+// deleteManifest removes a manifest by digest, which untags every tag that
+// points at it. The registry must run with storage deletion enabled.
+func (c *registryClient) deleteManifest(ctx context.Context, baseURL, repository, digest string) error {
+	resp, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/v2/%s/manifests/%s", baseURL, repository, digest), repository)
+	if err != nil {
+		return fmt.Errorf("registry DELETE manifest: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusAccepted, http.StatusOK, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("registry DELETE manifest unexpected status: %d", resp.StatusCode)
+	}
+}
+
+// manifestBlobs adds the manifest and everything it references (config,
+// layers, child manifests of an index) to blobs, keyed by digest.
+func (c *registryClient) manifestBlobs(ctx context.Context, baseURL, repository, digest string, blobs map[string]int64) error {
+	if _, seen := blobs[digest]; seen {
+		return nil
+	}
+	m, size, err := c.getManifest(ctx, baseURL, repository, digest)
+	if err != nil {
+		return err
+	}
+	blobs[digest] = size
+	if m.Config != nil {
+		blobs[m.Config.Digest] = m.Config.Size
+	}
+	for _, l := range m.Layers {
+		blobs[l.Digest] = l.Size
+	}
+	for _, child := range m.Manifests {
+		if err := c.manifestBlobs(ctx, baseURL, repository, child.Digest, blobs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planRegistryGC picks the manifests to delete from a repository given the
+// digest behind each tag and the refs (tags or digests) to keep. Tags that
+// share a digest with a kept ref survive, since deleting a manifest drops
+// all of its tags.
+func planRegistryGC(tagDigests map[string]string, keep []string) (remove []string, kept map[string]bool) {
+	kept = make(map[string]bool, len(keep))
+	for _, ref := range keep {
+		if strings.HasPrefix(ref, "sha256:") {
+			kept[ref] = true
+		} else if digest, ok := tagDigests[ref]; ok {
+			kept[digest] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, digest := range tagDigests {
+		if kept[digest] || seen[digest] {
+			continue
+		}
+		seen[digest] = true
+		remove = append(remove, digest)
+	}
+	sort.Strings(remove)
+	return remove, kept
+}
+
+// reclaimableBytes is the size of blobs only referenced by removed
+// manifests. Layers shared with kept images stay on disk.
+func reclaimableBytes(removed, kept map[string]int64) int64 {
+	var total int64
+	for digest, size := range removed {
+		if _, ok := kept[digest]; !ok {
+			total += size
+		}
+	}
+	return total
+}
@@ -0,0 +1,86 @@
+package k8sdeployments
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestPlanRegistryGC(t *testing.T) {
+	tagDigests := map[string]string{
+		"a1": "sha256:aaa",
+		"b2": "sha256:bbb",
+		"c3": "sha256:ccc",
+		"c4": "sha256:ccc", // rebuilt to the same manifest
+		"d5": "sha256:ddd",
+	}
+
+	tests := []struct {
+		name string
+		keep []string
+		want []string
+	}{
+		{
+			name: "keeps tags and everything sharing their digest",
+			keep: []string{"c3", "d5"},
+			want: []string{"sha256:aaa", "sha256:bbb"},
+		},
+		{
+			name: "keeps digest refs",
+			keep: []string{"sha256:aaa", "b2"},
+			want: []string{"sha256:ccc", "sha256:ddd"},
+		},
+		{
+			name: "unknown refs keep nothing",
+			keep: []string{"gone"},
+			want: []string{"sha256:aaa", "sha256:bbb", "sha256:ccc", "sha256:ddd"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := planRegistryGC(tagDigests, tt.keep)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planRegistryGC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReclaimableBytes(t *testing.T) {
+	removed := map[string]int64{"sha256:m1": 500, "sha256:base": 1000, "sha256:app": 200}
+	kept := map[string]int64{"sha256:m2": 500, "sha256:base": 1000}
+	if got := reclaimableBytes(removed, kept); got != 700 {
+		t.Errorf("reclaimableBytes() = %d, want 700", got)
+	}
+}
+
+func TestListTagsFollowsPagination(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v2/dp-u-default/api/tags/list" && r.URL.Query().Get("last") == "":
+			w.Header().Set("Link", `</v2/dp-u-default/api/tags/list?n=1000&last=b>; rel="next"`)
+			w.Write([]byte(`{"name":"dp-u-default/api","tags":["a","b"]}`))
+		case r.URL.Path == "/v2/dp-u-default/api/tags/list":
+			w.Write([]byte(`{"name":"dp-u-default/api","tags":["c"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := newRegistryClient(nil)
+	tags, err := client.listTags(context.Background(), srv.URL, "dp-u-default/api")
+	if err != nil {
+		t.Fatalf("listTags: %v", err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("tags = %v, want %v", tags, want)
+	}
+
+	tags, err = client.listTags(context.Background(), srv.URL, "dp-u-default/gone")
+	if err != nil || len(tags) != 0 {
+		t.Errorf("unknown repository: tags = %v, err = %v", tags, err)
+	}
+}
@@ -0,0 +1,118 @@
+package k8sdeployments
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const routesLabel = "dp.ml.ink/routes"
+
+// routePriorityBase lifts path routes above the routers Traefik derives for a
+// service's own Ingress and custom-domain IngressRoute, whose default
+// priority is their rule length and so stays well below it.
+const routePriorityBase = 10000
+
+func routeIngressName(host string) string {
+	return sanitizeDNS("rt-" + strings.ReplaceAll(host, ".", "-"))
+}
+
+func routeStripMiddlewareName(routeID string) string {
+	return sanitizeDNS("rt-" + routeID + "-strip")
+}
+
+// groupRoutesByHost returns routes grouped per host with hosts sorted so the
+// rendered objects are stable across syncs.
+func groupRoutesByHost(routes []ProjectRouteSpec) ([]string, map[string][]ProjectRouteSpec) {
+	byHost := make(map[string][]ProjectRouteSpec)
+	for _, r := range routes {
+		byHost[r.Host] = append(byHost[r.Host], r)
+	}
+	hosts := make([]string, 0, len(byHost))
+	for h := range byHost {
+		hosts = append(hosts, h)
+	}
+	sort.Strings(hosts)
+	return hosts, byHost
+}
+
+func routeMatch(host, pathPrefix string) string {
+	if pathPrefix == "" || pathPrefix == "/" {
+		return fmt.Sprintf("Host(`%s`)", host)
+	}
+	return fmt.Sprintf("Host(`%s`) && PathPrefix(`%s`)", host, pathPrefix)
+}
+
+// buildRouteIngressRoute renders all path routes of one host. Priorities
+// grow with the prefix length so longer prefixes still win over "/", and all
+// of them win over the routers of the service that owns the host.
+func buildRouteIngressRoute(namespace, host string, routes []ProjectRouteSpec) *unstructured.Unstructured {
+	specRoutes := make([]any, 0, len(routes))
+	for _, r := range routes {
+		var middlewares []any
+		for _, mw := range r.Middlewares {
+			middlewares = append(middlewares, map[string]any{"name": mw})
+		}
+		if r.StripPrefix && r.PathPrefix != "/" {
+			middlewares = append(middlewares, map[string]any{"name": routeStripMiddlewareName(r.RouteID)})
+		}
+
+		route := map[string]any{
+			"match":    routeMatch(host, r.PathPrefix),
+			"kind":     "Rule",
+			"priority": int64(routePriorityBase + len(r.PathPrefix)),
+			"services": []any{
+				map[string]any{
+					"name": r.ServiceName,
+					"port": int64(r.ServicePort),
+				},
+			},
+		}
+		if len(middlewares) > 0 {
+			route["middlewares"] = middlewares
+		}
+		specRoutes = append(specRoutes, route)
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "traefik.io/v1alpha1",
+			"kind":       "IngressRoute",
+			"metadata": map[string]any{
+				"name":      routeIngressName(host),
+				"namespace": namespace,
+				"labels": map[string]any{
+					routesLabel: "true",
+				},
+			},
+			"spec": map[string]any{
+				"entryPoints": []any{"websecure"},
+				"routes":      specRoutes,
+				"tls":         map[string]any{},
+			},
+		},
+	}
+}
+
+func buildStripPrefixMiddleware(namespace string, r ProjectRouteSpec) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "traefik.io/v1alpha1",
+			"kind":       "Middleware",
+			"metadata": map[string]any{
+				"name":      routeStripMiddlewareName(r.RouteID),
+				"namespace": namespace,
+				"labels": map[string]any{
+					routesLabel: "true",
+				},
+			},
+			"spec": map[string]any{
+				"stripPrefix": map[string]any{
+					"prefixes": []any{r.PathPrefix},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package k8sdeployments
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestBuildRouteIngressRoute(t *testing.T) {
+	ir := buildRouteIngressRoute("dp-u1-default", "app.ml.ink", []ProjectRouteSpec{
+		{RouteID: "R1", Host: "app.ml.ink", PathPrefix: "/", ServiceName: "web", ServicePort: 8080},
+		{RouteID: "R2", Host: "app.ml.ink", PathPrefix: "/api", ServiceName: "api", ServicePort: 3000, StripPrefix: true, Middlewares: []string{"api-basic-auth"}},
+	})
+
+	if got := ir.GetName(); got != "rt-app-ml-ink" {
+		t.Fatalf("name = %q, want %q", got, "rt-app-ml-ink")
+	}
+
+	routes, _, _ := unstructured.NestedSlice(ir.Object, "spec", "routes")
+	if len(routes) != 2 {
+		t.Fatalf("got %d routes, want 2", len(routes))
+	}
+
+	root := routes[0].(map[string]any)
+	if root["match"] != "Host(`app.ml.ink`)" {
+		t.Fatalf("root match = %v", root["match"])
+	}
+	if _, ok := root["middlewares"]; ok {
+		t.Fatalf("root route should have no middlewares")
+	}
+
+	api := routes[1].(map[string]any)
+	if api["match"] != "Host(`app.ml.ink`) && PathPrefix(`/api`)" {
+		t.Fatalf("api match = %v", api["match"])
+	}
+	rootPriority, apiPriority := root["priority"].(int64), api["priority"].(int64)
+	if rootPriority <= int64(len(root["match"].(string))) {
+		t.Fatalf("root priority = %d, should beat the owning service's Host rule", rootPriority)
+	}
+	if apiPriority <= rootPriority {
+		t.Fatalf("api priority = %d, should beat root priority %d", apiPriority, rootPriority)
+	}
+	mws := api["middlewares"].([]any)
+	if len(mws) != 2 {
+		t.Fatalf("api middlewares = %v, want access + strip", mws)
+	}
+	if name := mws[1].(map[string]any)["name"]; name != "rt-r2-strip" {
+		t.Fatalf("strip middleware = %v, want rt-r2-strip", name)
+	}
+}
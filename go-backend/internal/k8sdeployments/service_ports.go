@@ -0,0 +1,116 @@
+package k8sdeployments
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ServicePort is an additional non-HTTP port stored in services.ports.
+// PublicPort is the cluster-allocated NodePort clients connect to.
+type ServicePort struct {
+	Port       int32  `json:"port"`
+	Protocol   string `json:"protocol"`
+	PublicPort int32  `json:"public_port"`
+}
+
+func ParseServicePorts(raw []byte) []ServicePort {
+	var ports []ServicePort
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &ports)
+	}
+	return ports
+}
+
+func externalServiceName(name string) string {
+	return name + "-ext"
+}
+
+func servicePortName(p ServicePort) string {
+	return fmt.Sprintf("%s-%d", p.Protocol, p.Port)
+}
+
+func k8sProtocol(protocol string) corev1.Protocol {
+	if strings.EqualFold(protocol, "udp") {
+		return corev1.ProtocolUDP
+	}
+	return corev1.ProtocolTCP
+}
+
+func containerPorts(port int32, extra []ServicePort) []corev1.ContainerPort {
+	ports := []corev1.ContainerPort{{ContainerPort: port}}
+	for _, p := range extra {
+		if p.Port == port && k8sProtocol(p.Protocol) == corev1.ProtocolTCP {
+			continue
+		}
+		ports = append(ports, corev1.ContainerPort{
+			Name:          servicePortName(p),
+			ContainerPort: p.Port,
+			Protocol:      k8sProtocol(p.Protocol),
+		})
+	}
+	return ports
+}
+
+// buildExternalService exposes the extra ports on their allocated NodePorts.
+func buildExternalService(namespace, name string, ports []ServicePort) *corev1.Service {
+	svcPorts := make([]corev1.ServicePort, len(ports))
+	for i, p := range ports {
+		svcPorts[i] = corev1.ServicePort{
+			Name:       servicePortName(p),
+			Protocol:   k8sProtocol(p.Protocol),
+			Port:       p.Port,
+			TargetPort: intstr.FromInt32(p.Port),
+			NodePort:   p.PublicPort,
+		}
+	}
+
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      externalServiceName(name),
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeNodePort,
+			Selector: map[string]string{"app": name},
+			Ports:    svcPorts,
+		},
+	}
+}
+
+// buildExternalNetworkPolicy admits public traffic on the extra ports only;
+// the namespace-wide ingress-isolation policy still covers everything else.
+func buildExternalNetworkPolicy(namespace, name string, ports []ServicePort) *networkingv1.NetworkPolicy {
+	npPorts := make([]networkingv1.NetworkPolicyPort, len(ports))
+	for i, p := range ports {
+		proto := k8sProtocol(p.Protocol)
+		port := intstr.FromInt32(p.Port)
+		npPorts[i] = networkingv1.NetworkPolicyPort{Protocol: &proto, Port: &port}
+	}
+
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      externalServiceName(name),
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": name},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From:  []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}}},
+					Ports: npPorts,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package k8sdeployments
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// sleepingAnnotation marks a Deployment scaled to zero by the idle
+	// sleeper. Only Deployments carrying it are woken by the waker, so a
+	// Deployment scaled down for any other reason stays down.
+	sleepingAnnotation  = "dp.ml.ink/sleeping"
+	serviceIDAnnotation = "dp.ml.ink/service-id"
+
+	// wakerNamespace hosts the waker Service referenced by every wake
+	// middleware.
+	wakerNamespace = "dp-system"
+)
+
+func wakeMiddlewareName(name string) string {
+	return name + "-wake"
+}
+
+// WakeMiddlewares returns the Traefik Middleware names that wake a sleeping
+// service. The errors middleware must sit last in the chain so access
+// middlewares still reject requests before anything is scaled up.
+func WakeMiddlewares(name string, sleepAfterIdle *int32) []string {
+	if sleepAfterIdle == nil {
+		return nil
+	}
+	return []string{wakeMiddlewareName(name)}
+}
+
+// wakePath is the waker endpoint the errors middleware calls when the
+// service has no ready endpoints.
+func wakePath(namespace, name string) string {
+	return fmt.Sprintf("/wake/%s/%s", namespace, name)
+}
+
+// buildWakeMiddleware renders an errors middleware that hands 502-504
+// responses for the service to the waker in the system namespace. Traefik
+// needs allowCrossNamespace for the service reference to resolve.
+func buildWakeMiddleware(namespace, name string, cfg Config) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "traefik.io/v1alpha1",
+			"kind":       "Middleware",
+			"metadata": map[string]any{
+				"name":      wakeMiddlewareName(name),
+				"namespace": namespace,
+			},
+			"spec": map[string]any{
+				"errors": map[string]any{
+					"status": []any{"502-504"},
+					"service": map[string]any{
+						"name":      cfg.WakerService,
+						"namespace": wakerNamespace,
+						"port":      int64(ParsePortString(cfg.WakerPort)),
+					},
+					"query": wakePath(namespace, name),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package k8sdeployments
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWakeMiddlewares(t *testing.T) {
+	if got := WakeMiddlewares("web", nil); len(got) != 0 {
+		t.Fatalf("WakeMiddlewares(nil) = %v, want none", got)
+	}
+	idle := int32(1800)
+	got := WakeMiddlewares("web", &idle)
+	if len(got) != 1 || got[0] != "web-wake" {
+		t.Fatalf("WakeMiddlewares = %v, want [web-wake]", got)
+	}
+}
+
+func TestBuildWakeMiddleware(t *testing.T) {
+	mw := buildWakeMiddleware("dp-u1-default", "web", Config{WakerService: "deployer-waker", WakerPort: "8095"})
+
+	if mw.GetName() != "web-wake" || mw.GetNamespace() != "dp-u1-default" {
+		t.Fatalf("unexpected metadata: %s/%s", mw.GetNamespace(), mw.GetName())
+	}
+	query, _, _ := unstructured.NestedString(mw.Object, "spec", "errors", "query")
+	if query != "/wake/dp-u1-default/web" {
+		t.Errorf("query = %q", query)
+	}
+	svc, _, _ := unstructured.NestedMap(mw.Object, "spec", "errors", "service")
+	if svc["name"] != "deployer-waker" || svc["namespace"] != "dp-system" || svc["port"] != int64(8095) {
+		t.Errorf("service = %v", svc)
+	}
+}
@@ -0,0 +1,324 @@
+package k8sdeployments
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+// Netlify-style files read from the site root at build time. They are not
+// served.
+const (
+	redirectsFileName = "_redirects"
+	headersFileName   = "_headers"
+)
+
+const (
+	maxStaticRedirects = 200
+	maxStaticHeaders   = 100
+)
+
+// StaticRedirect sends requests for From to To. From is a path that may end
+// in "/*"; To may use ":splat" for the matched remainder. Status 200 is a
+// rewrite: the file at To is served when From doesn't exist on disk.
+type StaticRedirect struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Status int    `json:"status,omitempty"` // 301 (default), 302 or 200
+}
+
+// StaticHeader sets a response header on paths matching Path, where "*"
+// matches anything (e.g. "/assets/*"). The first matching rule wins for a
+// given header name.
+type StaticHeader struct {
+	Path  string `json:"path"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// StaticSiteConfig controls the nginx config generated for static builds.
+type StaticSiteConfig struct {
+	// SPAFallback serves /index.html for unknown paths so client-side
+	// routing survives a refresh. Nil means on.
+	SPAFallback  *bool            `json:"spa_fallback,omitempty"`
+	NotFoundPage string           `json:"not_found_page,omitempty"`
+	Headers      []StaticHeader   `json:"headers,omitempty"`
+	Redirects    []StaticRedirect `json:"redirects,omitempty"`
+}
+
+func (c StaticSiteConfig) isZero() bool {
+	return c.SPAFallback == nil && c.NotFoundPage == "" && len(c.Headers) == 0 && len(c.Redirects) == 0
+}
+
+var (
+	headerNameRe  = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+	staticPathRe  = regexp.MustCompile(`^/[^\s;{}"'\\$#?]*$`)
+	redirectURLRe = regexp.MustCompile(`^https?://[^\s;{}"'\\$]+$`)
+)
+
+// ValidateStaticSiteConfig checks rules before they end up in nginx.conf.
+func ValidateStaticSiteConfig(c StaticSiteConfig) error {
+	if len(c.Redirects) > maxStaticRedirects {
+		return fmt.Errorf("at most %d redirects are allowed", maxStaticRedirects)
+	}
+	if len(c.Headers) > maxStaticHeaders {
+		return fmt.Errorf("at most %d headers are allowed", maxStaticHeaders)
+	}
+	if c.NotFoundPage != "" && !isStaticFilePath(c.NotFoundPage) {
+		return fmt.Errorf("invalid not_found_page %q", c.NotFoundPage)
+	}
+	for _, r := range c.Redirects {
+		if err := validateStaticRedirect(r); err != nil {
+			return err
+		}
+	}
+	for _, h := range c.Headers {
+		if err := validateStaticHeader(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isStaticFilePath(p string) bool {
+	return staticPathRe.MatchString(p) && !strings.Contains(p, "*") && !strings.Contains(p, "..")
+}
+
+func validateStaticRedirect(r StaticRedirect) error {
+	from := strings.TrimSuffix(r.From, "*")
+	if !staticPathRe.MatchString(from) || strings.Contains(from, "*") {
+		return fmt.Errorf("invalid redirect source %q: must be a path, optionally ending in /*", r.From)
+	}
+	to := strings.ReplaceAll(r.To, ":splat", "")
+	switch r.Status {
+	case 0, 301, 302:
+		if !staticPathRe.MatchString(to) && !redirectURLRe.MatchString(to) {
+			return fmt.Errorf("invalid redirect target %q", r.To)
+		}
+	case 200:
+		if !isStaticFilePath(to) {
+			return fmt.Errorf("invalid rewrite target %q: must be a path on the site", r.To)
+		}
+	default:
+		return fmt.Errorf("unsupported redirect status %d for %q (use 301, 302 or 200)", r.Status, r.From)
+	}
+	if strings.Contains(r.To, ":splat") && !strings.HasSuffix(r.From, "*") {
+		return fmt.Errorf("redirect %q uses :splat but its source has no *", r.From)
+	}
+	return nil
+}
+
+func validateStaticHeader(h StaticHeader) error {
+	if !staticPathRe.MatchString(h.Path) {
+		return fmt.Errorf("invalid header path %q", h.Path)
+	}
+	if !headerNameRe.MatchString(h.Name) {
+		return fmt.Errorf("invalid header name %q", h.Name)
+	}
+	// nginx would expand $variables inside the value
+	if strings.ContainsAny(h.Value, "$\r\n") {
+		return fmt.Errorf("header %s value must not contain $ or newlines", h.Name)
+	}
+	return nil
+}
+
+// loadStaticSiteFiles reads _redirects and _headers from the first of dirs
+// that has them. Missing files are not an error.
+func loadStaticSiteFiles(dirs ...string) (redirects []StaticRedirect, headers []StaticHeader, err error) {
+	for _, dir := range dirs {
+		if redirects != nil {
+			break
+		}
+		data, err := os.ReadFile(filepath.Join(dir, redirectsFileName))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("read %s: %w", redirectsFileName, err)
+		}
+		if redirects, err = parseRedirectsFile(string(data)); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, dir := range dirs {
+		if headers != nil {
+			break
+		}
+		data, err := os.ReadFile(filepath.Join(dir, headersFileName))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("read %s: %w", headersFileName, err)
+		}
+		if headers, err = parseHeadersFile(string(data)); err != nil {
+			return nil, nil, err
+		}
+	}
+	return redirects, headers, nil
+}
+
+// parseRedirectsFile parses "from to [status]" lines. Status defaults to
+// 301; a trailing "!" (forced) is accepted and ignored.
+func parseRedirectsFile(content string) ([]StaticRedirect, error) {
+	out := []StaticRedirect{}
+	sc := bufio.NewScanner(strings.NewReader(content))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("%s line %d: expected \"from to [status]\"", redirectsFileName, n)
+		}
+		r := StaticRedirect{From: fields[0], To: fields[1], Status: 301}
+		if len(fields) == 3 {
+			status, err := strconv.Atoi(strings.TrimSuffix(fields[2], "!"))
+			if err != nil {
+				return nil, fmt.Errorf("%s line %d: invalid status %q", redirectsFileName, n, fields[2])
+			}
+			r.Status = status
+		}
+		if err := validateStaticRedirect(r); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", redirectsFileName, n, err)
+		}
+		out = append(out, r)
+	}
+	return out, sc.Err()
+}
+
+// parseHeadersFile parses a path line followed by indented "Name: value"
+// lines.
+func parseHeadersFile(content string) ([]StaticHeader, error) {
+	out := []StaticHeader{}
+	path := ""
+	sc := bufio.NewScanner(strings.NewReader(content))
+	for n := 1; sc.Scan(); n++ {
+		raw := sc.Text()
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if raw[0] != ' ' && raw[0] != '\t' {
+			path = line
+			continue
+		}
+		if path == "" {
+			return nil, fmt.Errorf("%s line %d: header before any path", headersFileName, n)
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("%s line %d: expected \"Name: value\"", headersFileName, n)
+		}
+		h := StaticHeader{Path: path, Name: strings.TrimSpace(name), Value: strings.TrimSpace(value)}
+		if err := validateStaticHeader(h); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", headersFileName, n, err)
+		}
+		out = append(out, h)
+	}
+	return out, sc.Err()
+}
+
+// staticPathPattern turns a rule path into an anchored nginx regex; "*"
+// captures the rest of the path.
+func staticPathPattern(p string) string {
+	return "^" + strings.ReplaceAll(regexp.QuoteMeta(p), `\*`, "(.*)") + "$"
+}
+
+// renderStaticNginxConf generates the nginx server block for a static site.
+// File rules come before the ones stored in the build config.
+func renderStaticNginxConf(c StaticSiteConfig) (string, error) {
+	if err := ValidateStaticSiteConfig(c); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	// One map per header name so several paths can set it; add_header
+	// skips empty values, so unmatched paths get nothing.
+	var headerNames []string
+	byName := map[string][]StaticHeader{}
+	for _, h := range c.Headers {
+		key := strings.ToLower(h.Name)
+		if _, ok := byName[key]; !ok {
+			headerNames = append(headerNames, key)
+		}
+		byName[key] = append(byName[key], h)
+	}
+	for i, key := range headerNames {
+		fmt.Fprintf(&b, "map $uri $static_header_%d {\n    default \"\";\n", i)
+		for _, h := range byName[key] {
+			fmt.Fprintf(&b, "    \"~%s\" %s;\n", staticPathPattern(h.Path), nginxQuote(h.Value))
+		}
+		b.WriteString("}\n\n")
+	}
+
+	b.WriteString("server {\n    listen 8080;\n    server_name _;\n    root /usr/share/nginx/html;\n    index index.html;\n    absolute_redirect off;\n")
+	for i, key := range headerNames {
+		fmt.Fprintf(&b, "    add_header %s $static_header_%d always;\n", byName[key][0].Name, i)
+	}
+	if c.NotFoundPage != "" {
+		fmt.Fprintf(&b, "    error_page 404 %s;\n", c.NotFoundPage)
+	}
+
+	fallback := "/index.html"
+	if c.SPAFallback != nil && !*c.SPAFallback {
+		fallback = "=404"
+	}
+	for _, r := range c.Redirects {
+		if r.From == "/*" && r.Status == 200 && !strings.Contains(r.To, ":splat") {
+			// The usual "/* /index.html 200" SPA rule
+			fallback = r.To
+			continue
+		}
+		b.WriteString("\n")
+		splat := strings.HasSuffix(r.From, "*")
+		if splat {
+			fmt.Fprintf(&b, "    location ~ %s {\n", staticPathPattern(r.From))
+		} else {
+			fmt.Fprintf(&b, "    location = %s {\n", r.From)
+		}
+		to := strings.ReplaceAll(r.To, ":splat", "$1")
+		switch r.Status {
+		case 200:
+			fmt.Fprintf(&b, "        try_files $uri $uri/ %s;\n", to)
+		case 302:
+			fmt.Fprintf(&b, "        return 302 %s$is_args$args;\n", to)
+		default:
+			fmt.Fprintf(&b, "        return 301 %s$is_args$args;\n", to)
+		}
+		b.WriteString("    }\n")
+	}
+
+	fmt.Fprintf(&b, "\n    location / {\n        try_files $uri $uri/ %s;\n    }\n}\n", fallback)
+	return b.String(), nil
+}
+
+func nginxQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// staticNginxConf merges the _redirects/_headers files found in dirs with
+// the configured rules and renders nginx.conf.
+func staticNginxConf(c StaticSiteConfig, dirs ...string) (string, error) {
+	redirects, headers, err := loadStaticSiteFiles(dirs...)
+	if err != nil {
+		return "", err
+	}
+	c.Redirects = append(redirects, c.Redirects...)
+	c.Headers = append(headers, c.Headers...)
+	return renderStaticNginxConf(c)
+}
+
+// invalidStaticConfigError fails the build without retries: a bad
+// _redirects or _headers file won't fix itself.
+func invalidStaticConfigError(err error) error {
+	return temporal.NewNonRetryableApplicationError(err.Error(), "invalid_static_config", err)
+}
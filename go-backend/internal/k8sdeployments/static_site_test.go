@@ -0,0 +1,182 @@
+package k8sdeployments
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseRedirectsFile(t *testing.T) {
+	content := `# comments and blank lines are skipped
+
+/old-page      /new-page
+/blog/*        /news/:splat   302
+/app/*         /app/index.html 200!
+/docs          https://docs.example.com 301
+`
+	got, err := parseRedirectsFile(content)
+	if err != nil {
+		t.Fatalf("parseRedirectsFile: %v", err)
+	}
+	want := []StaticRedirect{
+		{From: "/old-page", To: "/new-page", Status: 301},
+		{From: "/blog/*", To: "/news/:splat", Status: 302},
+		{From: "/app/*", To: "/app/index.html", Status: 200},
+		{From: "/docs", To: "https://docs.example.com", Status: 301},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseRedirectsFile = %+v, want %+v", got, want)
+	}
+
+	bad := []string{
+		"/only-one-field",
+		"/a /b 404",
+		"/a /b; 301",
+		"/a /:splat",
+		"/a/* https://evil.example.com/$host",
+	}
+	for _, content := range bad {
+		if _, err := parseRedirectsFile(content); err == nil {
+			t.Errorf("parseRedirectsFile(%q) expected error", content)
+		}
+	}
+}
+
+func TestParseHeadersFile(t *testing.T) {
+	content := `/assets/*
+  Cache-Control: public, max-age=31536000, immutable
+
+/*
+  X-Frame-Options: DENY
+  Content-Security-Policy: default-src 'self'; img-src *
+`
+	got, err := parseHeadersFile(content)
+	if err != nil {
+		t.Fatalf("parseHeadersFile: %v", err)
+	}
+	want := []StaticHeader{
+		{Path: "/assets/*", Name: "Cache-Control", Value: "public, max-age=31536000, immutable"},
+		{Path: "/*", Name: "X-Frame-Options", Value: "DENY"},
+		{Path: "/*", Name: "Content-Security-Policy", Value: "default-src 'self'; img-src *"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseHeadersFile = %+v, want %+v", got, want)
+	}
+
+	bad := []string{
+		"  X-Early: 1",
+		"/*\n  not a header",
+		"/*\n  Bad Name: 1",
+		"/*\n  X-Var: $host",
+	}
+	for _, content := range bad {
+		if _, err := parseHeadersFile(content); err == nil {
+			t.Errorf("parseHeadersFile(%q) expected error", content)
+		}
+	}
+}
+
+func TestRenderStaticNginxConf(t *testing.T) {
+	off := false
+	tests := []struct {
+		name    string
+		cfg     StaticSiteConfig
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "default spa fallback",
+			want:    []string{"listen 8080;", "try_files $uri $uri/ /index.html;"},
+			notWant: []string{"error_page", "add_header"},
+		},
+		{
+			name: "404 page without fallback",
+			cfg:  StaticSiteConfig{SPAFallback: &off, NotFoundPage: "/404.html"},
+			want: []string{"error_page 404 /404.html;", "try_files $uri $uri/ =404;"},
+		},
+		{
+			name: "spa rule from _redirects",
+			cfg:  StaticSiteConfig{SPAFallback: &off, Redirects: []StaticRedirect{{From: "/*", To: "/app.html", Status: 200}}},
+			want: []string{"try_files $uri $uri/ /app.html;"},
+		},
+		{
+			name: "redirects",
+			cfg: StaticSiteConfig{Redirects: []StaticRedirect{
+				{From: "/old", To: "/new"},
+				{From: "/blog/*", To: "/news/:splat", Status: 302},
+				{From: "/app/*", To: "/app/index.html", Status: 200},
+			}},
+			want: []string{
+				"location = /old {\n        return 301 /new$is_args$args;",
+				"location ~ ^/blog/(.*)$ {\n        return 302 /news/$1$is_args$args;",
+				"location ~ ^/app/(.*)$ {\n        try_files $uri $uri/ /app/index.html;",
+			},
+		},
+		{
+			name: "headers",
+			cfg: StaticSiteConfig{Headers: []StaticHeader{
+				{Path: "/assets/*", Name: "Cache-Control", Value: "immutable"},
+				{Path: "/*", Name: "cache-control", Value: "no-cache"},
+				{Path: "/*", Name: "Content-Security-Policy", Value: `default-src 'self'; script-src "x"`},
+			}},
+			want: []string{
+				"map $uri $static_header_0 {\n    default \"\";\n    \"~^/assets/(.*)$\" \"immutable\";\n    \"~^/(.*)$\" \"no-cache\";\n}",
+				`"~^/(.*)$" "default-src 'self'; script-src \"x\"";`,
+				"add_header Cache-Control $static_header_0 always;",
+				"add_header Content-Security-Policy $static_header_1 always;",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf, err := renderStaticNginxConf(tt.cfg)
+			if err != nil {
+				t.Fatalf("renderStaticNginxConf: %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(conf, w) {
+					t.Errorf("config missing %q:\n%s", w, conf)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(conf, w) {
+					t.Errorf("config should not contain %q:\n%s", w, conf)
+				}
+			}
+		})
+	}
+}
+
+func TestStaticNginxConf_FilesBeforeConfig(t *testing.T) {
+	dist := t.TempDir()
+	public := t.TempDir()
+	if err := os.WriteFile(filepath.Join(public, "_redirects"), []byte("/from-public /x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(public, "_headers"), []byte("/*\n  X-Test: 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	conf, err := staticNginxConf(StaticSiteConfig{Redirects: []StaticRedirect{{From: "/from-config", To: "/y"}}}, dist, public)
+	if err != nil {
+		t.Fatalf("staticNginxConf: %v", err)
+	}
+	fileIdx := strings.Index(conf, "location = /from-public")
+	configIdx := strings.Index(conf, "location = /from-config")
+	if fileIdx < 0 || configIdx < 0 || fileIdx > configIdx {
+		t.Errorf("expected _redirects rules before config rules:\n%s", conf)
+	}
+	if !strings.Contains(conf, "add_header X-Test") {
+		t.Errorf("expected _headers rule:\n%s", conf)
+	}
+
+	if err := os.WriteFile(filepath.Join(dist, "_redirects"), []byte("/broken\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := staticNginxConf(StaticSiteConfig{}, dist, public); err == nil {
+		t.Error("expected error for an invalid _redirects file")
+	}
+}
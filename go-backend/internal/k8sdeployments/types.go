@@ -1,5 +1,7 @@
 package k8sdeployments
 
+import "time"
+
 type DeployServiceInput struct {
 	ServiceID      string
 	DeploymentID   string
@@ -8,7 +10,11 @@ type DeployServiceInput struct {
 	GitProvider    string
 	InstallationID int64
 	CommitSHA      string
-	AppsDomain     string
+	// Ref pins the deploy to a commit SHA, tag or branch instead of
+	// branch HEAD
+	Ref        string
+	AppsDomain string
+	BuildPack  string
 }
 
 type DeployServiceResult struct {
@@ -32,6 +38,9 @@ type DeleteServiceWorkflowInput struct {
 	ServiceID string
 	Namespace string
 	Name      string
+	// InFlightWorkflowIDs are deploy workflows cancelled by the caller; the
+	// delete waits for them to close before removing anything.
+	InFlightWorkflowIDs []string
 }
 
 type DeleteServiceWorkflowResult struct {
@@ -48,6 +57,7 @@ type BuildServiceWorkflowInput struct {
 	GitProvider    string
 	InstallationID int64
 	CommitSHA      string
+	Ref            string
 }
 
 type BuildServiceWorkflowResult struct {
@@ -63,6 +73,7 @@ type CloneRepoInput struct {
 	GitProvider    string
 	InstallationID int64
 	CommitSHA      string
+	Ref            string
 }
 
 type CloneRepoResult struct {
@@ -71,9 +82,10 @@ type CloneRepoResult struct {
 }
 
 type ResolveBuildContextInput struct {
-	ServiceID  string
-	SourcePath string
-	CommitSHA  string
+	ServiceID    string
+	DeploymentID string // receives the effective config when ink.yaml is present
+	SourcePath   string
+	CommitSHA    string
 }
 
 type ResolveImageRefInput struct {
@@ -95,8 +107,12 @@ type ResolveBuildContextResult struct {
 	PublishDirectory    string
 	EffectiveSourcePath string
 	DockerfilePath      string
+	DockerfileTarget    string
+	BuildArgs           map[string]string
+	BuildContextPath    string // empty means EffectiveSourcePath
 	BuildCommand        string
 	StartCommand        string
+	StaticSite          StaticSiteConfig
 }
 
 type BuildImageInput struct {
@@ -108,8 +124,12 @@ type BuildImageInput struct {
 	EnvVars          map[string]string
 	PublishDirectory string
 	DockerfilePath   string
+	DockerfileTarget string
+	BuildArgs        map[string]string
+	BuildContextPath string
 	BuildCommand     string
 	StartCommand     string
+	StaticSite       StaticSiteConfig
 }
 
 type BuildImageResult struct {
@@ -117,11 +137,12 @@ type BuildImageResult struct {
 }
 
 type DeployInput struct {
-	ServiceID  string
-	ImageRef   string
-	CommitSHA  string
-	AppsDomain string
-	Port       string // resolved port from build phase; empty = re-read from DB
+	ServiceID    string
+	DeploymentID string // empty for workflows started before cancellation checks
+	ImageRef     string
+	CommitSHA    string
+	AppsDomain   string
+	Port         string // resolved port from build phase; empty = re-read from DB
 }
 
 type DeployResult struct {
@@ -155,6 +176,14 @@ type UpdateDeploymentBuildingInput struct {
 	DeploymentID string
 }
 
+type MarkDeploymentCancelledInput struct {
+	DeploymentID string
+}
+
+type WaitForWorkflowsClosedInput struct {
+	WorkflowIDs []string
+}
+
 type UpdateDeploymentDeployingInput struct {
 	DeploymentID string
 }
@@ -190,3 +219,144 @@ type MarkDeploymentRemovedInput struct {
 	DeploymentID string
 }
 
+type SyncProjectRoutesInput struct {
+	ProjectID string
+	Namespace string
+	Routes    []ProjectRouteSpec
+}
+
+// ProjectRouteSpec is one host + path prefix mapping rendered into the
+// host's IngressRoute. The full desired set is passed on every sync.
+type ProjectRouteSpec struct {
+	RouteID     string
+	Host        string
+	PathPrefix  string
+	ServiceName string
+	ServicePort int32
+	StripPrefix bool
+	Middlewares []string
+}
+
+type SyncProjectRoutesResult struct {
+	Hosts int
+}
+
+type SleepIdleServicesInput struct {
+	Region string
+}
+
+type SleepIdleServicesResult struct {
+	Slept int
+}
+
+type FindIdleServicesInput struct {
+	Region string
+}
+
+// IdleService is a running service that received no requests for its
+// configured sleep_after_idle window.
+type IdleService struct {
+	ServiceID string
+	Namespace string
+	Name      string
+}
+
+type SleepServiceInput struct {
+	ServiceID string
+	Namespace string
+	Name      string
+}
+
+type ServiceLifecycleWorkflowInput struct {
+	EventID   string
+	ServiceID string
+	Namespace string
+	Name      string
+	Action    string
+}
+
+type ServiceLifecycleWorkflowResult struct {
+	ServiceID    string
+	Action       string
+	Status       string
+	ErrorMessage string
+}
+
+type MarkServiceEventCompletedInput struct {
+	EventID string
+}
+
+type MarkServiceEventFailedInput struct {
+	EventID      string
+	ErrorMessage string
+}
+
+type ResolveImageInput struct {
+	ServiceID string
+}
+
+type ResolveImageResult struct {
+	ImageRef string
+}
+
+// CheckImageInput carries the registry credentials encrypted, as they are
+// stored on the service, so they never sit in workflow history in clear.
+type CheckImageInput struct {
+	Image               string
+	RegistryCredentials *string
+}
+
+type RegistryGCInput struct {
+	Region string
+}
+
+type RegistryGCResult struct {
+	Services         int
+	ManifestsDeleted int
+	BytesReclaimed   int64
+}
+
+type FindRegistryGCTargetsInput struct {
+	Region string
+}
+
+// RegistryGCTarget is one service's share of a registry GC run. Keep holds
+// tags or digests; Purge marks a deleted service whose repositories are
+// removed entirely.
+type RegistryGCTarget struct {
+	ServiceID    string
+	Repositories []string
+	Keep         []string
+	Purge        bool
+}
+
+type CollectRegistryGarbageResult struct {
+	ManifestsDeleted int
+	BytesReclaimed   int64
+}
+
+// DeployRequest asks a service's DeployCoordinatorWorkflow for a deploy.
+// Policy and Debounce are the service's settings when it was triggered.
+type DeployRequest struct {
+	Policy   string
+	Debounce time.Duration
+	// Initial runs the deploy as CreateServiceWorkflow for a new service.
+	Initial bool
+	Deploy  DeployServiceInput
+}
+
+type DeployCoordinatorInput struct {
+	ServiceID string
+	// Pending is carried over when the coordinator continues as new.
+	Pending *DeployRequest
+}
+
+type DeployCoordinatorResult struct {
+	ServiceID string
+	Deploys   int
+}
+
+type RecordDeploymentRunIDInput struct {
+	DeploymentID string
+	RunID        string
+}
@@ -0,0 +1,147 @@
+package k8sdeployments
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/go-chi/chi/v5"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	wakeReadyTimeout = 30 * time.Second
+	wakePollInterval = 500 * time.Millisecond
+)
+
+// Waker is the backend of every service's wake middleware. Traefik calls it
+// when a service answers 502-504; if the Deployment was put to sleep it is
+// scaled back to one replica and the request is held until it is ready.
+type Waker struct {
+	logger    *slog.Logger
+	k8s       kubernetes.Interface
+	servicesQ services.Querier
+}
+
+func NewWaker(logger *slog.Logger, k8s kubernetes.Interface, servicesQ services.Querier) *Waker {
+	return &Waker{
+		logger:    logger,
+		k8s:       k8s,
+		servicesQ: servicesQ,
+	}
+}
+
+func (wk *Waker) RegisterRoutes(r chi.Router) {
+	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+	r.Get("/wake/{namespace}/{name}", wk.HandleWake)
+}
+
+func (wk *Waker) HandleWake(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+	if !strings.HasPrefix(namespace, "dp-") {
+		writeUnavailable(w)
+		return
+	}
+
+	ctx := r.Context()
+	deployment, err := wk.k8s.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		writeUnavailable(w)
+		return
+	}
+
+	// Not asleep: the 5xx came from the app itself or it was stopped on purpose.
+	if _, ok := deployment.Annotations[sleepingAnnotation]; !ok {
+		writeUnavailable(w)
+		return
+	}
+
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas == 0 {
+		if err := wk.scaleUp(ctx, namespace, name); err != nil {
+			wk.logger.Error("Failed to wake service", "namespace", namespace, "name", name, "error", err)
+			writeUnavailable(w)
+			return
+		}
+		wk.logger.Info("Waking service", "namespace", namespace, "name", name)
+	}
+
+	ready := wk.waitReady(ctx, namespace, name)
+	if ready {
+		wk.markAwake(ctx, namespace, name, deployment)
+	}
+	writeWaking(w, ready)
+}
+
+func (wk *Waker) scaleUp(ctx context.Context, namespace, name string) error {
+	_, err := wk.k8s.AppsV1().Deployments(namespace).Patch(ctx, name,
+		types.MergePatchType, []byte(`{"spec":{"replicas":1}}`),
+		metav1.PatchOptions{FieldManager: "idle-sleeper"})
+	return err
+}
+
+func (wk *Waker) waitReady(ctx context.Context, namespace, name string) bool {
+	ctx, cancel := context.WithTimeout(ctx, wakeReadyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(wakePollInterval)
+	defer ticker.Stop()
+
+	for {
+		d, err := wk.k8s.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil && d.Status.ReadyReplicas > 0 {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+func (wk *Waker) markAwake(ctx context.Context, namespace, name string, deployment *appsv1.Deployment) {
+	if err := clearSleepingAnnotation(ctx, wk.k8s, namespace, name); err != nil {
+		wk.logger.Warn("Failed to clear sleeping annotation", "namespace", namespace, "name", name, "error", err)
+	}
+	serviceID := deployment.Annotations[serviceIDAnnotation]
+	if serviceID == "" {
+		return
+	}
+	if err := wk.servicesQ.ClearServiceSleeping(ctx, serviceID); err != nil {
+		wk.logger.Warn("Failed to clear sleeping state", "serviceID", serviceID, "error", err)
+		return
+	}
+	wk.logger.Info("Service → awake", "serviceID", serviceID, "namespace", namespace, "name", name)
+}
+
+func writeUnavailable(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	w.Write([]byte("Service unavailable\n"))
+}
+
+// writeWaking answers with a page that reloads the original URL. Traefik
+// keeps the upstream status code, so clients still see a 503 and retry.
+func writeWaking(w http.ResponseWriter, ready bool) {
+	refresh := 5
+	if ready {
+		refresh = 0
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Retry-After", fmt.Sprintf("%d", max(refresh, 1)))
+	w.WriteHeader(http.StatusServiceUnavailable)
+	fmt.Fprintf(w, `<!doctype html><html><head><meta http-equiv="refresh" content="%d"><title>Waking up</title></head>`+
+		`<body><p>This app was asleep and is starting up. The page will reload automatically.</p></body></html>`, refresh)
+}
@@ -10,9 +10,11 @@ import (
 )
 
 const (
-	StatusRunning = "running"
-	StatusFailed  = "failed"
-	StatusDeleted = "deleted"
+	StatusRunning   = "running"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+	StatusDeleted   = "deleted"
+	StatusCompleted = "completed"
 )
 
 func CreateServiceWorkflow(ctx workflow.Context, input CreateServiceWorkflowInput) (CreateServiceWorkflowResult, error) {
@@ -23,6 +25,171 @@ func RedeployServiceWorkflow(ctx workflow.Context, input RedeployServiceWorkflow
 	return deployService(ctx, input)
 }
 
+// DeployCoordinatorWorkflow is the one long-running workflow per service
+// that receives deploy requests as signals and runs them as child deploy
+// workflows, one at a time, according to each request's deploy policy.
+// It ends after an hour without work; the next request starts it again.
+func DeployCoordinatorWorkflow(ctx workflow.Context, input DeployCoordinatorInput) (DeployCoordinatorResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting deploy coordinator", "serviceID", input.ServiceID)
+
+	var activities *Activities
+
+	statusCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+	})
+
+	markCancelled := func(actCtx workflow.Context, deploymentID string) {
+		if err := workflow.ExecuteActivity(actCtx, activities.MarkDeploymentCancelled, MarkDeploymentCancelledInput{
+			DeploymentID: deploymentID,
+		}).Get(actCtx, nil); err != nil {
+			logger.Warn("Failed to mark deployment cancelled", "deploymentID", deploymentID, "error", err)
+		}
+	}
+
+	result := DeployCoordinatorResult{ServiceID: input.ServiceID}
+	queue := &deployQueue{pending: input.Pending}
+	signals := workflow.GetSignalChannel(ctx, DeploySignal)
+
+	var (
+		child       workflow.ChildWorkflowFuture
+		cancelChild workflow.CancelFunc
+		timer       workflow.Future
+		cancelTimer workflow.CancelFunc
+	)
+
+	receive := func(req DeployRequest) {
+		logger.Info("Deploy requested",
+			"serviceID", input.ServiceID,
+			"deploymentID", req.Deploy.DeploymentID,
+			"policy", req.Policy)
+		res := queue.enqueue(req)
+		if res.collapsed != "" {
+			logger.Info("Collapsing queued deploy", "deploymentID", res.collapsed, "replacedBy", req.Deploy.DeploymentID)
+			markCancelled(statusCtx, res.collapsed)
+		}
+		if res.cancelRunning && cancelChild != nil {
+			logger.Info("Cancelling running deploy", "deploymentID", queue.running)
+			cancelChild()
+		}
+		if cancelTimer != nil {
+			cancelTimer()
+			timer, cancelTimer = nil, nil
+		}
+		if res.debounce > 0 {
+			var timerCtx workflow.Context
+			timerCtx, cancelTimer = workflow.WithCancel(ctx)
+			timer = workflow.NewTimer(timerCtx, res.debounce)
+		}
+	}
+
+	drain := func() {
+		var req DeployRequest
+		for signals.ReceiveAsync(&req) {
+			receive(req)
+		}
+	}
+
+	for {
+		if ctx.Err() != nil && child == nil {
+			// Cancelled, e.g. by DeleteService: drop whatever was still queued
+			if queue.pending != nil {
+				disconnected, _ := workflow.NewDisconnectedContext(statusCtx)
+				markCancelled(disconnected, queue.pending.Deploy.DeploymentID)
+			}
+			return result, ctx.Err()
+		}
+
+		if req := queue.next(timer != nil); req != nil {
+			var childCtx workflow.Context
+			childCtx, cancelChild = workflow.WithCancel(ctx)
+			childCtx = workflow.WithChildOptions(childCtx, workflow.ChildWorkflowOptions{
+				WorkflowID:          DeployWorkflowID(req.Deploy.DeploymentID),
+				WaitForCancellation: true,
+			})
+			deployWorkflow := RedeployServiceWorkflow
+			if req.Initial {
+				deployWorkflow = CreateServiceWorkflow
+			}
+			child = workflow.ExecuteChildWorkflow(childCtx, deployWorkflow, req.Deploy)
+			result.Deploys++
+
+			var execution workflow.Execution
+			if err := child.GetChildWorkflowExecution().Get(ctx, &execution); err == nil {
+				if err := workflow.ExecuteActivity(statusCtx, activities.RecordDeploymentRunID, RecordDeploymentRunIDInput{
+					DeploymentID: req.Deploy.DeploymentID,
+					RunID:        execution.RunID,
+				}).Get(ctx, nil); err != nil {
+					logger.Warn("Failed to record deploy run id", "deploymentID", req.Deploy.DeploymentID, "error", err)
+				}
+			}
+		}
+
+		// Roll over between deploys; a queued request moves to the new run
+		if child == nil && timer == nil && workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			drain()
+			if timer == nil {
+				return result, workflow.NewContinueAsNewError(ctx, DeployCoordinatorWorkflow, DeployCoordinatorInput{
+					ServiceID: input.ServiceID,
+					Pending:   queue.pending,
+				})
+			}
+		}
+
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(signals, func(c workflow.ReceiveChannel, _ bool) {
+			var req DeployRequest
+			c.Receive(ctx, &req)
+			receive(req)
+		})
+		if child != nil {
+			running := child
+			selector.AddFuture(running, func(f workflow.Future) {
+				var deployResult DeployServiceResult
+				if err := f.Get(ctx, &deployResult); err != nil {
+					logger.Info("Deploy ended", "deploymentID", queue.running, "error", err)
+				} else {
+					logger.Info("Deploy finished", "deploymentID", queue.running, "status", deployResult.Status)
+				}
+				child, cancelChild = nil, nil
+				queue.finish()
+			})
+		}
+		if timer != nil {
+			window := timer
+			selector.AddFuture(window, func(workflow.Future) {
+				// A newer request may have replaced this window already
+				if window == timer {
+					timer, cancelTimer = nil, nil
+				}
+			})
+		}
+		idleExpired := false
+		var cancelIdle workflow.CancelFunc
+		if child == nil && timer == nil && queue.pending == nil {
+			var idleCtx workflow.Context
+			idleCtx, cancelIdle = workflow.WithCancel(ctx)
+			selector.AddFuture(workflow.NewTimer(idleCtx, deployCoordinatorIdleTimeout), func(f workflow.Future) {
+				idleExpired = f.Get(ctx, nil) == nil
+			})
+		}
+
+		selector.Select(ctx)
+		if cancelIdle != nil {
+			cancelIdle()
+		}
+
+		if idleExpired {
+			drain()
+			if child == nil && timer == nil && queue.pending == nil {
+				logger.Info("Deploy coordinator idle; exiting", "serviceID", input.ServiceID, "deploys", result.Deploys)
+				return result, nil
+			}
+		}
+	}
+}
+
 func deployService(ctx workflow.Context, input DeployServiceInput) (DeployServiceResult, error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting deploy", "serviceID", input.ServiceID, "deploymentID", input.DeploymentID, "repo", input.Repo, "commitSHA", input.CommitSHA)
@@ -42,6 +209,20 @@ func deployService(ctx workflow.Context, input DeployServiceInput) (DeployServic
 	}
 
 	fail := func(err error) (DeployServiceResult, error) {
+		if temporal.IsCanceledError(err) {
+			// ctx is cancelled, so record the outcome on a disconnected context
+			cleanupCtx, _ := workflow.NewDisconnectedContext(statusCtx)
+			if markErr := workflow.ExecuteActivity(cleanupCtx, activities.MarkDeploymentCancelled, MarkDeploymentCancelledInput{
+				DeploymentID: input.DeploymentID,
+			}).Get(cleanupCtx, nil); markErr != nil {
+				logger.Warn("Failed to mark deployment cancelled", "deploymentID", input.DeploymentID, "error", markErr)
+			}
+			return DeployServiceResult{
+				ServiceID:    input.ServiceID,
+				Status:       StatusCancelled,
+				ErrorMessage: err.Error(),
+			}, err
+		}
 		markFailed(err.Error())
 		return DeployServiceResult{
 			ServiceID:    input.ServiceID,
@@ -61,22 +242,43 @@ func deployService(ctx workflow.Context, input DeployServiceInput) (DeployServic
 		}, fmt.Errorf("update deployment building: %w", err)
 	}
 
-	childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
-		WorkflowID: fmt.Sprintf("build-%s-%s", input.ServiceID, input.CommitSHA),
-	})
 	var buildResult BuildServiceWorkflowResult
-	if err := workflow.ExecuteChildWorkflow(childCtx, BuildServiceWorkflow, BuildServiceWorkflowInput{
-		ServiceID:      input.ServiceID,
-		DeploymentID:   input.DeploymentID,
-		Repo:           input.Repo,
-		Branch:         input.Branch,
-		GitProvider:    input.GitProvider,
-		InstallationID: input.InstallationID,
-		CommitSHA:      input.CommitSHA,
-	}).Get(ctx, &buildResult); err != nil {
-		return fail(err)
+	if input.BuildPack == BuildPackImage {
+		// Prebuilt image: nothing to build, just pin the tag to a digest
+		resolveCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: time.Minute,
+			RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+			WaitForCancellation: true,
+		})
+		var resolveResult ResolveImageResult
+		if err := workflow.ExecuteActivity(resolveCtx, activities.ResolveImage, ResolveImageInput{
+			ServiceID: input.ServiceID,
+		}).Get(ctx, &resolveResult); err != nil {
+			return fail(err)
+		}
+		buildResult = BuildServiceWorkflowResult{ImageRef: resolveResult.ImageRef}
+	} else {
+		// Wait for a cancelled build to clean up before this workflow closes
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID:          fmt.Sprintf("build-%s-%s", input.ServiceID, input.CommitSHA),
+			WaitForCancellation: true,
+		})
+		if err := workflow.ExecuteChildWorkflow(childCtx, BuildServiceWorkflow, BuildServiceWorkflowInput{
+			ServiceID:      input.ServiceID,
+			DeploymentID:   input.DeploymentID,
+			Repo:           input.Repo,
+			Branch:         input.Branch,
+			GitProvider:    input.GitProvider,
+			InstallationID: input.InstallationID,
+			CommitSHA:      input.CommitSHA,
+			Ref:            input.Ref,
+		}).Get(ctx, &buildResult); err != nil {
+			return fail(err)
+		}
 	}
 
+	// A Deploy that is already applying resources finishes before the
+	// workflow reports cancelled, so a delete never races it
 	actCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
 		StartToCloseTimeout: 5 * time.Minute,
 		HeartbeatTimeout:    30 * time.Second,
@@ -86,15 +288,17 @@ func deployService(ctx workflow.Context, input DeployServiceInput) (DeployServic
 			MaximumInterval:    30 * time.Second,
 			MaximumAttempts:    3,
 		},
+		WaitForCancellation: true,
 	})
 
 	var deployResult DeployResult
 	if err := workflow.ExecuteActivity(actCtx, activities.Deploy, DeployInput{
-		ServiceID:  input.ServiceID,
-		ImageRef:   buildResult.ImageRef,
-		CommitSHA:  buildResult.CommitSHA,
-		AppsDomain: input.AppsDomain,
-		Port:       buildResult.Port,
+		ServiceID:    input.ServiceID,
+		DeploymentID: input.DeploymentID,
+		ImageRef:     buildResult.ImageRef,
+		CommitSHA:    buildResult.CommitSHA,
+		AppsDomain:   input.AppsDomain,
+		Port:         buildResult.Port,
 	}).Get(ctx, &deployResult); err != nil {
 		return fail(err)
 	}
@@ -158,14 +362,17 @@ func BuildServiceWorkflow(ctx workflow.Context, input BuildServiceWorkflowInput)
 			MaximumInterval:    30 * time.Second,
 			MaximumAttempts:    2,
 		},
+		WaitForCancellation: true,
 	})
 	var activities *Activities
 
+	// Source dirs are removed even when the build was cancelled
 	cleanupSource := func(path string) {
 		if path == "" {
 			return
 		}
-		if err := workflow.ExecuteActivity(actCtx, activities.CleanupSource, path).Get(ctx, nil); err != nil {
+		cleanupCtx, _ := workflow.NewDisconnectedContext(actCtx)
+		if err := workflow.ExecuteActivity(cleanupCtx, activities.CleanupSource, path).Get(cleanupCtx, nil); err != nil {
 			logger.Warn("CleanupSource failed", "sourcePath", path, "error", err)
 		}
 	}
@@ -221,6 +428,7 @@ func BuildServiceWorkflow(ctx workflow.Context, input BuildServiceWorkflowInput)
 			GitProvider:    input.GitProvider,
 			InstallationID: input.InstallationID,
 			CommitSHA:      input.CommitSHA,
+			Ref:            input.Ref,
 		}).Get(ctx, &cloneResult)
 		if err != nil {
 			return BuildServiceWorkflowResult{}, fmt.Errorf("clone failed: %w", err)
@@ -228,9 +436,10 @@ func BuildServiceWorkflow(ctx workflow.Context, input BuildServiceWorkflowInput)
 
 		var resolveResult ResolveBuildContextResult
 		err = workflow.ExecuteActivity(actCtx, activities.ResolveBuildContext, ResolveBuildContextInput{
-			ServiceID:  input.ServiceID,
-			SourcePath: cloneResult.SourcePath,
-			CommitSHA:  cloneResult.CommitSHA,
+			ServiceID:    input.ServiceID,
+			DeploymentID: input.DeploymentID,
+			SourcePath:   cloneResult.SourcePath,
+			CommitSHA:    cloneResult.CommitSHA,
 		}).Get(ctx, &resolveResult)
 		if err != nil {
 			cleanupSource(cloneResult.SourcePath)
@@ -265,8 +474,12 @@ func BuildServiceWorkflow(ctx workflow.Context, input BuildServiceWorkflowInput)
 			EnvVars:          resolveResult.EnvVars,
 			PublishDirectory: resolveResult.PublishDirectory,
 			DockerfilePath:   resolveResult.DockerfilePath,
+			DockerfileTarget: resolveResult.DockerfileTarget,
+			BuildArgs:        resolveResult.BuildArgs,
+			BuildContextPath: resolveResult.BuildContextPath,
 			BuildCommand:     resolveResult.BuildCommand,
 			StartCommand:     resolveResult.StartCommand,
+			StaticSite:       resolveResult.StaticSite,
 		}
 		var buildResult BuildImageResult
 		switch resolveResult.BuildPack {
@@ -322,8 +535,28 @@ func DeleteServiceWorkflow(ctx workflow.Context, input DeleteServiceWorkflowInpu
 	})
 
 	var activities *Activities
+
+	// Cancelled deploys may still be applying resources or cleaning up builds
+	if len(input.InFlightWorkflowIDs) > 0 {
+		waitCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: 15 * time.Minute,
+			HeartbeatTimeout:    30 * time.Second,
+			RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+		})
+		if err := workflow.ExecuteActivity(waitCtx, activities.WaitForWorkflowsClosed, WaitForWorkflowsClosedInput{
+			WorkflowIDs: input.InFlightWorkflowIDs,
+		}).Get(ctx, nil); err != nil {
+			// Deploy refuses cancelled deployments, so deleting anyway is safe
+			logger.Warn("In-flight deploys did not close; deleting anyway", "workflowIDs", input.InFlightWorkflowIDs, "error", err)
+		}
+	}
+
 	var deleteResult DeleteServiceResult
-	if err := workflow.ExecuteActivity(ctx, activities.DeleteService, DeleteServiceInput(input)).Get(ctx, &deleteResult); err != nil {
+	if err := workflow.ExecuteActivity(ctx, activities.DeleteService, DeleteServiceInput{
+		ServiceID: input.ServiceID,
+		Namespace: input.Namespace,
+		Name:      input.Name,
+	}).Get(ctx, &deleteResult); err != nil {
 		return DeleteServiceWorkflowResult{
 			ServiceID:    input.ServiceID,
 			Status:       StatusFailed,
@@ -331,6 +564,17 @@ func DeleteServiceWorkflow(ctx workflow.Context, input DeleteServiceWorkflowInpu
 		}, err
 	}
 
+	// Drop images the service (and any cancelled build) pushed; the nightly
+	// RegistryGCWorkflow picks up whatever this misses
+	repository := input.Namespace + "/" + input.Name
+	if err := workflow.ExecuteActivity(ctx, activities.CollectRegistryGarbage, RegistryGCTarget{
+		ServiceID:    input.ServiceID,
+		Repositories: []string{repository, "cache/" + repository},
+		Purge:        true,
+	}).Get(ctx, nil); err != nil {
+		logger.Warn("Failed to purge service images", "serviceID", input.ServiceID, "error", err)
+	}
+
 	if err := workflow.ExecuteActivity(ctx, activities.SoftDeleteService, input.ServiceID).Get(ctx, nil); err != nil {
 		logger.Error("Failed to soft-delete service record", "serviceID", input.ServiceID, "error", err)
 		return DeleteServiceWorkflowResult{
@@ -345,3 +589,205 @@ func DeleteServiceWorkflow(ctx workflow.Context, input DeleteServiceWorkflowInpu
 		Status:    StatusDeleted,
 	}, nil
 }
+
+// SyncProjectRoutesWorkflow renders a project's path-based routes. Callers
+// pass the full desired route set, so the latest run always wins.
+func SyncProjectRoutesWorkflow(ctx workflow.Context, input SyncProjectRoutesInput) (SyncProjectRoutesResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting route sync", "projectID", input.ProjectID, "namespace", input.Namespace)
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var activities *Activities
+	var result SyncProjectRoutesResult
+	if err := workflow.ExecuteActivity(ctx, activities.SyncProjectRoutes, input).Get(ctx, &result); err != nil {
+		return SyncProjectRoutesResult{}, err
+	}
+	return result, nil
+}
+
+// SleepIdleServicesWorkflow runs on a cron schedule per region and scales
+// services that saw no traffic for their sleep_after_idle window to zero.
+func SleepIdleServicesWorkflow(ctx workflow.Context, input SleepIdleServicesInput) (SleepIdleServicesResult, error) {
+	logger := workflow.GetLogger(ctx)
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	})
+
+	var activities *Activities
+	var idle []IdleService
+	if err := workflow.ExecuteActivity(ctx, activities.FindIdleServices, FindIdleServicesInput{
+		Region: input.Region,
+	}).Get(ctx, &idle); err != nil {
+		return SleepIdleServicesResult{}, err
+	}
+
+	slept := 0
+	for _, svc := range idle {
+		err := workflow.ExecuteActivity(ctx, activities.SleepService, SleepServiceInput(svc)).Get(ctx, nil)
+		if err != nil {
+			logger.Warn("Failed to put service to sleep", "serviceID", svc.ServiceID, "error", err)
+			continue
+		}
+		slept++
+	}
+
+	return SleepIdleServicesResult{Slept: slept}, nil
+}
+
+// RegistryGCWorkflow runs on a cron schedule per region and deletes old
+// images from the in-cluster registry. Reclaimed bytes are reported through
+// the worker's metrics handler.
+func RegistryGCWorkflow(ctx workflow.Context, input RegistryGCInput) (RegistryGCResult, error) {
+	logger := workflow.GetLogger(ctx)
+
+	findCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	})
+	collectCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	})
+
+	var activities *Activities
+	var targets []RegistryGCTarget
+	if err := workflow.ExecuteActivity(findCtx, activities.FindRegistryGCTargets, FindRegistryGCTargetsInput{
+		Region: input.Region,
+	}).Get(ctx, &targets); err != nil {
+		return RegistryGCResult{}, err
+	}
+
+	var result RegistryGCResult
+	for _, target := range targets {
+		var collected CollectRegistryGarbageResult
+		if err := workflow.ExecuteActivity(collectCtx, activities.CollectRegistryGarbage, target).Get(ctx, &collected); err != nil {
+			logger.Warn("Failed to collect registry garbage", "serviceID", target.ServiceID, "error", err)
+			continue
+		}
+		result.Services++
+		result.ManifestsDeleted += collected.ManifestsDeleted
+		result.BytesReclaimed += collected.BytesReclaimed
+	}
+
+	metrics := workflow.GetMetricsHandler(ctx).WithTags(map[string]string{"region": input.Region})
+	metrics.Counter("registry_gc_manifests_deleted").Inc(int64(result.ManifestsDeleted))
+	metrics.Counter("registry_gc_bytes_reclaimed").Inc(result.BytesReclaimed)
+
+	logger.Info("Registry gc finished",
+		"region", input.Region,
+		"services", result.Services,
+		"manifestsDeleted", result.ManifestsDeleted,
+		"bytesReclaimed", result.BytesReclaimed)
+	return result, nil
+}
+
+// ServiceLifecycleWorkflow stops, starts or restarts a service's current
+// deployment in place. Start and restart wait for the pods to come up
+// before the event is marked completed.
+func ServiceLifecycleWorkflow(ctx workflow.Context, input ServiceLifecycleWorkflowInput) (ServiceLifecycleWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting lifecycle action", "serviceID", input.ServiceID, "action", input.Action)
+
+	var activities *Activities
+
+	statusCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+	})
+
+	fail := func(err error) (ServiceLifecycleWorkflowResult, error) {
+		_ = workflow.ExecuteActivity(statusCtx, activities.MarkServiceEventFailed, MarkServiceEventFailedInput{
+			EventID:      input.EventID,
+			ErrorMessage: err.Error(),
+		}).Get(ctx, nil)
+		return ServiceLifecycleWorkflowResult{
+			ServiceID:    input.ServiceID,
+			Action:       input.Action,
+			Status:       StatusFailed,
+			ErrorMessage: err.Error(),
+		}, err
+	}
+
+	actCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	})
+	if err := workflow.ExecuteActivity(actCtx, activities.ApplyServiceLifecycle, input).Get(ctx, nil); err != nil {
+		return fail(err)
+	}
+
+	if input.Action != LifecycleStop {
+		rolloutCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: 3 * time.Minute,
+			HeartbeatTimeout:    30 * time.Second,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: 3,
+			},
+		})
+		if err := workflow.ExecuteActivity(rolloutCtx, activities.WaitForRollout, WaitForRolloutInput{
+			Namespace:      input.Namespace,
+			DeploymentName: input.Name,
+		}).Get(ctx, nil); err != nil {
+			return fail(err)
+		}
+	}
+
+	if err := workflow.ExecuteActivity(statusCtx, activities.MarkServiceEventCompleted, MarkServiceEventCompletedInput{
+		EventID: input.EventID,
+	}).Get(ctx, nil); err != nil {
+		logger.Warn("Failed to mark service event completed", "eventID", input.EventID, "error", err)
+	}
+
+	return ServiceLifecycleWorkflowResult{
+		ServiceID: input.ServiceID,
+		Action:    input.Action,
+		Status:    StatusCompleted,
+	}, nil
+}
+
+// CheckImageWorkflow runs CheckImage on the region's deployer worker.
+// create_service and update_service wait on it before saving an image.
+func CheckImageWorkflow(ctx workflow.Context, input CheckImageInput) error {
+	var activities *Activities
+
+	actCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 20 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumAttempts: 2,
+		},
+	})
+	return workflow.ExecuteActivity(actCtx, activities.CheckImage, input).Get(ctx, nil)
+}
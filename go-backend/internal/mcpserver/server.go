@@ -9,7 +9,9 @@ import (
 	"github.com/augustdev/autoclip/internal/dns"
 	"github.com/augustdev/autoclip/internal/githubapp"
 	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/augustdev/autoclip/internal/projectplan"
 	"github.com/augustdev/autoclip/internal/resources"
+	"github.com/augustdev/autoclip/internal/templates"
 	"github.com/invopop/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -30,6 +32,8 @@ type Server struct {
 	resourcesService *resources.Service
 	githubAppService *githubapp.Service
 	internalGitSvc   *internalgit.Service
+	projectPlanSvc   *projectplan.Service
+	templatesSvc     *templates.Service
 	logger           *slog.Logger
 	lokiQueryURL     string
 	lokiUsername     string
@@ -42,7 +46,7 @@ type LokiConfig struct {
 	Password string
 }
 
-func NewServer(authService *auth.Service, deployService *deployments.Service, dnsService *dns.Service, resourcesService *resources.Service, githubAppService *githubapp.Service, internalGitSvc *internalgit.Service, lokiCfg LokiConfig, logger *slog.Logger) *Server {
+func NewServer(authService *auth.Service, deployService *deployments.Service, dnsService *dns.Service, resourcesService *resources.Service, githubAppService *githubapp.Service, internalGitSvc *internalgit.Service, projectPlanSvc *projectplan.Service, templatesSvc *templates.Service, lokiCfg LokiConfig, logger *slog.Logger) *Server {
 	mcpServer := mcp.NewServer(
 		&mcp.Implementation{
 			Name:    "Ink MCP",
@@ -62,6 +66,8 @@ func NewServer(authService *auth.Service, deployService *deployments.Service, dn
 		resourcesService: resourcesService,
 		githubAppService: githubAppService,
 		internalGitSvc:   internalGitSvc,
+		projectPlanSvc:   projectPlanSvc,
+		templatesSvc:     templatesSvc,
 		logger:           logger,
 		lokiQueryURL:     lokiCfg.QueryURL,
 		lokiUsername:     lokiCfg.Username,
@@ -89,16 +95,22 @@ func (s *Server) registerTools() {
 
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "create_service",
-		Description: "Create and deploy a service. Use host='ink' (default) for Ink managed repos or host='github' for GitHub.",
+		Description: "Create and deploy a service. Use host='ink' (default) for Ink managed repos or host='github' for GitHub. Use build_pack='image' with image to deploy a prebuilt container image instead of a repo.",
 		InputSchema: schemaFor[CreateServiceInput](),
 	}, s.handleCreateService)
 
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "redeploy_service",
-		Description: "Redeploy an existing service to pull latest code",
+		Description: "Redeploy an existing service to pull latest code, or a specific commit, tag or branch with ref",
 		InputSchema: schemaFor[RedeployServiceInput](),
 	}, s.handleRedeployService)
 
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "deploy_source",
+		Description: "Deploy local source without git. Returns a short-lived upload URL; PUT a tar.gz of the source to it (see command) and it is committed to the service's Ink repo on its branch, which deploys like a push. Every file in the archive replaces the previous tree; a single top-level directory is stripped.",
+		InputSchema: schemaFor[DeploySourceInput](),
+	}, s.handleDeploySource)
+
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "list_services",
 		Description: "List all deployed services",
@@ -131,7 +143,7 @@ func (s *Server) registerTools() {
 
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "get_service",
-		Description: "Get detailed information about a deployed service. Returns status (queued/building/deploying/active/failed/cancelled/superseded/crashed/completed/removed). Use deploy_log_lines and runtime_log_lines to fetch logs.",
+		Description: "Get detailed information about a deployed service. Returns status (queued/building/deploying/active/sleeping/stopped/failed/cancelled/superseded/crashed/completed/removed). Use deploy_log_lines and runtime_log_lines to fetch logs.",
 		InputSchema: schemaFor[GetServiceInput](),
 	}, s.handleGetService)
 
@@ -147,6 +159,24 @@ func (s *Server) registerTools() {
 		InputSchema: schemaFor[DeleteServiceInput](),
 	}, s.handleDeleteService)
 
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "stop_service",
+		Description: "Stop a service by scaling it to zero. Configuration, domains and the built image are kept; list_services shows it as stopped until start_service is called.",
+		InputSchema: schemaFor[ServiceLifecycleInput](),
+	}, s.handleStopService)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "start_service",
+		Description: "Start a stopped service again on its current deployment, without rebuilding.",
+		InputSchema: schemaFor[ServiceLifecycleInput](),
+	}, s.handleStartService)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "restart_service",
+		Description: "Restart a running service's pods on its current deployment, without rebuilding.",
+		InputSchema: schemaFor[ServiceLifecycleInput](),
+	}, s.handleRestartService)
+
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "create_repo",
 		Description: "Create a git repository. Use host='ink' (default) for instant private repos, or host='github' for GitHub. Pass the returned 'repo' value directly to create_service and get_git_token.",
@@ -159,6 +189,78 @@ func (s *Server) registerTools() {
 		InputSchema: schemaFor[GetGitTokenInput](),
 	}, s.handleGetGitToken)
 
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "list_git_tokens",
+		Description: "List your active Ink git tokens with their prefix, repo, scopes, expiry and last use.",
+		InputSchema: schemaFor[ListGitTokensInput](),
+	}, s.handleListGitTokens)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "create_git_token",
+		Description: "Create a git token for one Ink repo, read-only ('pull') by default. Use a longer expires_in_hours for deploy tokens in CI.",
+		InputSchema: schemaFor[CreateGitTokenInput](),
+	}, s.handleCreateGitToken)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "revoke_git_token",
+		Description: "Revoke an Ink git token by ID. It stops working immediately.",
+		InputSchema: schemaFor[RevokeGitTokenInput](),
+	}, s.handleRevokeGitToken)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "list_ssh_keys",
+		Description: "List your SSH public keys for git over SSH.",
+		InputSchema: schemaFor[ListSSHKeysInput](),
+	}, s.handleListSSHKeys)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "add_ssh_key",
+		Description: "Add an SSH public key. It can clone, fetch and push all of your Ink repos over SSH, e.g. git clone ssh://git@ssh.ml.ink/<owner>/<repo>.git.",
+		InputSchema: schemaFor[AddSSHKeyInput](),
+	}, s.handleAddSSHKey)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "delete_ssh_key",
+		Description: "Delete an SSH public key by ID. New SSH connections with it are refused.",
+		InputSchema: schemaFor[DeleteSSHKeyInput](),
+	}, s.handleDeleteSSHKey)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "list_repo_refs",
+		Description: "List the branches and tags of an Ink repo with the commit each points at.",
+		InputSchema: schemaFor[ListRepoRefsInput](),
+	}, s.handleListRepoRefs)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "get_repo_log",
+		Description: "Show the commit log of an Ink repo at a branch, tag or commit, optionally only commits touching a path.",
+		InputSchema: schemaFor[GetRepoLogInput](),
+	}, s.handleGetRepoLog)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "list_repo_tree",
+		Description: "List the files and directories in a directory of an Ink repo at a branch, tag or commit.",
+		InputSchema: schemaFor[ListRepoTreeInput](),
+	}, s.handleListRepoTree)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "read_repo_file",
+		Description: "Read a file from an Ink repo at a branch, tag or commit. Files over 1 MiB are refused; binary files are reported without content.",
+		InputSchema: schemaFor[ReadRepoFileInput](),
+	}, s.handleReadRepoFile)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "import_repo",
+		Description: "Import a GitHub repo into a new Ink repo with all its branches and tags. The GitHub App must have access to it. Deploy it with create_service, or move an existing service over with update_service.",
+		InputSchema: schemaFor[ImportRepoInput](),
+	}, s.handleImportRepo)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "mirror_repo",
+		Description: "Push every branch and tag of an Ink repo to a GitHub repo after each push, overwriting what is there. Pass an empty github_repo to stop mirroring.",
+		InputSchema: schemaFor[MirrorRepoInput](),
+	}, s.handleMirrorRepo)
+
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "add_custom_domain",
 		Description: "Attach a custom domain to a service. Returns DNS records to configure.",
@@ -171,6 +273,48 @@ func (s *Server) registerTools() {
 		InputSchema: schemaFor[RemoveCustomDomainInput](),
 	}, s.handleRemoveCustomDomain)
 
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "add_route",
+		Description: "Route a hostname + path prefix to a service (e.g. web.ml.ink/api → api). Lets multiple services share one hostname. Longest matching prefix wins.",
+		InputSchema: schemaFor[AddRouteInput](),
+	}, s.handleAddRoute)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "list_routes",
+		Description: "List path-based routes in a project.",
+		InputSchema: schemaFor[ListRoutesInput](),
+	}, s.handleListRoutes)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "remove_route",
+		Description: "Remove a path-based route from a project.",
+		InputSchema: schemaFor[RemoveRouteInput](),
+	}, s.handleRemoveRoute)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "plan_project",
+		Description: "Preview the changes needed to make a project match a declarative spec of services, resources (databases), links and custom domains. Returns creates, updates and deletes plus a plan_hash; nothing is changed.",
+		InputSchema: schemaFor[PlanProjectInput](),
+	}, s.handlePlanProject)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "apply_project",
+		Description: "Apply a declarative project spec in one transaction: resources, then services, then domains, then deletes (with prune). If any step fails the steps already applied are rolled back. Pass plan_hash from plan_project to apply exactly the reviewed plan.",
+		InputSchema: schemaFor[ApplyProjectInput](),
+	}, s.handleApplyProject)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "list_templates",
+		Description: "List starter templates (app skeleton plus the services and databases it runs with) that create_from_template can deploy.",
+		InputSchema: schemaFor[ListTemplatesInput](),
+	}, s.handleListTemplates)
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "create_from_template",
+		Description: "Start a new app from a template: creates an Ink repo with the template's code, provisions its databases, creates and deploys its services. Returns the URL and a git remote to clone and push changes to.",
+		InputSchema: schemaFor[CreateFromTemplateInput](),
+	}, s.handleCreateFromTemplate)
+
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "list_delegations",
 		Description: "List all delegated zones with their status. Zone delegation can be set up at https://ml.ink/dns",
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -20,10 +21,10 @@ import (
 
 func validateBuildPack(bp string) error {
 	switch bp {
-	case "railpack", "dockerfile", "static", "dockercompose":
+	case "railpack", "dockerfile", "static", "dockercompose", k8sdeployments.BuildPackImage:
 		return nil
 	default:
-		return fmt.Errorf("invalid build_pack: %s. Valid options: railpack, dockerfile, static, dockercompose", bp)
+		return fmt.Errorf("invalid build_pack: %s. Valid options: railpack, dockerfile, static, dockercompose, image", bp)
 	}
 }
 
@@ -129,6 +130,10 @@ func (s *Server) handleCreateService(ctx context.Context, req *mcp.CallToolReque
 		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, CreateServiceOutput{}, nil
 	}
 
+	if input.BuildPack == k8sdeployments.BuildPackImage {
+		return s.createServiceFromImage(ctx, user.ID, input)
+	}
+
 	if input.Repo == "" {
 		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "repo is required"}}}, CreateServiceOutput{}, nil
 	}
@@ -278,41 +283,9 @@ func (s *Server) normalizeServiceRepo(ctx context.Context, user *users.User, inp
 //	"ml.ink/owner/dream-wall-abc" → strip ml.ink/ → GetRepoByFullName
 //	"owner/dream-wall-abc"        → has slash → GetRepoByFullName
 func (s *Server) resolveInternalRepo(ctx context.Context, user *users.User, repo, projectRef string) (host string, normalizedRepo string, err error) {
-	// Strip known prefixes
-	cleaned := repo
-	if strings.HasPrefix(cleaned, "ink/") {
-		cleaned = strings.TrimPrefix(cleaned, "ink/")
-	} else if strings.HasPrefix(cleaned, "ml.ink/") {
-		cleaned = strings.TrimPrefix(cleaned, "ml.ink/")
-	}
-
-	if projectRef == "" {
-		projectRef = "default"
-	}
-
-	if strings.Contains(cleaned, "/") {
-		// Has slash → treat as full_name (owner/repo-slug)
-		internalRepo, repoErr := s.internalGitSvc.GetRepoByFullName(ctx, cleaned)
-		if repoErr != nil {
-			return "", "", fmt.Errorf("repo '%s' not found. Create it first with create_repo", cleaned)
-		}
-		if internalRepo.UserID != user.ID {
-			return "", "", fmt.Errorf("repo belongs to another user")
-		}
-		return "ink", internalRepo.FullName, nil
-	}
-
-	// No slash → treat as project-scoped name
-	project, projErr := s.deployService.GetProjectByRef(ctx, user.ID, projectRef)
-	if projErr != nil {
-		return "", "", fmt.Errorf("project not found: %s", projectRef)
-	}
-	internalRepo, repoErr := s.internalGitSvc.GetRepoByProjectAndName(ctx, project.ID, cleaned)
-	if repoErr != nil {
-		return "", "", fmt.Errorf("repo '%s' not found in project '%s'. Create it first with create_repo", cleaned, projectRef)
-	}
-	if internalRepo.UserID != user.ID {
-		return "", "", fmt.Errorf("repo belongs to another user")
+	internalRepo, err := s.internalGitSvc.ResolveRepo(ctx, user.ID, repo, projectRef)
+	if err != nil {
+		return "", "", err
 	}
 	return "ink", internalRepo.FullName, nil
 }
@@ -348,6 +321,16 @@ func (s *Server) createServiceFromGitHub(ctx context.Context, user *users.User,
 		RootDirectory:    input.RootDirectory,
 		DockerfilePath:   input.DockerfilePath,
 		Region:           input.Region,
+		Ports:            toPortInputs(input.Ports),
+		SleepAfterIdle:   input.SleepAfterIdle,
+		DeployPolicy:     input.DeployPolicy,
+		DeployDebounce:   input.DeployDebounce,
+		WatchPaths:       input.WatchPaths,
+		TagPattern:       input.TagPattern,
+		DockerfileTarget: input.DockerfileTarget,
+		BuildArgs:        toDeployEnvVars(input.BuildArgs),
+		BuildContext:     input.BuildContext,
+		StaticSite:       toStaticSiteConfig(input.SPAFallback, input.NotFoundPage, input.Redirects, input.Headers),
 	})
 }
 
@@ -385,9 +368,46 @@ func (s *Server) createServiceFromInternalGit(ctx context.Context, userID string
 		RootDirectory:    input.RootDirectory,
 		DockerfilePath:   input.DockerfilePath,
 		Region:           input.Region,
+		Ports:            toPortInputs(input.Ports),
+		SleepAfterIdle:   input.SleepAfterIdle,
+		DeployPolicy:     input.DeployPolicy,
+		DeployDebounce:   input.DeployDebounce,
+		WatchPaths:       input.WatchPaths,
+		TagPattern:       input.TagPattern,
+		DockerfileTarget: input.DockerfileTarget,
+		BuildArgs:        toDeployEnvVars(input.BuildArgs),
+		BuildContext:     input.BuildContext,
+		StaticSite:       toStaticSiteConfig(input.SPAFallback, input.NotFoundPage, input.Redirects, input.Headers),
 	})
 }
 
+func toDeployEnvVars(envVars []EnvVar) []deployments.EnvVar {
+	out := make([]deployments.EnvVar, len(envVars))
+	for i, ev := range envVars {
+		out[i] = deployments.EnvVar{Key: ev.Key, Value: ev.Value}
+	}
+	return out
+}
+
+func toStaticSiteConfig(spaFallback *bool, notFoundPage string, redirects []StaticRedirect, headers []StaticHeader) k8sdeployments.StaticSiteConfig {
+	cfg := k8sdeployments.StaticSiteConfig{SPAFallback: spaFallback, NotFoundPage: notFoundPage}
+	for _, r := range redirects {
+		cfg.Redirects = append(cfg.Redirects, k8sdeployments.StaticRedirect(r))
+	}
+	for _, h := range headers {
+		cfg.Headers = append(cfg.Headers, k8sdeployments.StaticHeader(h))
+	}
+	return cfg
+}
+
+func toPortInputs(ports []PortInput) []deployments.PortInput {
+	out := make([]deployments.PortInput, len(ports))
+	for i, p := range ports {
+		out[i] = deployments.PortInput{Port: int32(p.Port), Protocol: p.Protocol}
+	}
+	return out
+}
+
 func (s *Server) handleListServices(ctx context.Context, req *mcp.CallToolRequest, input ListServicesInput) (*mcp.CallToolResult, ListServicesOutput, error) {
 	user := UserFromContext(ctx)
 	if user == nil {
@@ -409,7 +429,7 @@ func (s *Server) handleListServices(ctx context.Context, req *mcp.CallToolReques
 
 		status := "pending"
 		if d, err := s.deployService.GetLatestDeployment(ctx, svc.ID); err == nil && d != nil {
-			status = d.Status
+			status = deployments.ServiceStatus(d.Status, svc.SleepingAt.Valid, svc.StoppedAt.Valid)
 		}
 
 		services[i] = ServiceInfo{
@@ -453,9 +473,15 @@ func (s *Server) handleRedeployService(ctx context.Context, req *mcp.CallToolReq
 		"user_id", user.ID,
 		"service_id", svc.ID,
 		"name", input.Name,
+		"ref", input.Ref,
 	)
 
-	workflowID, err := s.deployService.RedeployService(ctx, svc.ID)
+	var workflowID string
+	if strings.TrimSpace(input.Ref) != "" {
+		workflowID, err = s.deployService.RedeployServiceAtRef(ctx, svc.ID, input.Ref)
+	} else {
+		workflowID, err = s.deployService.RedeployService(ctx, svc.ID)
+	}
 	if err != nil {
 		s.logger.Error("failed to start redeploy", "error", err)
 		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to start redeploy: %v", err)}}}, RedeployServiceOutput{}, nil
@@ -633,22 +659,31 @@ func (s *Server) handleGetService(ctx context.Context, req *mcp.CallToolRequest,
 
 	status := "pending"
 	var errorMessage *string
+	var gitTag string
 	if dep, err := s.deployService.GetLatestDeployment(ctx, svc.ID); err == nil && dep != nil {
-		status = dep.Status
+		status = deployments.ServiceStatus(dep.Status, svc.SleepingAt.Valid, svc.StoppedAt.Valid)
 		errorMessage = dep.ErrorMessage
+		gitTag = helpers.Deref(dep.GitTag)
 	}
 
 	output := GetServiceOutput{
-		ServiceID:    svc.ID,
-		Name:         helpers.Deref(svc.Name),
-		Project:      project,
-		Repo:         svc.Repo,
-		Branch:       svc.Branch,
-		Status:       status,
-		ErrorMessage: errorMessage,
-		URL:          svc.Fqdn,
-		CreatedAt:    svc.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:    svc.UpdatedAt.Time.Format(time.RFC3339),
+		ServiceID:      svc.ID,
+		Name:           helpers.Deref(svc.Name),
+		Project:        project,
+		Repo:           svc.Repo,
+		Branch:         svc.Branch,
+		Image:          helpers.Deref(svc.Image),
+		Status:         status,
+		ErrorMessage:   errorMessage,
+		URL:            svc.Fqdn,
+		SleepAfterIdle: deployments.FormatSleepAfterIdle(svc.SleepAfterIdle),
+		DeployPolicy:   svc.DeployPolicy,
+		DeployDebounce: deployments.FormatDeployDebounce(svc.DeployPolicy, svc.DeployDebounce),
+		WatchPaths:     deployments.ServiceWatchPaths(*svc),
+		TagPattern:     helpers.Deref(svc.TagPattern),
+		GitTag:         gitTag,
+		CreatedAt:      svc.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:      svc.UpdatedAt.Time.Format(time.RFC3339),
 	}
 
 	if zr, dz, err := s.dnsService.GetCustomDomainForService(ctx, svc.ID); err == nil {
@@ -660,6 +695,41 @@ func (s *Server) handleGetService(ctx context.Context, req *mcp.CallToolRequest,
 		}
 	}
 
+	for _, p := range s.deployService.PublicPorts(svc) {
+		output.Ports = append(output.Ports, PortInfo{
+			Port:          int(p.Port),
+			Protocol:      p.Protocol,
+			PublicAddress: p.Address,
+		})
+	}
+
+	policy, err := k8sdeployments.ParseAccessPolicy(svc.AccessPolicy)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, GetServiceOutput{}, nil
+	}
+	if !policy.IsPublic() {
+		access := &AccessPolicyDetails{IPAllowList: policy.IPAllowList}
+		for _, u := range policy.BasicAuth {
+			access.BasicAuthUsers = append(access.BasicAuthUsers, u.Username)
+		}
+		output.Access = access
+	}
+
+	var bc k8sdeployments.BuildConfig
+	if len(svc.BuildConfig) > 0 {
+		_ = json.Unmarshal(svc.BuildConfig, &bc)
+	}
+	output.DockerfileTarget = bc.DockerfileTarget
+	output.BuildContext = bc.BuildContext
+	output.SPAFallback = bc.SPAFallback
+	output.NotFoundPage = bc.NotFoundPage
+	for _, r := range bc.Redirects {
+		output.Redirects = append(output.Redirects, StaticRedirect(r))
+	}
+	for _, h := range bc.Headers {
+		output.Headers = append(output.Headers, StaticHeader(h))
+	}
+
 	if input.IncludeEnv {
 		var envVars []EnvVar
 		if err := json.Unmarshal(svc.EnvVars, &envVars); err == nil {
@@ -668,6 +738,15 @@ func (s *Server) handleGetService(ctx context.Context, req *mcp.CallToolRequest,
 				output.EnvVars[i] = EnvVarInfo(ev)
 			}
 		}
+		// Build args often carry tokens, so they follow include_env
+		keys := make([]string, 0, len(bc.BuildArgs))
+		for k := range bc.BuildArgs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			output.BuildArgs = append(output.BuildArgs, EnvVarInfo{Key: k, Value: bc.BuildArgs[k]})
+		}
 	}
 
 	if input.DeployLogLines > 0 {
@@ -743,6 +822,10 @@ func (s *Server) handleUpdateService(ctx context.Context, req *mcp.CallToolReque
 		depInput.BuildPack = input.BuildPack
 	}
 
+	depInput.Image = input.Image
+	depInput.RegistryUsername = input.RegistryUsername
+	depInput.RegistryPassword = input.RegistryPassword
+
 	if input.Memory != nil {
 		if err := validateMemory(*input.Memory); err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, UpdateServiceOutput{}, nil
@@ -795,6 +878,41 @@ func (s *Server) handleUpdateService(ctx context.Context, req *mcp.CallToolReque
 		depInput.EnvVars = &envVars
 	}
 
+	if input.Ports != nil {
+		ports := toPortInputs(*input.Ports)
+		depInput.Ports = &ports
+	}
+
+	if input.Access != nil {
+		access := deployments.AccessPolicyInput{IPAllowList: input.Access.IPAllowList}
+		for _, cred := range input.Access.BasicAuth {
+			access.BasicAuth = append(access.BasicAuth, deployments.BasicAuthCredential(cred))
+		}
+		depInput.Access = &access
+	}
+
+	depInput.SleepAfterIdle = input.SleepAfterIdle
+	depInput.DeployPolicy = input.DeployPolicy
+	depInput.DeployDebounce = input.DeployDebounce
+	depInput.WatchPaths = input.WatchPaths
+	depInput.TagPattern = input.TagPattern
+	depInput.DockerfileTarget = input.DockerfileTarget
+	depInput.BuildContext = input.BuildContext
+	depInput.SPAFallback = input.SPAFallback
+	depInput.NotFoundPage = input.NotFoundPage
+	if input.Redirects != nil {
+		redirects := toStaticSiteConfig(nil, "", *input.Redirects, nil).Redirects
+		depInput.Redirects = &redirects
+	}
+	if input.Headers != nil {
+		headers := toStaticSiteConfig(nil, "", nil, *input.Headers).Headers
+		depInput.Headers = &headers
+	}
+	if input.BuildArgs != nil {
+		buildArgs := toDeployEnvVars(*input.BuildArgs)
+		depInput.BuildArgs = &buildArgs
+	}
+
 	result, err := s.deployService.UpdateService(ctx, depInput)
 	if err != nil {
 		s.logger.Error("failed to update service", "error", err)
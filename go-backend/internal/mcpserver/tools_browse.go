@@ -0,0 +1,127 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// browseRepo resolves an internal repo the user owns, for the repo
+// browsing tools.
+func (s *Server) browseRepo(ctx context.Context, repo, project string) (string, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return "", fmt.Errorf("not authenticated")
+	}
+	if repo == "" {
+		return "", fmt.Errorf("repo is required")
+	}
+	internalRepo, err := s.internalGitSvc.ResolveRepo(ctx, user.ID, repo, project)
+	if err != nil {
+		return "", err
+	}
+	return internalRepo.FullName, nil
+}
+
+func (s *Server) handleListRepoRefs(ctx context.Context, req *mcp.CallToolRequest, input ListRepoRefsInput) (*mcp.CallToolResult, ListRepoRefsOutput, error) {
+	fullName, err := s.browseRepo(ctx, input.Repo, input.Project)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, ListRepoRefsOutput{}, nil
+	}
+	refs, err := s.internalGitSvc.ListRefs(ctx, fullName)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to list refs: %v", err)}}}, ListRepoRefsOutput{}, nil
+	}
+
+	return nil, ListRepoRefsOutput{
+		Repo:          input.Repo,
+		DefaultBranch: refs.DefaultBranch,
+		Branches:      toRepoRefInfos(refs.Branches),
+		Tags:          toRepoRefInfos(refs.Tags),
+		Truncated:     refs.Truncated,
+	}, nil
+}
+
+func (s *Server) handleGetRepoLog(ctx context.Context, req *mcp.CallToolRequest, input GetRepoLogInput) (*mcp.CallToolResult, GetRepoLogOutput, error) {
+	fullName, err := s.browseRepo(ctx, input.Repo, input.Project)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, GetRepoLogOutput{}, nil
+	}
+	if input.Limit < 0 || input.Limit > internalgit.MaxRepoLogLimit {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("limit must be between 1 and %d", internalgit.MaxRepoLogLimit)}}}, GetRepoLogOutput{}, nil
+	}
+	log, err := s.internalGitSvc.Log(ctx, fullName, input.Ref, input.Path, input.Limit)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to read log: %v", err)}}}, GetRepoLogOutput{}, nil
+	}
+
+	out := GetRepoLogOutput{Repo: input.Repo, Ref: log.Ref, Commits: make([]RepoCommitInfo, len(log.Commits))}
+	for i, c := range log.Commits {
+		out.Commits[i] = RepoCommitInfo{
+			SHA:        c.SHA,
+			Author:     fmt.Sprintf("%s <%s>", c.AuthorName, c.AuthorEmail),
+			AuthoredAt: c.AuthoredAt.Format(time.RFC3339),
+			Subject:    c.Subject,
+		}
+	}
+	return nil, out, nil
+}
+
+func (s *Server) handleListRepoTree(ctx context.Context, req *mcp.CallToolRequest, input ListRepoTreeInput) (*mcp.CallToolResult, ListRepoTreeOutput, error) {
+	fullName, err := s.browseRepo(ctx, input.Repo, input.Project)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, ListRepoTreeOutput{}, nil
+	}
+	tree, err := s.internalGitSvc.Tree(ctx, fullName, input.Ref, input.Path)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to list tree: %v", err)}}}, ListRepoTreeOutput{}, nil
+	}
+
+	out := ListRepoTreeOutput{
+		Repo:      input.Repo,
+		Ref:       tree.Ref,
+		CommitSHA: tree.CommitSHA,
+		Path:      tree.Path,
+		Entries:   make([]RepoTreeEntryInfo, len(tree.Entries)),
+		Truncated: tree.Truncated,
+	}
+	for i, e := range tree.Entries {
+		out.Entries[i] = RepoTreeEntryInfo(e)
+	}
+	return nil, out, nil
+}
+
+func (s *Server) handleReadRepoFile(ctx context.Context, req *mcp.CallToolRequest, input ReadRepoFileInput) (*mcp.CallToolResult, ReadRepoFileOutput, error) {
+	fullName, err := s.browseRepo(ctx, input.Repo, input.Project)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, ReadRepoFileOutput{}, nil
+	}
+	if input.Path == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "path is required"}}}, ReadRepoFileOutput{}, nil
+	}
+	file, err := s.internalGitSvc.ReadFile(ctx, fullName, input.Ref, input.Path)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to read file: %v", err)}}}, ReadRepoFileOutput{}, nil
+	}
+
+	return nil, ReadRepoFileOutput{
+		Repo:      input.Repo,
+		Ref:       file.Ref,
+		CommitSHA: file.CommitSHA,
+		Path:      file.Path,
+		Size:      file.Size,
+		Binary:    file.Binary,
+		Content:   file.Content,
+	}, nil
+}
+
+func toRepoRefInfos(refs []internalgit.RepoRef) []RepoRefInfo {
+	out := make([]RepoRefInfo, len(refs))
+	for i, r := range refs {
+		out[i] = RepoRefInfo(r)
+	}
+	return out
+}
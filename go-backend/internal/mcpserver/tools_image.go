@@ -0,0 +1,74 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// createServiceFromImage handles create_service with build_pack=image: there
+// is no repo to resolve, the image is validated against its registry instead.
+func (s *Server) createServiceFromImage(ctx context.Context, userID string, input CreateServiceInput) (*mcp.CallToolResult, CreateServiceOutput, error) {
+	if input.Name == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "name is required"}}}, CreateServiceOutput{}, nil
+	}
+	if input.Image == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "image is required with build_pack=image"}}}, CreateServiceOutput{}, nil
+	}
+	if input.Repo != "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "repo is not used with build_pack=image; pass image instead"}}}, CreateServiceOutput{}, nil
+	}
+	if input.BuildCommand != "" || input.StartCommand != "" || input.PublishDirectory != "" || input.RootDirectory != "" || input.DockerfilePath != "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "build settings are not supported with build_pack=image"}}}, CreateServiceOutput{}, nil
+	}
+
+	port := resolveServicePort(k8sdeployments.BuildPackImage, "", input.Port)
+
+	envVars := make([]deployments.EnvVar, len(input.EnvVars))
+	for i, ev := range input.EnvVars {
+		envVars[i] = deployments.EnvVar{
+			Key:   ev.Key,
+			Value: ev.Value,
+		}
+	}
+
+	s.logger.Info("starting image deployment",
+		"user_id", userID,
+		"project", input.Project,
+		"image", input.Image,
+		"port", port,
+	)
+
+	result, err := s.deployService.CreateService(ctx, deployments.CreateServiceInput{
+		UserID:           userID,
+		ProjectRef:       input.Project,
+		Name:             input.Name,
+		BuildPack:        k8sdeployments.BuildPackImage,
+		Port:             port,
+		EnvVars:          envVars,
+		Memory:           input.Memory,
+		VCPUs:            input.VCPUs,
+		Region:           input.Region,
+		Ports:            toPortInputs(input.Ports),
+		SleepAfterIdle:   input.SleepAfterIdle,
+		Image:            input.Image,
+		RegistryUsername: input.RegistryUsername,
+		RegistryPassword: input.RegistryPassword,
+		DeployPolicy:     input.DeployPolicy,
+		DeployDebounce:   input.DeployDebounce,
+	})
+	if err != nil {
+		s.logger.Error("failed to start deployment", "error", err)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to start deployment: %v", err)}}}, CreateServiceOutput{}, nil
+	}
+
+	return nil, CreateServiceOutput{
+		ServiceID: result.ServiceID,
+		Name:      result.Name,
+		Status:    result.Status,
+		Message:   fmt.Sprintf("Deployment started (workflow_id: %s)", result.WorkflowID),
+	}, nil
+}
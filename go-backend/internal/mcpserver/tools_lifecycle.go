@@ -0,0 +1,57 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type lifecycleFunc func(context.Context, deployments.ServiceLifecycleParams) (*deployments.ServiceLifecycleResult, error)
+
+func (s *Server) handleStopService(ctx context.Context, req *mcp.CallToolRequest, input ServiceLifecycleInput) (*mcp.CallToolResult, ServiceLifecycleOutput, error) {
+	return s.runLifecycle(ctx, input, s.deployService.StopService, "Service %s is stopping")
+}
+
+func (s *Server) handleStartService(ctx context.Context, req *mcp.CallToolRequest, input ServiceLifecycleInput) (*mcp.CallToolResult, ServiceLifecycleOutput, error) {
+	return s.runLifecycle(ctx, input, s.deployService.StartService, "Service %s is starting")
+}
+
+func (s *Server) handleRestartService(ctx context.Context, req *mcp.CallToolRequest, input ServiceLifecycleInput) (*mcp.CallToolResult, ServiceLifecycleOutput, error) {
+	return s.runLifecycle(ctx, input, s.deployService.RestartService, "Service %s is restarting")
+}
+
+func (s *Server) runLifecycle(ctx context.Context, input ServiceLifecycleInput, run lifecycleFunc, message string) (*mcp.CallToolResult, ServiceLifecycleOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, ServiceLifecycleOutput{}, nil
+	}
+
+	if input.Name == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "name is required"}}}, ServiceLifecycleOutput{}, nil
+	}
+
+	project := "default"
+	if input.Project != "" {
+		project = input.Project
+	}
+
+	result, err := run(ctx, deployments.ServiceLifecycleParams{
+		Name:    input.Name,
+		Project: project,
+		UserID:  user.ID,
+	})
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, ServiceLifecycleOutput{}, nil
+	}
+
+	return nil, ServiceLifecycleOutput{
+		ServiceID: result.ServiceID,
+		Name:      result.Name,
+		Action:    result.Action,
+		EventID:   result.EventID,
+		Status:    result.Status,
+		Message:   fmt.Sprintf(message, result.Name),
+	}, nil
+}
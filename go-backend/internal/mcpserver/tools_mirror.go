@@ -0,0 +1,107 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// requireGitHubApp checks the user has installed the GitHub App, which
+// the git server uses to read and push their GitHub repos.
+func (s *Server) requireGitHubApp(ctx context.Context, userID string) error {
+	creds, err := s.authService.GetGitHubCredsByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("GitHub not connected. Please go to https://ml.ink/settings/access")
+	}
+	if creds.GithubAppInstallationID == nil {
+		return fmt.Errorf("GitHub App not installed. Please install at https://ml.ink/settings/github")
+	}
+	return nil
+}
+
+func (s *Server) handleImportRepo(ctx context.Context, req *mcp.CallToolRequest, input ImportRepoInput) (*mcp.CallToolResult, ImportRepoOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, ImportRepoOutput{}, nil
+	}
+
+	githubRepo := strings.TrimSuffix(strings.TrimPrefix(input.GitHubRepo, "https://github.com/"), ".git")
+	if !internalgit.ValidGitHubRepo(githubRepo) {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "github_repo must be owner/name"}}}, ImportRepoOutput{}, nil
+	}
+	name := input.Name
+	if name == "" {
+		_, name, _ = strings.Cut(githubRepo, "/")
+	}
+	if err := s.requireGitHubApp(ctx, user.ID); err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, ImportRepoOutput{}, nil
+	}
+
+	projectRef := input.Project
+	if projectRef == "" {
+		projectRef = "default"
+	}
+	project, err := s.deployService.GetProjectByRef(ctx, user.ID, projectRef)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("project not found: %s", projectRef)}}}, ImportRepoOutput{}, nil
+	}
+
+	imported, err := s.internalGitSvc.ImportFromGitHub(ctx, user.ID, project.ID, name, githubRepo)
+	if err != nil {
+		s.logger.Error("failed to import repo", "error", err, "github", githubRepo)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to import %s: %v", githubRepo, err)}}}, ImportRepoOutput{}, nil
+	}
+
+	out := ImportRepoOutput{
+		Repo:          "ink/" + name,
+		GitRemote:     imported.GitRemote,
+		DefaultBranch: imported.DefaultBranch,
+		Branches:      imported.Branches,
+		Tags:          imported.Tags,
+		Message:       "Imported. Call create_service with this repo to deploy it, or update_service with repo and host='ink' to move an existing GitHub service here; its deploy history is kept.",
+	}
+	if imported.Pending {
+		out.Message = "The import is still running. Check it with list_repo_refs before deploying."
+	}
+	return nil, out, nil
+}
+
+func (s *Server) handleMirrorRepo(ctx context.Context, req *mcp.CallToolRequest, input MirrorRepoInput) (*mcp.CallToolResult, MirrorRepoOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, MirrorRepoOutput{}, nil
+	}
+	if input.Repo == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "repo is required"}}}, MirrorRepoOutput{}, nil
+	}
+
+	githubRepo := strings.TrimSuffix(strings.TrimPrefix(input.GitHubRepo, "https://github.com/"), ".git")
+	if githubRepo != "" {
+		if !internalgit.ValidGitHubRepo(githubRepo) {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "github_repo must be owner/name"}}}, MirrorRepoOutput{}, nil
+		}
+		if err := s.requireGitHubApp(ctx, user.ID); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, MirrorRepoOutput{}, nil
+		}
+	}
+
+	repo, err := s.internalGitSvc.SetMirror(ctx, user.ID, input.Repo, input.Project, githubRepo)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, MirrorRepoOutput{}, nil
+	}
+
+	out := MirrorRepoOutput{Repo: input.Repo, GitHubRepo: githubRepo}
+	if repo.MirroredAt.Valid {
+		out.MirroredAt = repo.MirroredAt.Time.Format(time.RFC3339)
+	}
+	if githubRepo == "" {
+		out.Message = "Mirroring stopped."
+	} else {
+		out.Message = fmt.Sprintf("Every push to %s is now also pushed to github.com/%s, overwriting its branches and tags. To deploy from GitHub instead, call update_service with repo='%s' and host='github'; its deploy history is kept.", input.Repo, githubRepo, githubRepo)
+	}
+	return nil, out, nil
+}
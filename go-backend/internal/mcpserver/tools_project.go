@@ -0,0 +1,205 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/augustdev/autoclip/internal/projectplan"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/users"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func (s *Server) handlePlanProject(ctx context.Context, req *mcp.CallToolRequest, input PlanProjectInput) (*mcp.CallToolResult, PlanProjectOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, PlanProjectOutput{}, nil
+	}
+
+	spec, err := s.toProjectSpec(ctx, user, input)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, PlanProjectOutput{}, nil
+	}
+
+	plan, err := s.projectPlanSvc.Plan(ctx, user.ID, spec)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, PlanProjectOutput{}, nil
+	}
+
+	out := PlanProjectOutput{
+		Project:  plan.Project,
+		PlanHash: plan.Hash,
+		Changes:  toProjectChanges(plan.Steps),
+		Message:  "No changes; the project matches the spec",
+	}
+	if len(plan.Steps) > 0 {
+		out.Message = fmt.Sprintf("%d change(s). Call apply_project with the same spec and plan_hash %s to apply them.", len(plan.Steps), plan.Hash)
+	}
+	return nil, out, nil
+}
+
+func (s *Server) handleApplyProject(ctx context.Context, req *mcp.CallToolRequest, input ApplyProjectInput) (*mcp.CallToolResult, ApplyProjectOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, ApplyProjectOutput{}, nil
+	}
+
+	spec, err := s.toProjectSpec(ctx, user, input.PlanProjectInput)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, ApplyProjectOutput{}, nil
+	}
+
+	s.logger.Info("applying project spec", "user_id", user.ID, "project", spec.Project, "plan_hash", input.PlanHash)
+
+	plan, result, err := s.projectPlanSvc.Apply(ctx, user.ID, spec, input.PlanHash)
+	if err != nil {
+		s.logger.Error("failed to apply project spec", "user_id", user.ID, "project", spec.Project, "error", err)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("apply failed: %v", err)}}}, ApplyProjectOutput{}, nil
+	}
+
+	out := ApplyProjectOutput{
+		Project:  plan.Project,
+		PlanHash: plan.Hash,
+		Applied:  result.Applied,
+		Message:  "No changes; the project already matches the spec",
+	}
+	if len(result.Applied) > 0 {
+		out.Message = fmt.Sprintf("Applied %d change(s). Services deploy in the background; use get_service to follow them.", len(result.Applied))
+	}
+	return nil, out, nil
+}
+
+func toProjectChanges(steps []projectplan.Step) []ProjectChange {
+	out := make([]ProjectChange, len(steps))
+	for i, st := range steps {
+		out[i] = ProjectChange{
+			Action:         st.Action,
+			Kind:           st.Kind,
+			Name:           st.Name,
+			Fields:         st.Fields,
+			Domain:         st.Domain,
+			PreviousDomain: st.PreviousDomain,
+		}
+	}
+	return out
+}
+
+// toProjectSpec validates the tool input the way create_service and
+// create_resource do and resolves repos to what CreateService expects.
+func (s *Server) toProjectSpec(ctx context.Context, user *users.User, input PlanProjectInput) (projectplan.Spec, error) {
+	project := "default"
+	if input.Project != "" {
+		project = input.Project
+	}
+	spec := projectplan.Spec{Project: project, Prune: input.Prune}
+
+	var installationID *int64
+	for _, in := range input.Services {
+		svc := projectplan.ServiceSpec{
+			Name:         strings.TrimSpace(in.Name),
+			Branch:       in.Branch,
+			BuildPack:    in.BuildPack,
+			Memory:       in.Memory,
+			VCPUs:        in.VCPUs,
+			BuildCommand: in.BuildCommand,
+			StartCommand: in.StartCommand,
+			Region:       in.Region,
+			EnvVars:      toDeployEnvVars(in.EnvVars),
+			SetEnv:       in.EnvVars != nil,
+		}
+		if svc.Name == "" {
+			return spec, fmt.Errorf("every service needs a name")
+		}
+		if svc.BuildPack != "" {
+			if err := validateBuildPack(svc.BuildPack); err != nil {
+				return spec, fmt.Errorf("service %s: %w", svc.Name, err)
+			}
+		}
+		if svc.Memory != "" {
+			if err := validateMemory(svc.Memory); err != nil {
+				return spec, fmt.Errorf("service %s: %w", svc.Name, err)
+			}
+		}
+		if svc.VCPUs != "" {
+			if err := validateVCPUs(svc.VCPUs); err != nil {
+				return spec, fmt.Errorf("service %s: %w", svc.Name, err)
+			}
+		}
+
+		var err error
+		for _, p := range []struct {
+			dst   *string
+			raw   string
+			field string
+		}{
+			{&svc.RootDirectory, in.RootDirectory, "root_directory"},
+			{&svc.PublishDirectory, in.PublishDirectory, "publish_directory"},
+			{&svc.DockerfilePath, in.DockerfilePath, "dockerfile_path"},
+		} {
+			if *p.dst, err = sanitizePath(p.raw, p.field); err != nil {
+				return spec, fmt.Errorf("service %s: %w", svc.Name, err)
+			}
+		}
+
+		buildPack := svc.BuildPack
+		if buildPack == "" {
+			buildPack = DefaultBuildPack
+		}
+		if in.Port != nil {
+			svc.Port = resolveServicePort(buildPack, svc.PublishDirectory, in.Port)
+		}
+		svc.DefaultPort = resolveServicePort(buildPack, svc.PublishDirectory, nil)
+
+		if buildPack == k8sdeployments.BuildPackImage {
+			if in.Image == "" {
+				return spec, fmt.Errorf("service %s: image is required with build_pack=image", svc.Name)
+			}
+			svc.Image = in.Image
+			spec.Services = append(spec.Services, svc)
+			continue
+		}
+
+		if in.Repo != "" {
+			host, repo, err := s.normalizeServiceRepo(ctx, user, CreateServiceInput{Repo: in.Repo, Host: in.Host, Project: project})
+			if err != nil {
+				return spec, fmt.Errorf("service %s: %w", svc.Name, err)
+			}
+			svc.Repo, svc.GitProvider = repo, "internal"
+			if host == "github" {
+				if installationID == nil {
+					creds, err := s.authService.GetGitHubCredsByUserID(ctx, user.ID)
+					if err != nil || creds.GithubAppInstallationID == nil {
+						return spec, fmt.Errorf("service %s: GitHub App not installed. Please install the GitHub App first", svc.Name)
+					}
+					installationID = creds.GithubAppInstallationID
+				}
+				svc.Repo, svc.GitProvider = strings.TrimPrefix(repo, "github.com/"), "github"
+				svc.InstallationID = *installationID
+			}
+		}
+		spec.Services = append(spec.Services, svc)
+	}
+
+	for _, in := range input.Resources {
+		if in.Type != "" && in.Type != DefaultDBType {
+			return spec, fmt.Errorf("resource %s: invalid type: only 'sqlite' is supported", in.Name)
+		}
+		if in.Region != "" && in.Region != DefaultRegion {
+			return spec, fmt.Errorf("resource %s: invalid region: only 'eu-central' is supported", in.Name)
+		}
+		spec.Resources = append(spec.Resources, projectplan.ResourceSpec{
+			Name:   strings.TrimSpace(in.Name),
+			Type:   in.Type,
+			Size:   in.Size,
+			Region: in.Region,
+		})
+	}
+	for _, l := range input.Links {
+		spec.Links = append(spec.Links, projectplan.LinkSpec(l))
+	}
+	for _, d := range input.Domains {
+		spec.Domains = append(spec.Domains, projectplan.DomainSpec(d))
+	}
+	return spec, nil
+}
@@ -0,0 +1,106 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func toRouteInfo(r deployments.RouteInfo) RouteInfo {
+	return RouteInfo{
+		RouteID:     r.ID,
+		Host:        r.Host,
+		Path:        r.PathPrefix,
+		Service:     r.ServiceName,
+		StripPrefix: r.StripPrefix,
+		URL:         r.URL,
+	}
+}
+
+func (s *Server) handleAddRoute(ctx context.Context, req *mcp.CallToolRequest, input AddRouteInput) (*mcp.CallToolResult, AddRouteOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, AddRouteOutput{}, nil
+	}
+
+	if input.Host == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "host is required"}}}, AddRouteOutput{}, nil
+	}
+	if input.Service == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "service is required"}}}, AddRouteOutput{}, nil
+	}
+
+	project := "default"
+	if input.Project != "" {
+		project = input.Project
+	}
+
+	route, err := s.deployService.AddRoute(ctx, deployments.AddRouteInput{
+		UserID:      user.ID,
+		Project:     project,
+		Host:        input.Host,
+		PathPrefix:  input.Path,
+		ServiceName: input.Service,
+		StripPrefix: input.StripPrefix,
+	})
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, AddRouteOutput{}, nil
+	}
+
+	return nil, AddRouteOutput{
+		Route:   toRouteInfo(*route),
+		Message: fmt.Sprintf("Route %s → %s will be live in seconds", route.URL, route.ServiceName),
+	}, nil
+}
+
+func (s *Server) handleListRoutes(ctx context.Context, req *mcp.CallToolRequest, input ListRoutesInput) (*mcp.CallToolResult, ListRoutesOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, ListRoutesOutput{}, nil
+	}
+
+	project := "default"
+	if input.Project != "" {
+		project = input.Project
+	}
+
+	routes, err := s.deployService.ListRoutes(ctx, user.ID, project)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, ListRoutesOutput{}, nil
+	}
+
+	out := ListRoutesOutput{Routes: make([]RouteInfo, len(routes))}
+	for i, r := range routes {
+		out.Routes[i] = toRouteInfo(r)
+	}
+	return nil, out, nil
+}
+
+func (s *Server) handleRemoveRoute(ctx context.Context, req *mcp.CallToolRequest, input RemoveRouteInput) (*mcp.CallToolResult, RemoveRouteOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, RemoveRouteOutput{}, nil
+	}
+
+	if input.Host == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "host is required"}}}, RemoveRouteOutput{}, nil
+	}
+
+	project := "default"
+	if input.Project != "" {
+		project = input.Project
+	}
+
+	if err := s.deployService.RemoveRoute(ctx, deployments.RemoveRouteInput{
+		UserID:     user.ID,
+		Project:    project,
+		Host:       input.Host,
+		PathPrefix: input.Path,
+	}); err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, RemoveRouteOutput{}, nil
+	}
+
+	return nil, RemoveRouteOutput{Message: "Route removed"}, nil
+}
@@ -0,0 +1,76 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/internalgit"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func (s *Server) handleDeploySource(ctx context.Context, req *mcp.CallToolRequest, input DeploySourceInput) (*mcp.CallToolResult, DeploySourceOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, DeploySourceOutput{}, nil
+	}
+
+	if input.Name == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "name is required"}}}, DeploySourceOutput{}, nil
+	}
+
+	projectRef := input.Project
+	if projectRef == "" {
+		projectRef = "default"
+	}
+
+	project, err := s.deployService.GetProjectByRef(ctx, user.ID, projectRef)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("project not found: %s", projectRef)}}}, DeploySourceOutput{}, nil
+	}
+
+	svc, err := s.deployService.GetServiceByNameAndProject(ctx, input.Name, project.ID)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("service not found: %s", input.Name)}}}, DeploySourceOutput{}, nil
+	}
+	if svc.GitProvider != "internal" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("service %s deploys from %s; deploy_source only works with Ink repos (create_repo with host 'ink')", input.Name, svc.GitProvider)}}}, DeploySourceOutput{}, nil
+	}
+	if svc.TagPattern != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("service %s deploys tags matching %s, not its branch; push a tag instead", input.Name, *svc.TagPattern)}}}, DeploySourceOutput{}, nil
+	}
+
+	repo, err := s.internalGitSvc.GetRepoByFullName(ctx, svc.Repo)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("internal repo not found: %s", svc.Repo)}}}, DeploySourceOutput{}, nil
+	}
+	if repo.UserID != user.ID {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "repo belongs to another user"}}}, DeploySourceOutput{}, nil
+	}
+
+	upload, err := s.internalGitSvc.SourceUploadURL(repo.FullName, svc.Branch, input.Message)
+	if err != nil {
+		s.logger.Error("failed to presign source upload", "error", err, "repo", repo.FullName)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to create upload URL: %v", err)}}}, DeploySourceOutput{}, nil
+	}
+
+	s.logger.Info("issued source upload URL",
+		"user_id", user.ID,
+		"service_id", svc.ID,
+		"repo", repo.FullName,
+		"branch", svc.Branch,
+	)
+
+	return nil, DeploySourceOutput{
+		ServiceID: svc.ID,
+		Name:      input.Name,
+		Repo:      "ink/" + repo.Name,
+		Branch:    svc.Branch,
+		UploadURL: upload.URL,
+		Method:    upload.Method,
+		ExpiresAt: upload.ExpiresAt.Format(time.RFC3339),
+		MaxBytes:  internalgit.MaxSourceUploadSize,
+		Command:   fmt.Sprintf("tar czf - --exclude=.git --exclude=node_modules . | curl --fail-with-body -X PUT --data-binary @- '%s'", upload.URL),
+		Message:   fmt.Sprintf("Upload the source within %d minutes. The response has the commit_sha; the deploy then starts as for a push to %s. Use get_service to follow it.", int(internalgit.SourceUploadTTL.Minutes()), svc.Branch),
+	}, nil
+}
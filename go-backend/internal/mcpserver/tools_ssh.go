@@ -0,0 +1,69 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/sshkeys"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func (s *Server) handleListSSHKeys(ctx context.Context, req *mcp.CallToolRequest, input ListSSHKeysInput) (*mcp.CallToolResult, ListSSHKeysOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, ListSSHKeysOutput{}, nil
+	}
+
+	keys, err := s.internalGitSvc.ListSSHKeys(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("failed to list ssh keys", "error", err)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to list ssh keys: %v", err)}}}, ListSSHKeysOutput{}, nil
+	}
+
+	out := ListSSHKeysOutput{Keys: make([]SSHKeyInfo, len(keys))}
+	for i, k := range keys {
+		out.Keys[i] = sshKeyInfo(k)
+	}
+	return nil, out, nil
+}
+
+func (s *Server) handleAddSSHKey(ctx context.Context, req *mcp.CallToolRequest, input AddSSHKeyInput) (*mcp.CallToolResult, SSHKeyInfo, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, SSHKeyInfo{}, nil
+	}
+	if input.PublicKey == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "public_key is required"}}}, SSHKeyInfo{}, nil
+	}
+
+	key, err := s.internalGitSvc.AddSSHKey(ctx, user.ID, input.Name, input.PublicKey)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, SSHKeyInfo{}, nil
+	}
+	return nil, sshKeyInfo(key), nil
+}
+
+func (s *Server) handleDeleteSSHKey(ctx context.Context, req *mcp.CallToolRequest, input DeleteSSHKeyInput) (*mcp.CallToolResult, DeleteSSHKeyOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, DeleteSSHKeyOutput{}, nil
+	}
+	if input.ID == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "id is required"}}}, DeleteSSHKeyOutput{}, nil
+	}
+
+	if err := s.internalGitSvc.DeleteSSHKey(ctx, user.ID, input.ID); err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, DeleteSSHKeyOutput{}, nil
+	}
+	return nil, DeleteSSHKeyOutput{ID: input.ID, Deleted: true}, nil
+}
+
+func sshKeyInfo(k sshkeys.SshKey) SSHKeyInfo {
+	return SSHKeyInfo{
+		ID:          k.ID,
+		Name:        k.Name,
+		Fingerprint: k.Fingerprint,
+		LastUsedAt:  formatTimestamp(k.LastUsedAt),
+		CreatedAt:   formatTimestamp(k.CreatedAt),
+	}
+}
@@ -0,0 +1,135 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/augustdev/autoclip/internal/templates"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// templateAppNameRe leaves room for the -<resource> suffix within the
+// 63 character DNS label limit.
+var templateAppNameRe = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,38}[a-z0-9])?$`)
+
+func (s *Server) handleListTemplates(ctx context.Context, req *mcp.CallToolRequest, input ListTemplatesInput) (*mcp.CallToolResult, ListTemplatesOutput, error) {
+	list, err := templates.List()
+	if err != nil {
+		s.logger.Error("failed to load templates", "error", err)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "failed to load templates"}}}, ListTemplatesOutput{}, nil
+	}
+
+	out := ListTemplatesOutput{Templates: make([]TemplateInfo, 0, len(list))}
+	for _, t := range list {
+		info := TemplateInfo{
+			Name:        t.Name,
+			Version:     t.Version,
+			Title:       t.Title,
+			Description: t.Description,
+		}
+		for _, svc := range t.Services {
+			info.Services = append(info.Services, svc.Name)
+		}
+		for _, r := range t.Resources {
+			info.Resources = append(info.Resources, r.Name)
+		}
+		out.Templates = append(out.Templates, info)
+	}
+	return nil, out, nil
+}
+
+func (s *Server) handleCreateFromTemplate(ctx context.Context, req *mcp.CallToolRequest, input CreateFromTemplateInput) (*mcp.CallToolResult, CreateFromTemplateOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, CreateFromTemplateOutput{}, nil
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if !templateAppNameRe.MatchString(name) {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "name is required: lowercase letters, digits and hyphens, at most 40 characters"}}}, CreateFromTemplateOutput{}, nil
+	}
+	tmpl, err := templates.Get(input.Template)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("%v. Use list_templates to see the available templates", err)}}}, CreateFromTemplateOutput{}, nil
+	}
+	projectRef := input.Project
+	if projectRef == "" {
+		projectRef = "default"
+	}
+
+	project, err := s.deployService.EnsureProject(ctx, user.ID, projectRef)
+	if err != nil {
+		s.logger.Error("failed to get project", "error", err, "project", projectRef)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to get project %s: %v", projectRef, err)}}}, CreateFromTemplateOutput{}, nil
+	}
+
+	// Everything the template creates must be new: the skeleton only
+	// pushes into an empty repo, and apply would otherwise update or reuse
+	// existing services and databases.
+	spec := tmpl.Spec(projectRef, name, "")
+	if _, err := s.internalGitSvc.GetRepoByProjectAndName(ctx, project.ID, name); err == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("repo '%s' already exists in project '%s'; pick another name", name, projectRef)}}}, CreateFromTemplateOutput{}, nil
+	}
+	for _, svc := range spec.Services {
+		if _, err := s.deployService.GetServiceByName(ctx, deployments.GetServiceByNameParams{Name: svc.Name, Project: projectRef, UserID: user.ID}); err == nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("service '%s' already exists in project '%s'; pick another name", svc.Name, projectRef)}}}, CreateFromTemplateOutput{}, nil
+		}
+	}
+	for _, r := range spec.Resources {
+		if _, err := s.resourcesService.GetResourceByName(ctx, user.ID, r.Name); err == nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("resource '%s' already exists; pick another name", r.Name)}}}, CreateFromTemplateOutput{}, nil
+		}
+	}
+
+	repo, err := s.internalGitSvc.CreateRepo(ctx, user.ID, project.ID, name, tmpl.Title, true)
+	if err != nil {
+		s.logger.Error("failed to create internal repo", "error", err, "name", name)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to create repository: %v", err)}}}, CreateFromTemplateOutput{}, nil
+	}
+	internalRepo, err := s.internalGitSvc.GetRepoByProjectAndName(ctx, project.ID, name)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to look up repository: %v", err)}}}, CreateFromTemplateOutput{}, nil
+	}
+
+	out := CreateFromTemplateOutput{
+		Template:  tmpl.Name,
+		Version:   tmpl.Version,
+		Project:   projectRef,
+		Repo:      repo.Repo,
+		GitRemote: repo.GitRemote,
+		ExpiresAt: repo.ExpiresAt,
+	}
+	if err := s.templatesSvc.Push(ctx, tmpl, internalRepo.FullName); err != nil {
+		s.logger.Error("failed to push template skeleton", "error", err, "template", tmpl.Name, "repo", internalRepo.FullName)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("created repo %s but failed to push the template: %v", repo.Repo, err)}}}, out, nil
+	}
+
+	spec = tmpl.Spec(projectRef, name, internalRepo.FullName)
+	for i := range spec.Services {
+		svc := &spec.Services[i]
+		buildPack := svc.BuildPack
+		if buildPack == "" {
+			buildPack = DefaultBuildPack
+		}
+		svc.DefaultPort = resolveServicePort(buildPack, svc.PublishDirectory, nil)
+		out.Services = append(out.Services, svc.Name)
+	}
+	for _, r := range spec.Resources {
+		out.Resources = append(out.Resources, r.Name)
+	}
+
+	s.logger.Info("creating app from template", "user_id", user.ID, "template", tmpl.Name, "version", tmpl.Version, "name", name, "project", projectRef)
+	if _, _, err := s.projectPlanSvc.Apply(ctx, user.ID, spec, ""); err != nil {
+		s.logger.Error("failed to apply template stack", "error", err, "template", tmpl.Name, "name", name)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("pushed the template to %s but failed to create its services: %v", repo.Repo, err)}}}, out, nil
+	}
+
+	if svc, err := s.deployService.GetServiceByName(ctx, deployments.GetServiceByNameParams{Name: out.Services[0], Project: projectRef, UserID: user.ID}); err == nil {
+		out.URL = svc.Fqdn
+	}
+	out.Message = fmt.Sprintf("Created %s from template %s v%d. Deployment started; use get_service to follow it. Clone git_remote to edit the code — every push to main redeploys.", name, tmpl.Name, tmpl.Version)
+	return nil, out, nil
+}
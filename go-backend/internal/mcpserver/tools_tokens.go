@@ -0,0 +1,85 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/augustdev/autoclip/internal/helpers"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func (s *Server) handleListGitTokens(ctx context.Context, req *mcp.CallToolRequest, input ListGitTokensInput) (*mcp.CallToolResult, ListGitTokensOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, ListGitTokensOutput{}, nil
+	}
+
+	tokens, err := s.internalGitSvc.ListTokens(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("failed to list git tokens", "error", err)
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("failed to list git tokens: %v", err)}}}, ListGitTokensOutput{}, nil
+	}
+
+	out := ListGitTokensOutput{Tokens: make([]GitTokenInfo, len(tokens))}
+	for i, t := range tokens {
+		out.Tokens[i] = GitTokenInfo{
+			ID:         t.ID,
+			Prefix:     t.TokenPrefix,
+			Repo:       helpers.Deref(t.RepoFullName),
+			Scopes:     t.Scopes,
+			ExpiresAt:  formatTimestamp(t.ExpiresAt),
+			LastUsedAt: formatTimestamp(t.LastUsedAt),
+			CreatedAt:  formatTimestamp(t.CreatedAt),
+		}
+	}
+	return nil, out, nil
+}
+
+func (s *Server) handleCreateGitToken(ctx context.Context, req *mcp.CallToolRequest, input CreateGitTokenInput) (*mcp.CallToolResult, CreateGitTokenOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, CreateGitTokenOutput{}, nil
+	}
+	if input.Repo == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "repo is required"}}}, CreateGitTokenOutput{}, nil
+	}
+
+	ttl := time.Duration(input.ExpiresInHours) * time.Hour
+	minted, err := s.internalGitSvc.MintToken(ctx, user.ID, input.Repo, input.Project, input.Scopes, ttl)
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, CreateGitTokenOutput{}, nil
+	}
+
+	return nil, CreateGitTokenOutput{
+		ID:        minted.ID,
+		Repo:      minted.Repo,
+		Scopes:    minted.Scopes,
+		GitRemote: minted.GitRemote,
+		ExpiresAt: formatTimestamp(minted.ExpiresAt),
+		Message:   "Store the git_remote now, the token can't be shown again. Revoke it with revoke_git_token.",
+	}, nil
+}
+
+func (s *Server) handleRevokeGitToken(ctx context.Context, req *mcp.CallToolRequest, input RevokeGitTokenInput) (*mcp.CallToolResult, RevokeGitTokenOutput, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "not authenticated"}}}, RevokeGitTokenOutput{}, nil
+	}
+	if input.ID == "" {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "id is required"}}}, RevokeGitTokenOutput{}, nil
+	}
+
+	if err := s.internalGitSvc.RevokeToken(ctx, user.ID, input.ID); err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}}}, RevokeGitTokenOutput{}, nil
+	}
+	return nil, RevokeGitTokenOutput{ID: input.ID, Revoked: true}, nil
+}
+
+func formatTimestamp(ts pgtype.Timestamptz) string {
+	if !ts.Valid {
+		return ""
+	}
+	return ts.Time.Format(time.RFC3339)
+}
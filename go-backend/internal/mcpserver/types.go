@@ -15,14 +15,14 @@ type EnvVar struct {
 }
 
 type CreateServiceInput struct {
-	Repo   string `json:"repo" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp' or 'user/myapp')."`
+	Repo   string `json:"repo,omitempty" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp' or 'user/myapp'). Required unless build_pack=image."`
 	Host   string `json:"host,omitempty" jsonschema:"description=Git host,enum=ink,enum=github,default=ink"`
 	Branch string `json:"branch,omitempty" jsonschema:"description=Branch to deploy,default=main"`
 	Name   string `json:"name" jsonschema:"description=Name for the deployment"`
 	Region string `json:"region,omitempty" jsonschema:"description=Cluster region to deploy to,enum=eu-central-1,default=eu-central-1"`
 
 	Project   string   `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
-	BuildPack string   `json:"build_pack,omitempty" jsonschema:"description=Build pack to use. 'railpack' (default) auto-detects and builds most apps. 'static' serves files as-is with no build step. 'dockerfile' uses a custom Dockerfile. Use 'railpack' with publish_directory for Vite/React/Vue SPAs that need a build step then static serving via nginx.,enum=railpack,enum=dockerfile,enum=static,enum=dockercompose,default=railpack"`
+	BuildPack string   `json:"build_pack,omitempty" jsonschema:"description=Build pack to use. 'railpack' (default) auto-detects and builds most apps. 'static' serves files as-is with no build step. 'dockerfile' uses a custom Dockerfile. Use 'railpack' with publish_directory for Vite/React/Vue SPAs that need a build step then static serving via nginx. 'image' deploys a prebuilt container image without a repo.,enum=railpack,enum=dockerfile,enum=static,enum=dockercompose,enum=image,default=railpack"`
 	Port      *int     `json:"port,omitempty" jsonschema:"description=Port the application listens on"`
 	EnvVars   []EnvVar `json:"env_vars,omitempty" jsonschema:"description=Environment variables"`
 
@@ -34,8 +34,48 @@ type CreateServiceInput struct {
 
 	PublishDirectory string `json:"publish_directory,omitempty" jsonschema:"description=Directory containing built static files (e.g. 'dist'). When set with build_pack=railpack the app is built then served as static files via nginx. Recommended for Vite/React/Vue SPAs."`
 
-	RootDirectory  string `json:"root_directory,omitempty" jsonschema:"description=Subdirectory within the repo to use as build context (e.g. 'frontend' or 'services/api'). For monorepo deployments."`
-	DockerfilePath string `json:"dockerfile_path,omitempty" jsonschema:"description=Path to Dockerfile relative to root_directory (e.g. 'worker.Dockerfile' or 'build/Dockerfile'). Only used with build_pack=dockerfile."`
+	RootDirectory    string   `json:"root_directory,omitempty" jsonschema:"description=Subdirectory within the repo to use as build context (e.g. 'frontend' or 'services/api'). For monorepo deployments."`
+	DockerfilePath   string   `json:"dockerfile_path,omitempty" jsonschema:"description=Path to Dockerfile relative to root_directory (e.g. 'worker.Dockerfile' or 'build/Dockerfile'). Only used with build_pack=dockerfile."`
+	DockerfileTarget string   `json:"dockerfile_target,omitempty" jsonschema:"description=Multi-stage build stage to stop at (the name after AS in a FROM line, e.g. 'prod'). Only used with build_pack=dockerfile."`
+	BuildArgs        []EnvVar `json:"build_args,omitempty" jsonschema:"description=Dockerfile ARG values passed as --build-arg only; unlike env_vars they are not set on the running service. Only used with build_pack=dockerfile."`
+	BuildContext     string   `json:"build_context,omitempty" jsonschema:"description=Build context relative to root_directory (e.g. '..' to COPY shared code from the parent directory). Must stay inside the repo. Only used with build_pack=dockerfile."`
+
+	Ports []PortInput `json:"ports,omitempty" jsonschema:"description=Additional non-HTTP ports to expose publicly (game servers, MQTT, raw gRPC). Each gets a cluster-allocated public port reported by get_service."`
+
+	SleepAfterIdle string `json:"sleep_after_idle,omitempty" jsonschema:"description=Scale the service to zero after this long without HTTP requests (e.g. '30m'; min 5m). The first request wakes it back up. Omit to keep it always running."`
+
+	Image            string `json:"image,omitempty" jsonschema:"description=Prebuilt container image to deploy (e.g. 'nginx:1.27' or 'ghcr.io/acme/api:v2'). Required with build_pack=image. The tag is pinned to a digest on every deploy."`
+	RegistryUsername string `json:"registry_username,omitempty" jsonschema:"description=Username for a private registry. Only used with build_pack=image."`
+	RegistryPassword string `json:"registry_password,omitempty" jsonschema:"description=Password or access token for a private registry. Stored encrypted and never returned."`
+
+	DeployPolicy   string `json:"deploy_policy,omitempty" jsonschema:"description=What a new push does while a deploy is still running. 'cancel_previous' (default) cancels it and deploys the new commit. 'queue' lets it finish and then deploys only the latest commit. 'debounce' waits for pushes to stop for deploy_debounce and then queues the latest commit.,enum=cancel_previous,enum=queue,enum=debounce,default=cancel_previous"`
+	DeployDebounce string `json:"deploy_debounce,omitempty" jsonschema:"description=Quiet period for deploy_policy=debounce (e.g. '45s'; max 10m),default=30s"`
+
+	WatchPaths []string `json:"watch_paths,omitempty" jsonschema:"description=Globs relative to the repo root (e.g. 'services/api/**' or 'libs/shared/**'). Pushes that change no matching file skip the redeploy. Defaults to root_directory/** when root_directory is set."`
+
+	TagPattern string `json:"tag_pattern,omitempty" jsonschema:"description=Release mode: deploy only when a git tag matching this glob is pushed (e.g. 'v*' or 'release/*') and ignore branch pushes. The first deploy still uses the branch."`
+
+	SPAFallback  *bool            `json:"spa_fallback,omitempty" jsonschema:"description=Serve /index.html for unknown paths so client-side routing works on refresh. Set false to return 404s instead. Only used for static sites (build_pack=static or publish_directory),default=true"`
+	NotFoundPage string           `json:"not_found_page,omitempty" jsonschema:"description=Page served with 404 status for missing files (e.g. '/404.html'). Only used for static sites; usually combined with spa_fallback=false."`
+	Redirects    []StaticRedirect `json:"redirects,omitempty" jsonschema:"description=Redirect and rewrite rules for static sites, applied after any _redirects file in the site."`
+	Headers      []StaticHeader   `json:"headers,omitempty" jsonschema:"description=Response headers for static sites (e.g. Cache-Control or Content-Security-Policy), applied after any _headers file in the site."`
+}
+
+type StaticRedirect struct {
+	From   string `json:"from" jsonschema:"description=Request path; may end in /* to match everything below it (e.g. '/blog/*')"`
+	To     string `json:"to" jsonschema:"description=Target path or URL; ':splat' inserts what /* matched (e.g. '/news/:splat')"`
+	Status int    `json:"status,omitempty" jsonschema:"description=301 or 302 redirect, or 200 to serve the target without changing the URL,enum=301,enum=302,enum=200,default=301"`
+}
+
+type StaticHeader struct {
+	Path  string `json:"path" jsonschema:"description=Path the header applies to; * matches anything (e.g. '/assets/*' or '/*'). The first matching rule wins per header."`
+	Name  string `json:"name" jsonschema:"description=Header name (e.g. 'Cache-Control')"`
+	Value string `json:"value" jsonschema:"description=Header value"`
+}
+
+type PortInput struct {
+	Port     int    `json:"port" jsonschema:"description=Port the application listens on inside the container"`
+	Protocol string `json:"protocol,omitempty" jsonschema:"description=Transport protocol. HTTP is always served on the main port.,enum=tcp,enum=udp,default=tcp"`
 }
 
 type CreateServiceOutput struct {
@@ -50,6 +90,7 @@ type CreateServiceOutput struct {
 type RedeployServiceInput struct {
 	Name    string `json:"name" jsonschema:"description=Name of the service to redeploy (required)"`
 	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	Ref     string `json:"ref,omitempty" jsonschema:"description=Deploy this full commit SHA, tag (e.g. 'v1.4.0') or branch instead of the latest commit on the service's branch. Later pushes still deploy the branch as usual."`
 }
 
 type RedeployServiceOutput struct {
@@ -60,6 +101,25 @@ type RedeployServiceOutput struct {
 	Message    string `json:"message"`
 }
 
+type DeploySourceInput struct {
+	Name    string `json:"name" jsonschema:"description=Name of the service to deploy (required). It must deploy from an Ink repo."`
+	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	Message string `json:"message,omitempty" jsonschema:"description=Commit message for the upload,default=Upload source"`
+}
+
+type DeploySourceOutput struct {
+	ServiceID string `json:"service_id"`
+	Name      string `json:"name"`
+	Repo      string `json:"repo"`
+	Branch    string `json:"branch"`
+	UploadURL string `json:"upload_url"`
+	Method    string `json:"method"`
+	ExpiresAt string `json:"expires_at"`
+	MaxBytes  int    `json:"max_bytes"`
+	Command   string `json:"command"`
+	Message   string `json:"message"`
+}
+
 type ListServicesInput struct{}
 
 type ListServicesOutput struct {
@@ -148,20 +208,47 @@ type CustomDomainDetails struct {
 }
 
 type GetServiceOutput struct {
-	ServiceID    string               `json:"service_id"`
-	Name         string               `json:"name"`
-	Project      string               `json:"project"`
-	Repo         string               `json:"repo"`
-	Branch       string               `json:"branch"`
-	Status       string               `json:"status"`
-	ErrorMessage *string              `json:"error_message,omitempty"`
-	URL          *string              `json:"url,omitempty"`
-	CreatedAt    string               `json:"created_at"`
-	UpdatedAt    string               `json:"updated_at"`
-	DeployLogs   string               `json:"deploy_logs,omitempty"`
-	RuntimeLogs  string               `json:"runtime_logs,omitempty"`
-	EnvVars      []EnvVarInfo         `json:"env_vars,omitempty"`
-	CustomDomain *CustomDomainDetails `json:"custom_domain,omitempty"`
+	ServiceID        string               `json:"service_id"`
+	Name             string               `json:"name"`
+	Project          string               `json:"project"`
+	Repo             string               `json:"repo"`
+	Branch           string               `json:"branch"`
+	Image            string               `json:"image,omitempty"`
+	Status           string               `json:"status"`
+	ErrorMessage     *string              `json:"error_message,omitempty"`
+	URL              *string              `json:"url,omitempty"`
+	SleepAfterIdle   string               `json:"sleep_after_idle,omitempty"`
+	DeployPolicy     string               `json:"deploy_policy"`
+	DeployDebounce   string               `json:"deploy_debounce,omitempty"`
+	WatchPaths       []string             `json:"watch_paths,omitempty"`
+	TagPattern       string               `json:"tag_pattern,omitempty"`
+	GitTag           string               `json:"git_tag,omitempty"`
+	DockerfileTarget string               `json:"dockerfile_target,omitempty"`
+	BuildContext     string               `json:"build_context,omitempty"`
+	SPAFallback      *bool                `json:"spa_fallback,omitempty"`
+	NotFoundPage     string               `json:"not_found_page,omitempty"`
+	Redirects        []StaticRedirect     `json:"redirects,omitempty"`
+	Headers          []StaticHeader       `json:"headers,omitempty"`
+	CreatedAt        string               `json:"created_at"`
+	UpdatedAt        string               `json:"updated_at"`
+	DeployLogs       string               `json:"deploy_logs,omitempty"`
+	RuntimeLogs      string               `json:"runtime_logs,omitempty"`
+	EnvVars          []EnvVarInfo         `json:"env_vars,omitempty"`
+	BuildArgs        []EnvVarInfo         `json:"build_args,omitempty"`
+	CustomDomain     *CustomDomainDetails `json:"custom_domain,omitempty"`
+	Access           *AccessPolicyDetails `json:"access,omitempty"`
+	Ports            []PortInfo           `json:"ports,omitempty"`
+}
+
+type PortInfo struct {
+	Port          int    `json:"port"`
+	Protocol      string `json:"protocol"`
+	PublicAddress string `json:"public_address"`
+}
+
+type AccessPolicyDetails struct {
+	BasicAuthUsers []string `json:"basic_auth_users,omitempty"`
+	IPAllowList    []string `json:"ip_allowlist,omitempty"`
 }
 
 type EnvVarInfo struct {
@@ -182,22 +269,63 @@ type DeleteServiceOutput struct {
 	Message   string `json:"message"`
 }
 
+type ServiceLifecycleInput struct {
+	Name    string `json:"name" jsonschema:"description=Name of the service (required)"`
+	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+}
+
+type ServiceLifecycleOutput struct {
+	ServiceID string `json:"service_id"`
+	Name      string `json:"name"`
+	Action    string `json:"action"`
+	EventID   string `json:"event_id"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+}
+
 type UpdateServiceInput struct {
-	Name             string    `json:"name" jsonschema:"description=Name of the service to update (required)"`
-	Project          string    `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
-	Repo             *string   `json:"repo,omitempty" jsonschema:"description=New repository name"`
-	Host             *string   `json:"host,omitempty" jsonschema:"description=Git host for new repo,enum=ink,enum=github"`
-	Branch           *string   `json:"branch,omitempty" jsonschema:"description=Branch to deploy"`
-	Port             *int      `json:"port,omitempty" jsonschema:"description=Port the application listens on"`
-	EnvVars          *[]EnvVar `json:"env_vars,omitempty" jsonschema:"description=Environment variables (replaces all existing)"`
-	BuildPack        *string   `json:"build_pack,omitempty" jsonschema:"description=Build pack to use,enum=railpack,enum=dockerfile,enum=static,enum=dockercompose"`
-	Memory           *string   `json:"memory,omitempty" jsonschema:"description=Memory limit,enum=256Mi,enum=512Mi,enum=1024Mi,enum=2048Mi,enum=4096Mi"`
-	VCPUs            *string   `json:"vcpus,omitempty" jsonschema:"description=vCPUs,enum=0.5,enum=1,enum=2,enum=4"`
-	BuildCommand     *string   `json:"build_command,omitempty" jsonschema:"description=Custom build command (overrides auto-detected). Only used with build_pack=railpack."`
-	StartCommand     *string   `json:"start_command,omitempty" jsonschema:"description=Custom start command (overrides auto-detected). Only used with build_pack=railpack."`
-	PublishDirectory *string   `json:"publish_directory,omitempty" jsonschema:"description=Directory containing built static files (e.g. 'dist'). When set with build_pack=railpack the app is built then served as static files via nginx."`
-	RootDirectory    *string   `json:"root_directory,omitempty" jsonschema:"description=Subdirectory within the repo to use as build context (e.g. 'frontend' or 'services/api')."`
-	DockerfilePath   *string   `json:"dockerfile_path,omitempty" jsonschema:"description=Path to Dockerfile relative to root_directory. Only used with build_pack=dockerfile."`
+	Name             string             `json:"name" jsonschema:"description=Name of the service to update (required)"`
+	Project          string             `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	Repo             *string            `json:"repo,omitempty" jsonschema:"description=New repository name"`
+	Host             *string            `json:"host,omitempty" jsonschema:"description=Git host for new repo,enum=ink,enum=github"`
+	Branch           *string            `json:"branch,omitempty" jsonschema:"description=Branch to deploy"`
+	Port             *int               `json:"port,omitempty" jsonschema:"description=Port the application listens on"`
+	EnvVars          *[]EnvVar          `json:"env_vars,omitempty" jsonschema:"description=Environment variables (replaces all existing)"`
+	BuildPack        *string            `json:"build_pack,omitempty" jsonschema:"description=Build pack to use,enum=railpack,enum=dockerfile,enum=static,enum=dockercompose,enum=image"`
+	Memory           *string            `json:"memory,omitempty" jsonschema:"description=Memory limit,enum=256Mi,enum=512Mi,enum=1024Mi,enum=2048Mi,enum=4096Mi"`
+	VCPUs            *string            `json:"vcpus,omitempty" jsonschema:"description=vCPUs,enum=0.5,enum=1,enum=2,enum=4"`
+	BuildCommand     *string            `json:"build_command,omitempty" jsonschema:"description=Custom build command (overrides auto-detected). Only used with build_pack=railpack."`
+	StartCommand     *string            `json:"start_command,omitempty" jsonschema:"description=Custom start command (overrides auto-detected). Only used with build_pack=railpack."`
+	PublishDirectory *string            `json:"publish_directory,omitempty" jsonschema:"description=Directory containing built static files (e.g. 'dist'). When set with build_pack=railpack the app is built then served as static files via nginx."`
+	RootDirectory    *string            `json:"root_directory,omitempty" jsonschema:"description=Subdirectory within the repo to use as build context (e.g. 'frontend' or 'services/api')."`
+	DockerfilePath   *string            `json:"dockerfile_path,omitempty" jsonschema:"description=Path to Dockerfile relative to root_directory. Only used with build_pack=dockerfile."`
+	DockerfileTarget *string            `json:"dockerfile_target,omitempty" jsonschema:"description=Multi-stage build stage to stop at. Pass an empty string to build the last stage. Only used with build_pack=dockerfile."`
+	BuildArgs        *[]EnvVar          `json:"build_args,omitempty" jsonschema:"description=Dockerfile build args (replaces all existing). Pass an empty list to remove them. Only used with build_pack=dockerfile."`
+	BuildContext     *string            `json:"build_context,omitempty" jsonschema:"description=Build context relative to root_directory (e.g. '..'). Pass an empty string to use root_directory. Only used with build_pack=dockerfile."`
+	SPAFallback      *bool              `json:"spa_fallback,omitempty" jsonschema:"description=Serve /index.html for unknown paths (client-side routing). Only used for static sites."`
+	NotFoundPage     *string            `json:"not_found_page,omitempty" jsonschema:"description=Page served for missing files (e.g. '/404.html'). Pass an empty string to remove it. Only used for static sites."`
+	Redirects        *[]StaticRedirect  `json:"redirects,omitempty" jsonschema:"description=Static site redirect rules (replaces all existing). Pass an empty list to remove them."`
+	Headers          *[]StaticHeader    `json:"headers,omitempty" jsonschema:"description=Static site response headers (replaces all existing). Pass an empty list to remove them."`
+	Ports            *[]PortInput       `json:"ports,omitempty" jsonschema:"description=Additional TCP/UDP ports to expose (replaces all existing). Existing public ports are kept for ports that stay listed."`
+	Access           *AccessPolicyInput `json:"access,omitempty" jsonschema:"description=Access policy for the service's public URLs (replaces the existing policy). Pass an empty object to make the service public again."`
+	SleepAfterIdle   *string            `json:"sleep_after_idle,omitempty" jsonschema:"description=Scale the service to zero after this long without HTTP requests (e.g. '30m'; min 5m). Pass '0' to keep it always running."`
+	Image            *string            `json:"image,omitempty" jsonschema:"description=Prebuilt container image to deploy. Only used with build_pack=image."`
+	RegistryUsername *string            `json:"registry_username,omitempty" jsonschema:"description=Username for a private registry (set together with registry_password; empty strings remove the credentials)."`
+	RegistryPassword *string            `json:"registry_password,omitempty" jsonschema:"description=Password or access token for a private registry. Stored encrypted and never returned."`
+	DeployPolicy     *string            `json:"deploy_policy,omitempty" jsonschema:"description=What a new push does while a deploy is still running: cancel_previous, queue or debounce,enum=cancel_previous,enum=queue,enum=debounce"`
+	DeployDebounce   *string            `json:"deploy_debounce,omitempty" jsonschema:"description=Quiet period for deploy_policy=debounce (e.g. '45s'; max 10m)"`
+	WatchPaths       *[]string          `json:"watch_paths,omitempty" jsonschema:"description=Globs relative to the repo root that a push must touch to redeploy (replaces the existing list). Pass an empty list to go back to root_directory/**."`
+	TagPattern       *string            `json:"tag_pattern,omitempty" jsonschema:"description=Deploy only pushed tags matching this glob (e.g. 'v*'). Pass an empty string to track the branch again."`
+}
+
+type AccessPolicyInput struct {
+	BasicAuth   []BasicAuthCredential `json:"basic_auth,omitempty" jsonschema:"description=HTTP basic auth users. Requests must authenticate as one of them."`
+	IPAllowList []string              `json:"ip_allowlist,omitempty" jsonschema:"description=Allowed client IPs or CIDRs (e.g. '203.0.113.7' or '10.0.0.0/8'). Other clients get 403."`
+}
+
+type BasicAuthCredential struct {
+	Username string `json:"username" jsonschema:"description=Username (no colons or whitespace)"`
+	Password string `json:"password" jsonschema:"description=Password (min 8 characters). Stored hashed and never returned."`
 }
 
 type UpdateServiceOutput struct {
@@ -244,6 +372,190 @@ type GetGitTokenOutput struct {
 	ExpiresAt string `json:"expires_at"`
 }
 
+// Import and mirror (internal repos <-> GitHub)
+
+type ImportRepoInput struct {
+	GitHubRepo string `json:"github_repo" jsonschema:"description=GitHub repository to import (owner/name). The GitHub App must have access to it."`
+	Name       string `json:"name,omitempty" jsonschema:"description=Name of the new Ink repo. Defaults to the GitHub repo name"`
+	Project    string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+}
+
+type ImportRepoOutput struct {
+	Repo          string `json:"repo"`
+	GitRemote     string `json:"git_remote"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+	Branches      int    `json:"branches"`
+	Tags          int    `json:"tags"`
+	Message       string `json:"message"`
+}
+
+type MirrorRepoInput struct {
+	Repo       string `json:"repo" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp')"`
+	Project    string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	GitHubRepo string `json:"github_repo,omitempty" jsonschema:"description=GitHub repository to push to (owner/name). Empty stops mirroring"`
+}
+
+type MirrorRepoOutput struct {
+	Repo       string `json:"repo"`
+	GitHubRepo string `json:"github_repo,omitempty"`
+	MirroredAt string `json:"mirrored_at,omitempty"`
+	Message    string `json:"message"`
+}
+
+// Git token management (internal repos)
+
+type ListGitTokensInput struct{}
+
+type GitTokenInfo struct {
+	ID         string   `json:"id"`
+	Prefix     string   `json:"prefix"`
+	Repo       string   `json:"repo,omitempty"`
+	Scopes     []string `json:"scopes"`
+	ExpiresAt  string   `json:"expires_at,omitempty"`
+	LastUsedAt string   `json:"last_used_at,omitempty"`
+	CreatedAt  string   `json:"created_at"`
+}
+
+type ListGitTokensOutput struct {
+	Tokens []GitTokenInfo `json:"tokens"`
+}
+
+type CreateGitTokenInput struct {
+	Repo           string   `json:"repo" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp')"`
+	Project        string   `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	Scopes         []string `json:"scopes,omitempty" jsonschema:"description=Scopes: 'pull' (clone and fetch) and/or 'push'. Defaults to read-only ['pull']"`
+	ExpiresInHours int      `json:"expires_in_hours,omitempty" jsonschema:"description=Token lifetime in hours, up to 8760 (one year). Defaults to 1"`
+}
+
+type CreateGitTokenOutput struct {
+	ID        string   `json:"id"`
+	Repo      string   `json:"repo"`
+	Scopes    []string `json:"scopes"`
+	GitRemote string   `json:"git_remote"`
+	ExpiresAt string   `json:"expires_at"`
+	Message   string   `json:"message"`
+}
+
+type RevokeGitTokenInput struct {
+	ID string `json:"id" jsonschema:"description=Token ID from list_git_tokens"`
+}
+
+type RevokeGitTokenOutput struct {
+	ID      string `json:"id"`
+	Revoked bool   `json:"revoked"`
+}
+
+// SSH keys for git over SSH (internal repos)
+
+type ListSSHKeysInput struct{}
+
+type SSHKeyInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Fingerprint string `json:"fingerprint"`
+	LastUsedAt  string `json:"last_used_at,omitempty"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type ListSSHKeysOutput struct {
+	Keys []SSHKeyInfo `json:"keys"`
+}
+
+type AddSSHKeyInput struct {
+	Name      string `json:"name" jsonschema:"description=Name to recognize the key by (e.g. 'laptop')"`
+	PublicKey string `json:"public_key" jsonschema:"description=Public key in authorized_keys format (e.g. the contents of ~/.ssh/id_ed25519.pub)"`
+}
+
+type DeleteSSHKeyInput struct {
+	ID string `json:"id" jsonschema:"description=Key ID from list_ssh_keys"`
+}
+
+type DeleteSSHKeyOutput struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// Repo browsing (internal repos)
+
+type ListRepoRefsInput struct {
+	Repo    string `json:"repo" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp')"`
+	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+}
+
+type RepoRefInfo struct {
+	Name      string `json:"name"`
+	CommitSHA string `json:"commit_sha"`
+}
+
+type ListRepoRefsOutput struct {
+	Repo          string        `json:"repo"`
+	DefaultBranch string        `json:"default_branch,omitempty"`
+	Branches      []RepoRefInfo `json:"branches"`
+	Tags          []RepoRefInfo `json:"tags"`
+	Truncated     bool          `json:"truncated,omitempty"`
+}
+
+type GetRepoLogInput struct {
+	Repo    string `json:"repo" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp')"`
+	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	Ref     string `json:"ref,omitempty" jsonschema:"description=Branch, tag or commit SHA. Defaults to the default branch."`
+	Path    string `json:"path,omitempty" jsonschema:"description=Only commits touching this file or directory"`
+	Limit   int    `json:"limit,omitempty" jsonschema:"description=Number of commits (max 100),default=20"`
+}
+
+type RepoCommitInfo struct {
+	SHA        string `json:"sha"`
+	Author     string `json:"author"`
+	AuthoredAt string `json:"authored_at"`
+	Subject    string `json:"subject"`
+}
+
+type GetRepoLogOutput struct {
+	Repo    string           `json:"repo"`
+	Ref     string           `json:"ref"`
+	Commits []RepoCommitInfo `json:"commits"`
+}
+
+type ListRepoTreeInput struct {
+	Repo    string `json:"repo" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp')"`
+	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	Ref     string `json:"ref,omitempty" jsonschema:"description=Branch, tag or commit SHA. Defaults to the default branch."`
+	Path    string `json:"path,omitempty" jsonschema:"description=Directory to list. Defaults to the repo root."`
+}
+
+type RepoTreeEntryInfo struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size *int64 `json:"size,omitempty"`
+}
+
+type ListRepoTreeOutput struct {
+	Repo      string              `json:"repo"`
+	Ref       string              `json:"ref"`
+	CommitSHA string              `json:"commit_sha"`
+	Path      string              `json:"path"`
+	Entries   []RepoTreeEntryInfo `json:"entries"`
+	Truncated bool                `json:"truncated,omitempty"`
+}
+
+type ReadRepoFileInput struct {
+	Repo    string `json:"repo" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp')"`
+	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	Ref     string `json:"ref,omitempty" jsonschema:"description=Branch, tag or commit SHA. Defaults to the default branch."`
+	Path    string `json:"path" jsonschema:"description=File path relative to the repo root (required)"`
+}
+
+type ReadRepoFileOutput struct {
+	Repo      string `json:"repo"`
+	Ref       string `json:"ref"`
+	CommitSHA string `json:"commit_sha"`
+	Path      string `json:"path"`
+	Size      int64  `json:"size"`
+	Binary    bool   `json:"binary"`
+	Content   string `json:"content,omitempty"`
+}
+
 // Custom domain (backed by hosted zones)
 
 type AddCustomDomainInput struct {
@@ -335,3 +647,153 @@ type ListDnsRecordsInput struct {
 type ListDnsRecordsOutput struct {
 	Records []DnsRecordInfo `json:"records"`
 }
+
+type AddRouteInput struct {
+	Host        string `json:"host" jsonschema:"description=Hostname to route: the default domain of a service in the project (e.g. 'web.ml.ink') or a custom domain already attached to one"`
+	Path        string `json:"path,omitempty" jsonschema:"description=Path prefix to match (e.g. '/api'),default=/"`
+	Service     string `json:"service" jsonschema:"description=Name of the service that receives matching requests"`
+	StripPrefix bool   `json:"strip_prefix,omitempty" jsonschema:"description=Remove the path prefix before forwarding (e.g. '/api/users' arrives as '/users')"`
+	Project     string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+}
+
+type RouteInfo struct {
+	RouteID     string `json:"route_id"`
+	Host        string `json:"host"`
+	Path        string `json:"path"`
+	Service     string `json:"service"`
+	StripPrefix bool   `json:"strip_prefix"`
+	URL         string `json:"url"`
+}
+
+type AddRouteOutput struct {
+	Route   RouteInfo `json:"route"`
+	Message string    `json:"message"`
+}
+
+type ListRoutesInput struct {
+	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+}
+
+type ListRoutesOutput struct {
+	Routes []RouteInfo `json:"routes"`
+}
+
+type RemoveRouteInput struct {
+	Host    string `json:"host" jsonschema:"description=Hostname of the route"`
+	Path    string `json:"path,omitempty" jsonschema:"description=Path prefix of the route,default=/"`
+	Project string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+}
+
+type RemoveRouteOutput struct {
+	Message string `json:"message"`
+}
+
+type ProjectServiceSpec struct {
+	Name             string   `json:"name" jsonschema:"description=Service name (required)"`
+	Repo             string   `json:"repo,omitempty" jsonschema:"description=Repository as returned by create_repo (e.g. 'ink/myapp'). Required unless build_pack=image."`
+	Host             string   `json:"host,omitempty" jsonschema:"description=Git host,enum=ink,enum=github,default=ink"`
+	Branch           string   `json:"branch,omitempty" jsonschema:"description=Branch to deploy,default=main"`
+	BuildPack        string   `json:"build_pack,omitempty" jsonschema:"description=Build pack, as for create_service,enum=railpack,enum=dockerfile,enum=static,enum=dockercompose,enum=image,default=railpack"`
+	Image            string   `json:"image,omitempty" jsonschema:"description=Prebuilt container image. Required with build_pack=image."`
+	Port             *int     `json:"port,omitempty" jsonschema:"description=Port the application listens on"`
+	Memory           string   `json:"memory,omitempty" jsonschema:"description=Memory limit,enum=256Mi,enum=512Mi,enum=1024Mi,enum=2048Mi,enum=4096Mi,default=256Mi"`
+	VCPUs            string   `json:"vcpus,omitempty" jsonschema:"description=vCPUs,enum=0.5,enum=1,enum=2,enum=4,default=0.5"`
+	BuildCommand     string   `json:"build_command,omitempty" jsonschema:"description=Custom build command"`
+	StartCommand     string   `json:"start_command,omitempty" jsonschema:"description=Custom start command (e.g. the worker entrypoint)"`
+	RootDirectory    string   `json:"root_directory,omitempty" jsonschema:"description=Subdirectory within the repo to build"`
+	PublishDirectory string   `json:"publish_directory,omitempty" jsonschema:"description=Directory with built static files. Only used with build_pack=railpack."`
+	DockerfilePath   string   `json:"dockerfile_path,omitempty" jsonschema:"description=Dockerfile path relative to root_directory. Only used with build_pack=dockerfile."`
+	Region           string   `json:"region,omitempty" jsonschema:"description=Cluster region; only used when the service is created,enum=eu-central-1,default=eu-central-1"`
+	EnvVars          []EnvVar `json:"env_vars,omitempty" jsonschema:"description=Environment variables. When given they replace the service's env vars; omit to keep them. Link variables are added on top."`
+}
+
+type ProjectResourceSpec struct {
+	Name   string `json:"name" jsonschema:"description=Resource name (required)"`
+	Type   string `json:"type,omitempty" jsonschema:"description=Resource type,enum=sqlite,default=sqlite"`
+	Size   string `json:"size,omitempty" jsonschema:"description=Size limit for databases,default=100mb"`
+	Region string `json:"region,omitempty" jsonschema:"description=Region,enum=eu-central,default=eu-central"`
+}
+
+type ProjectLinkSpec struct {
+	Service   string `json:"service" jsonschema:"description=Service that uses the resource"`
+	Resource  string `json:"resource" jsonschema:"description=Resource the service connects to"`
+	EnvPrefix string `json:"env_prefix,omitempty" jsonschema:"description=The service gets <env_prefix>_URL and <env_prefix>_AUTH_TOKEN,default=DATABASE"`
+}
+
+type ProjectDomainSpec struct {
+	Service string `json:"service" jsonschema:"description=Service the domain points at"`
+	Domain  string `json:"domain" jsonschema:"description=Custom domain in a delegated zone (e.g. 'app.example.com')"`
+}
+
+type PlanProjectInput struct {
+	Project   string                `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+	Services  []ProjectServiceSpec  `json:"services,omitempty" jsonschema:"description=Services the project should contain"`
+	Resources []ProjectResourceSpec `json:"resources,omitempty" jsonschema:"description=Databases the project should contain"`
+	Links     []ProjectLinkSpec     `json:"links,omitempty" jsonschema:"description=Resource credentials to inject into services as env vars"`
+	Domains   []ProjectDomainSpec   `json:"domains,omitempty" jsonschema:"description=Custom domains, at most one per service"`
+	Prune     bool                  `json:"prune,omitempty" jsonschema:"description=Also delete services, resources and domains in the project that the spec leaves out,default=false"`
+}
+
+type ProjectChange struct {
+	Action         string   `json:"action"`
+	Kind           string   `json:"kind"`
+	Name           string   `json:"name"`
+	Fields         []string `json:"fields,omitempty"`
+	Domain         string   `json:"domain,omitempty"`
+	PreviousDomain string   `json:"previous_domain,omitempty"`
+}
+
+type PlanProjectOutput struct {
+	Project  string          `json:"project"`
+	PlanHash string          `json:"plan_hash"`
+	Changes  []ProjectChange `json:"changes"`
+	Message  string          `json:"message"`
+}
+
+type ApplyProjectInput struct {
+	PlanProjectInput
+	PlanHash string `json:"plan_hash,omitempty" jsonschema:"description=plan_hash from plan_project. When set nothing is applied if the plan has changed since."`
+}
+
+type ApplyProjectOutput struct {
+	Project  string   `json:"project"`
+	PlanHash string   `json:"plan_hash"`
+	Applied  []string `json:"applied"`
+	Message  string   `json:"message"`
+}
+
+// Templates
+
+type ListTemplatesInput struct{}
+
+type TemplateInfo struct {
+	Name        string   `json:"name"`
+	Version     int      `json:"version"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Services    []string `json:"services"`
+	Resources   []string `json:"resources,omitempty"`
+}
+
+type ListTemplatesOutput struct {
+	Templates []TemplateInfo `json:"templates"`
+}
+
+type CreateFromTemplateInput struct {
+	Template string `json:"template" jsonschema:"description=Template name from list_templates (required)"`
+	Name     string `json:"name" jsonschema:"description=Name for the new app (required). Used for the repo and service; resources are named <name>-<resource>."`
+	Project  string `json:"project,omitempty" jsonschema:"description=Project name,default=default"`
+}
+
+type CreateFromTemplateOutput struct {
+	Template  string   `json:"template"`
+	Version   int      `json:"version"`
+	Project   string   `json:"project"`
+	Repo      string   `json:"repo"`
+	GitRemote string   `json:"git_remote"`
+	ExpiresAt string   `json:"expires_at"`
+	Services  []string `json:"services"`
+	Resources []string `json:"resources,omitempty"`
+	URL       *string  `json:"url,omitempty"`
+	Message   string   `json:"message"`
+}
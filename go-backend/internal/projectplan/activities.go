@@ -0,0 +1,317 @@
+package projectplan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/augustdev/autoclip/internal/dns"
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/augustdev/autoclip/internal/resources"
+	"github.com/jackc/pgx/v5"
+	"go.temporal.io/sdk/temporal"
+)
+
+type Activities struct {
+	deployService    *deployments.Service
+	resourcesService *resources.Service
+	dnsService       *dns.Service
+	logger           *slog.Logger
+}
+
+func NewActivities(deployService *deployments.Service, resourcesService *resources.Service, dnsService *dns.Service, logger *slog.Logger) *Activities {
+	return &Activities{
+		deployService:    deployService,
+		resourcesService: resourcesService,
+		dnsService:       dnsService,
+		logger:           logger,
+	}
+}
+
+// ApplyStep runs one plan step. Creates are skipped when the object already
+// exists so a retried attempt doesn't fail on its own earlier success.
+func (a *Activities) ApplyStep(ctx context.Context, input StepInput) error {
+	step := input.Step
+	a.logger.Info("Applying project step", "userID", input.UserID, "project", input.Project, "step", step.Summary())
+
+	var err error
+	switch step.Kind {
+	case KindResource:
+		err = a.applyResource(ctx, input)
+	case KindService:
+		err = a.applyService(ctx, input)
+	case KindDomain:
+		err = a.applyDomain(ctx, input)
+	default:
+		err = temporal.NewNonRetryableApplicationError(fmt.Sprintf("unknown step kind %q", step.Kind), "invalid_step", nil)
+	}
+	if err != nil {
+		a.logger.Error("Project step failed", "step", step.Summary(), "error", err)
+	}
+	return err
+}
+
+func (a *Activities) applyResource(ctx context.Context, input StepInput) error {
+	step := input.Step
+	switch step.Action {
+	case ActionCreate:
+		_, err := a.resourcesService.GetResourceByName(ctx, input.UserID, step.Name)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to look up resource %s: %w", step.Name, err)
+		}
+		project, err := a.deployService.EnsureProject(ctx, input.UserID, input.Project)
+		if err != nil {
+			return err
+		}
+		r := step.Resource
+		typ, size, region := r.Type, r.Size, r.Region
+		if typ == "" {
+			typ = resources.TypeSQLite
+		}
+		if region == "" {
+			region = resources.DefaultRegion
+		}
+		_, err = a.resourcesService.ProvisionDatabase(ctx, resources.ProvisionDatabaseInput{
+			UserID:    input.UserID,
+			ProjectID: &project.ID,
+			Name:      r.Name,
+			Type:      typ,
+			Size:      size,
+			Region:    region,
+		})
+		return err
+	case ActionDelete:
+		id := step.ResourceID
+		if id == "" {
+			r, err := a.resourcesService.GetResourceByName(ctx, input.UserID, step.Name)
+			if errors.Is(err, pgx.ErrNoRows) {
+				// Already deleted by an earlier attempt
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to look up resource %s: %w", step.Name, err)
+			}
+			id = r.ID
+		}
+		return a.resourcesService.DeleteResource(ctx, input.UserID, id)
+	}
+	return temporal.NewNonRetryableApplicationError(fmt.Sprintf("cannot %s a resource", step.Action), "invalid_step", nil)
+}
+
+func (a *Activities) applyService(ctx context.Context, input StepInput) error {
+	step := input.Step
+	switch step.Action {
+	case ActionCreate:
+		if _, err := a.deployService.GetServiceByName(ctx, deployments.GetServiceByNameParams{
+			Name:    step.Name,
+			Project: input.Project,
+			UserID:  input.UserID,
+		}); err == nil {
+			return nil
+		}
+		svc := step.Service
+		envVars, err := a.serviceEnv(ctx, input.UserID, *svc, nil)
+		if err != nil {
+			return err
+		}
+		port := svc.Port
+		if port == "" {
+			port = svc.DefaultPort
+		}
+		buildPack, branch := svc.BuildPack, svc.Branch
+		if buildPack == "" {
+			buildPack = "railpack"
+		}
+		if branch == "" && buildPack != k8sdeployments.BuildPackImage {
+			branch = "main"
+		}
+		_, err = a.deployService.CreateService(ctx, deployments.CreateServiceInput{
+			UserID:           input.UserID,
+			ProjectRef:       input.Project,
+			Repo:             svc.Repo,
+			Branch:           branch,
+			Name:             svc.Name,
+			BuildPack:        buildPack,
+			Port:             port,
+			EnvVars:          envVars,
+			GitProvider:      svc.GitProvider,
+			Memory:           svc.Memory,
+			VCPUs:            svc.VCPUs,
+			BuildCommand:     svc.BuildCommand,
+			StartCommand:     svc.StartCommand,
+			InstallationID:   svc.InstallationID,
+			PublishDirectory: svc.PublishDirectory,
+			RootDirectory:    svc.RootDirectory,
+			DockerfilePath:   svc.DockerfilePath,
+			Region:           svc.Region,
+			Image:            svc.Image,
+		})
+		return err
+	case ActionUpdate:
+		update, err := a.serviceUpdate(ctx, input)
+		if err != nil {
+			return err
+		}
+		_, err = a.deployService.UpdateService(ctx, *update)
+		return err
+	case ActionDelete:
+		if _, err := a.deployService.GetServiceByName(ctx, deployments.GetServiceByNameParams{
+			Name:    step.Name,
+			Project: input.Project,
+			UserID:  input.UserID,
+		}); err != nil {
+			return nil
+		}
+		_, err := a.deployService.DeleteService(ctx, deployments.DeleteServiceParams{
+			Name:    step.Name,
+			Project: input.Project,
+			UserID:  input.UserID,
+		})
+		return err
+	}
+	return temporal.NewNonRetryableApplicationError(fmt.Sprintf("cannot %s a service", step.Action), "invalid_step", nil)
+}
+
+// serviceUpdate sets only the fields the step changes.
+func (a *Activities) serviceUpdate(ctx context.Context, input StepInput) (*deployments.UpdateServiceInput, error) {
+	step := input.Step
+	svc := step.Service
+	update := &deployments.UpdateServiceInput{
+		Name:    step.Name,
+		Project: input.Project,
+		UserID:  input.UserID,
+	}
+	for _, f := range step.Fields {
+		switch f {
+		case "repo":
+			update.Repo, update.GitProvider = &svc.Repo, &svc.GitProvider
+		case "branch":
+			update.Branch = &svc.Branch
+		case "build_pack":
+			update.BuildPack = &svc.BuildPack
+		case "image":
+			update.Image = &svc.Image
+		case "port":
+			update.Port = &svc.Port
+		case "memory":
+			update.Memory = &svc.Memory
+		case "vcpus":
+			update.VCPUs = &svc.VCPUs
+		case "build_command":
+			update.BuildCommand = &svc.BuildCommand
+		case "start_command":
+			update.StartCommand = &svc.StartCommand
+		case "root_directory":
+			update.RootDirectory = &svc.RootDirectory
+		case "publish_directory":
+			update.PublishDirectory = &svc.PublishDirectory
+		case "dockerfile_path":
+			update.DockerfilePath = &svc.DockerfilePath
+		case "env_vars":
+			var current []deployments.EnvVar
+			if step.Previous != nil {
+				current = step.Previous.EnvVars
+			}
+			envVars, err := a.serviceEnv(ctx, input.UserID, *svc, current)
+			if err != nil {
+				return nil, err
+			}
+			update.EnvVars = &envVars
+		}
+	}
+	return update, nil
+}
+
+// serviceEnv returns the env vars svc should run with: its declared env
+// vars, or current when it declares none, plus its links' credentials.
+func (a *Activities) serviceEnv(ctx context.Context, userID string, svc ServiceSpec, current []deployments.EnvVar) ([]deployments.EnvVar, error) {
+	base := current
+	if svc.SetEnv {
+		base = svc.EnvVars
+	}
+	linkKeys := map[string]bool{}
+	for _, l := range svc.Links {
+		u, t := linkEnvKeys(l)
+		linkKeys[u], linkKeys[t] = true, true
+	}
+
+	out := make([]deployments.EnvVar, 0, len(base)+len(linkKeys))
+	for _, ev := range base {
+		if !linkKeys[ev.Key] {
+			out = append(out, ev)
+		}
+	}
+	for _, l := range svc.Links {
+		r, err := a.resourcesService.GetResourceByName(ctx, userID, l.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("link %s → %s: %w", l.Service, l.Resource, err)
+		}
+		if r.Credentials == nil {
+			return nil, fmt.Errorf("link %s → %s: resource has no credentials", l.Service, l.Resource)
+		}
+		u, t := linkEnvKeys(l)
+		out = append(out,
+			deployments.EnvVar{Key: u, Value: r.Credentials.URL},
+			deployments.EnvVar{Key: t, Value: r.Credentials.AuthToken},
+		)
+	}
+	return out, nil
+}
+
+func (a *Activities) applyDomain(ctx context.Context, input StepInput) error {
+	step := input.Step
+	current, err := a.currentDomain(ctx, input)
+	if err != nil {
+		return err
+	}
+	if current != "" && (step.Action == ActionDelete || current != dns.NormalizeDomain(step.Domain)) {
+		if _, err := a.dnsService.RemoveCustomDomain(ctx, dns.RemoveCustomDomainParams{
+			Name:    step.Name,
+			Project: input.Project,
+			UserID:  input.UserID,
+		}); err != nil {
+			return err
+		}
+		current = ""
+	}
+	if step.Action == ActionDelete || current != "" {
+		return nil
+	}
+	_, err = a.dnsService.AddCustomDomain(ctx, dns.AddCustomDomainParams{
+		Name:    step.Name,
+		Project: input.Project,
+		UserID:  input.UserID,
+		Domain:  step.Domain,
+	})
+	return err
+}
+
+// currentDomain returns the service's custom domain, or "" if it has none.
+// Domain steps compare against it so a retried step picks up where the
+// previous attempt stopped.
+func (a *Activities) currentDomain(ctx context.Context, input StepInput) (string, error) {
+	svc, err := a.deployService.GetServiceByName(ctx, deployments.GetServiceByNameParams{
+		Name:    input.Step.Name,
+		Project: input.Project,
+		UserID:  input.UserID,
+	})
+	if err != nil {
+		return "", err
+	}
+	rec, hz, err := a.dnsService.GetCustomDomainForService(ctx, svc.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get custom domain of %s: %w", input.Step.Name, err)
+	}
+	if rec.Name == "@" {
+		return hz.Zone, nil
+	}
+	return rec.Name + "." + hz.Zone, nil
+}
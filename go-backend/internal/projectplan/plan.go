@@ -0,0 +1,295 @@
+package projectplan
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/augustdev/autoclip/internal/deployments"
+)
+
+var envPrefixRe = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+
+// Validate checks that names are unique and that links and domains point
+// at services and resources declared in the spec.
+func Validate(spec Spec) error {
+	svcs := map[string]bool{}
+	for _, s := range spec.Services {
+		if s.Name == "" {
+			return fmt.Errorf("every service needs a name")
+		}
+		if svcs[s.Name] {
+			return fmt.Errorf("service %q is declared twice", s.Name)
+		}
+		svcs[s.Name] = true
+	}
+	res := map[string]bool{}
+	for _, r := range spec.Resources {
+		if r.Name == "" {
+			return fmt.Errorf("every resource needs a name")
+		}
+		if res[r.Name] {
+			return fmt.Errorf("resource %q is declared twice", r.Name)
+		}
+		if r.Type != "" && r.Type != "sqlite" {
+			return fmt.Errorf("resource %q: only type 'sqlite' is supported", r.Name)
+		}
+		res[r.Name] = true
+	}
+
+	linked := map[string]bool{}
+	for _, l := range spec.Links {
+		if !svcs[l.Service] {
+			return fmt.Errorf("link to %q: service %q is not in the spec", l.Resource, l.Service)
+		}
+		if !res[l.Resource] {
+			return fmt.Errorf("link from %q: resource %q is not in the spec", l.Service, l.Resource)
+		}
+		prefix := linkEnvPrefix(l)
+		if !envPrefixRe.MatchString(prefix) {
+			return fmt.Errorf("link %s → %s: env_prefix %q must be uppercase letters, digits and _", l.Service, l.Resource, l.EnvPrefix)
+		}
+		key := l.Service + "/" + prefix
+		if linked[key] {
+			return fmt.Errorf("service %q has two links with env_prefix %q", l.Service, prefix)
+		}
+		linked[key] = true
+	}
+
+	domains := map[string]bool{}
+	for _, d := range spec.Domains {
+		if !svcs[d.Service] {
+			return fmt.Errorf("domain %q: service %q is not in the spec", d.Domain, d.Service)
+		}
+		if d.Domain == "" {
+			return fmt.Errorf("domain for service %q is empty", d.Service)
+		}
+		if domains[d.Service] {
+			return fmt.Errorf("service %q can only have one custom domain", d.Service)
+		}
+		domains[d.Service] = true
+	}
+	return nil
+}
+
+func linkEnvPrefix(l LinkSpec) string {
+	if l.EnvPrefix == "" {
+		return DefaultLinkEnvPrefix
+	}
+	return l.EnvPrefix
+}
+
+func linkEnvKeys(l LinkSpec) (urlKey, tokenKey string) {
+	prefix := linkEnvPrefix(l)
+	return prefix + "_URL", prefix + "_AUTH_TOKEN"
+}
+
+// Diff computes the steps that turn state into spec. spec must have passed
+// Validate.
+func Diff(spec Spec, state State) []Step {
+	current := map[string]ServiceState{}
+	for _, s := range state.Services {
+		current[s.Spec.Name] = s
+	}
+	currentRes := map[string]ResourceState{}
+	for _, r := range state.Resources {
+		currentRes[r.Spec.Name] = r
+	}
+	links := map[string][]LinkSpec{}
+	for _, l := range spec.Links {
+		links[l.Service] = append(links[l.Service], l)
+	}
+	domains := map[string]string{}
+	for _, d := range spec.Domains {
+		domains[d.Service] = strings.ToLower(strings.TrimSuffix(d.Domain, "."))
+	}
+
+	var steps []Step
+
+	for _, r := range spec.Resources {
+		if _, ok := currentRes[r.Name]; !ok {
+			r := r
+			steps = append(steps, Step{Action: ActionCreate, Kind: KindResource, Name: r.Name, Resource: &r})
+		}
+	}
+
+	for _, s := range spec.Services {
+		s := s
+		s.Links = links[s.Name]
+		cur, ok := current[s.Name]
+		if !ok {
+			steps = append(steps, Step{Action: ActionCreate, Kind: KindService, Name: s.Name, Service: &s})
+			continue
+		}
+		fields := serviceChanges(s, cur.Spec)
+		if len(fields) == 0 {
+			continue
+		}
+		prev := cur.Spec
+		steps = append(steps, Step{Action: ActionUpdate, Kind: KindService, Name: s.Name, Fields: fields, Service: &s, Previous: &prev})
+	}
+
+	for _, s := range spec.Services {
+		want, cur := domains[s.Name], current[s.Name].Domain
+		switch {
+		case want == cur:
+		case cur == "":
+			steps = append(steps, Step{Action: ActionCreate, Kind: KindDomain, Name: s.Name, Domain: want})
+		case want != "":
+			steps = append(steps, Step{Action: ActionUpdate, Kind: KindDomain, Name: s.Name, Domain: want, PreviousDomain: cur})
+		case spec.Prune:
+			steps = append(steps, Step{Action: ActionDelete, Kind: KindDomain, Name: s.Name, Domain: cur})
+		}
+	}
+
+	if !spec.Prune {
+		return steps
+	}
+
+	declared := map[string]bool{}
+	for _, s := range spec.Services {
+		declared[s.Name] = true
+	}
+	var removed []ServiceState
+	for _, s := range state.Services {
+		if !declared[s.Spec.Name] {
+			removed = append(removed, s)
+		}
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i].Spec.Name < removed[j].Spec.Name })
+	for _, s := range removed {
+		if s.Domain != "" {
+			steps = append(steps, Step{Action: ActionDelete, Kind: KindDomain, Name: s.Spec.Name, Domain: s.Domain})
+		}
+		steps = append(steps, Step{Action: ActionDelete, Kind: KindService, Name: s.Spec.Name})
+	}
+
+	declaredRes := map[string]bool{}
+	for _, r := range spec.Resources {
+		declaredRes[r.Name] = true
+	}
+	var removedRes []ResourceState
+	for _, r := range state.Resources {
+		if !declaredRes[r.Spec.Name] {
+			removedRes = append(removedRes, r)
+		}
+	}
+	sort.Slice(removedRes, func(i, j int) bool { return removedRes[i].Spec.Name < removedRes[j].Spec.Name })
+	for _, r := range removedRes {
+		steps = append(steps, Step{Action: ActionDelete, Kind: KindResource, Name: r.Spec.Name, ResourceID: r.ID})
+	}
+	return steps
+}
+
+// serviceChanges lists the fields want sets to something other than cur.
+// Region can't change after create and is ignored.
+func serviceChanges(want, cur ServiceSpec) []string {
+	var fields []string
+	diff := func(name, w, c string) {
+		if w != "" && w != c {
+			fields = append(fields, name)
+		}
+	}
+	if want.Repo != "" && (want.Repo != cur.Repo || want.GitProvider != cur.GitProvider) {
+		fields = append(fields, "repo")
+	}
+	diff("branch", want.Branch, cur.Branch)
+	diff("build_pack", want.BuildPack, cur.BuildPack)
+	diff("image", want.Image, cur.Image)
+	diff("port", want.Port, cur.Port)
+	diff("memory", want.Memory, cur.Memory)
+	diff("vcpus", want.VCPUs, cur.VCPUs)
+	diff("build_command", want.BuildCommand, cur.BuildCommand)
+	diff("start_command", want.StartCommand, cur.StartCommand)
+	diff("root_directory", want.RootDirectory, cur.RootDirectory)
+	diff("publish_directory", want.PublishDirectory, cur.PublishDirectory)
+	diff("dockerfile_path", want.DockerfilePath, cur.DockerfilePath)
+	if envChanged(want, cur.EnvVars) {
+		fields = append(fields, "env_vars")
+	}
+	return fields
+}
+
+// envChanged reports whether want's env vars differ from cur, ignoring the
+// values of link variables: those are only known at apply time.
+func envChanged(want ServiceSpec, cur []deployments.EnvVar) bool {
+	linkKeys := map[string]bool{}
+	for _, l := range want.Links {
+		u, t := linkEnvKeys(l)
+		linkKeys[u], linkKeys[t] = true, true
+	}
+	have := map[string]string{}
+	for _, ev := range cur {
+		have[ev.Key] = ev.Value
+	}
+	for k := range linkKeys {
+		if _, ok := have[k]; !ok {
+			return true
+		}
+	}
+	if !want.SetEnv {
+		return false
+	}
+
+	wantEnv := map[string]string{}
+	for _, ev := range want.EnvVars {
+		if !linkKeys[ev.Key] {
+			wantEnv[ev.Key] = ev.Value
+		}
+	}
+	for k := range linkKeys {
+		delete(have, k)
+	}
+	if len(wantEnv) != len(have) {
+		return true
+	}
+	for k, v := range wantEnv {
+		if cv, ok := have[k]; !ok || cv != v {
+			return true
+		}
+	}
+	return false
+}
+
+// Inverse returns the step that undoes s. Deletes can't be undone.
+func Inverse(s Step) (Step, bool) {
+	switch {
+	case s.Action == ActionCreate && s.Kind == KindDomain:
+		return Step{Action: ActionDelete, Kind: KindDomain, Name: s.Name, Domain: s.Domain}, true
+	case s.Action == ActionUpdate && s.Kind == KindDomain:
+		return Step{Action: ActionUpdate, Kind: KindDomain, Name: s.Name, Domain: s.PreviousDomain, PreviousDomain: s.Domain}, true
+	case s.Action == ActionDelete && s.Kind == KindDomain:
+		return Step{Action: ActionCreate, Kind: KindDomain, Name: s.Name, Domain: s.Domain}, true
+	case s.Action == ActionCreate:
+		return Step{Action: ActionDelete, Kind: s.Kind, Name: s.Name}, true
+	case s.Action == ActionUpdate && s.Kind == KindService:
+		prev := *s.Previous
+		prev.SetEnv = true
+		return Step{Action: ActionUpdate, Kind: KindService, Name: s.Name, Fields: s.Fields, Service: &prev, Previous: s.Service}, true
+	}
+	return Step{}, false
+}
+
+// Summary describes s in one line without env var values.
+func (s Step) Summary() string {
+	out := fmt.Sprintf("%s %s %s", s.Action, s.Kind, s.Name)
+	switch {
+	case s.Kind == KindDomain && s.PreviousDomain != "":
+		out += fmt.Sprintf(": %s → %s", s.PreviousDomain, s.Domain)
+	case s.Kind == KindDomain:
+		out += ": " + s.Domain
+	case len(s.Fields) > 0:
+		out += " (" + strings.Join(s.Fields, ", ") + ")"
+	}
+	return out
+}
+
+func planHash(steps []Step) string {
+	data, _ := json.Marshal(steps)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,212 @@
+package projectplan
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/deployments"
+)
+
+func summaries(steps []Step) []string {
+	out := make([]string, len(steps))
+	for i, s := range steps {
+		out[i] = s.Summary()
+	}
+	return out
+}
+
+func TestValidate(t *testing.T) {
+	base := func() Spec {
+		return Spec{
+			Services:  []ServiceSpec{{Name: "web"}, {Name: "worker"}},
+			Resources: []ResourceSpec{{Name: "db"}},
+		}
+	}
+	tests := []struct {
+		name    string
+		mutate  func(*Spec)
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(s *Spec) {
+			s.Links = []LinkSpec{{Service: "web", Resource: "db"}, {Service: "worker", Resource: "db", EnvPrefix: "QUEUE_DB"}}
+			s.Domains = []DomainSpec{{Service: "web", Domain: "app.example.com"}}
+		}},
+		{name: "duplicate service", mutate: func(s *Spec) { s.Services = append(s.Services, ServiceSpec{Name: "web"}) }, wantErr: true},
+		{name: "duplicate resource", mutate: func(s *Spec) { s.Resources = append(s.Resources, ResourceSpec{Name: "db"}) }, wantErr: true},
+		{name: "unsupported resource type", mutate: func(s *Spec) { s.Resources[0].Type = "postgres" }, wantErr: true},
+		{name: "link to unknown resource", mutate: func(s *Spec) { s.Links = []LinkSpec{{Service: "web", Resource: "cache"}} }, wantErr: true},
+		{name: "link from unknown service", mutate: func(s *Spec) { s.Links = []LinkSpec{{Service: "api", Resource: "db"}} }, wantErr: true},
+		{name: "lowercase env prefix", mutate: func(s *Spec) { s.Links = []LinkSpec{{Service: "web", Resource: "db", EnvPrefix: "db"}} }, wantErr: true},
+		{name: "same prefix twice", mutate: func(s *Spec) {
+			s.Resources = append(s.Resources, ResourceSpec{Name: "db2"})
+			s.Links = []LinkSpec{{Service: "web", Resource: "db"}, {Service: "web", Resource: "db2"}}
+		}, wantErr: true},
+		{name: "two domains", mutate: func(s *Spec) {
+			s.Domains = []DomainSpec{{Service: "web", Domain: "a.example.com"}, {Service: "web", Domain: "b.example.com"}}
+		}, wantErr: true},
+		{name: "domain for unknown service", mutate: func(s *Spec) { s.Domains = []DomainSpec{{Service: "api", Domain: "a.example.com"}} }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := base()
+			tt.mutate(&spec)
+			if err := Validate(spec); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiff_NewProjectInDependencyOrder(t *testing.T) {
+	spec := Spec{
+		Services:  []ServiceSpec{{Name: "web", Repo: "u/web"}, {Name: "worker", Repo: "u/web", StartCommand: "node worker.js"}},
+		Resources: []ResourceSpec{{Name: "db"}},
+		Links:     []LinkSpec{{Service: "worker", Resource: "db"}},
+		Domains:   []DomainSpec{{Service: "web", Domain: "App.Example.com."}},
+	}
+	steps := Diff(spec, State{})
+
+	want := []string{
+		"create resource db",
+		"create service web",
+		"create service worker",
+		"create domain web: app.example.com",
+	}
+	if got := summaries(steps); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Diff() = %v, want %v", got, want)
+	}
+	if links := steps[2].Service.Links; len(links) != 1 || links[0].Resource != "db" {
+		t.Errorf("worker links = %+v, want the db link", links)
+	}
+}
+
+func TestDiff_UpdatesOnlyChangedFields(t *testing.T) {
+	current := ServiceSpec{
+		Name: "web", Repo: "u/web", GitProvider: "internal", Branch: "main", BuildPack: "railpack",
+		Port: "3000", Memory: "256Mi", VCPUs: "0.5",
+		EnvVars: []deployments.EnvVar{{Key: "A", Value: "1"}, {Key: "DATABASE_URL", Value: "libsql://x"}, {Key: "DATABASE_AUTH_TOKEN", Value: "t"}},
+	}
+	state := State{
+		Services:  []ServiceState{{Spec: current, Domain: "old.example.com"}},
+		Resources: []ResourceState{{ID: "r1", Spec: ResourceSpec{Name: "db"}}},
+	}
+
+	tests := []struct {
+		name string
+		svc  ServiceSpec
+		want []string
+	}{
+		{name: "nothing set", svc: ServiceSpec{Name: "web"}},
+		{name: "same values", svc: ServiceSpec{Name: "web", Repo: "u/web", GitProvider: "internal", Memory: "256Mi"}},
+		{name: "memory and branch", svc: ServiceSpec{Name: "web", Memory: "512Mi", Branch: "prod"}, want: []string{"branch", "memory"}},
+		{name: "repo moved to github", svc: ServiceSpec{Name: "web", Repo: "u/web", GitProvider: "github"}, want: []string{"repo"}},
+		{name: "same env with link values", svc: ServiceSpec{Name: "web", SetEnv: true, EnvVars: []deployments.EnvVar{{Key: "A", Value: "1"}}}},
+		{name: "env value changed", svc: ServiceSpec{Name: "web", SetEnv: true, EnvVars: []deployments.EnvVar{{Key: "A", Value: "2"}}}, want: []string{"env_vars"}},
+		{name: "env var removed", svc: ServiceSpec{Name: "web", SetEnv: true}, want: []string{"env_vars"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := Spec{
+				Services:  []ServiceSpec{tt.svc},
+				Resources: []ResourceSpec{{Name: "db"}},
+				Links:     []LinkSpec{{Service: "web", Resource: "db"}},
+				Domains:   []DomainSpec{{Service: "web", Domain: "old.example.com"}},
+			}
+			steps := Diff(spec, state)
+			if tt.want == nil {
+				if len(steps) != 0 {
+					t.Fatalf("Diff() = %v, want no changes", summaries(steps))
+				}
+				return
+			}
+			if len(steps) != 1 || !reflect.DeepEqual(steps[0].Fields, tt.want) {
+				t.Fatalf("Diff() = %v, want one update of %v", summaries(steps), tt.want)
+			}
+			if !reflect.DeepEqual(*steps[0].Previous, current) {
+				t.Errorf("Previous = %+v, want the current service", *steps[0].Previous)
+			}
+		})
+	}
+}
+
+func TestDiff_NewLinkUpdatesEnv(t *testing.T) {
+	state := State{Services: []ServiceState{{Spec: ServiceSpec{Name: "api", EnvVars: []deployments.EnvVar{{Key: "A", Value: "1"}}}}}}
+	spec := Spec{
+		Services:  []ServiceSpec{{Name: "api"}},
+		Resources: []ResourceSpec{{Name: "cache"}},
+		Links:     []LinkSpec{{Service: "api", Resource: "cache", EnvPrefix: "CACHE"}},
+	}
+	want := []string{"create resource cache", "update service api (env_vars)"}
+	if got := summaries(Diff(spec, state)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+}
+
+func TestDiff_DomainsAndPrune(t *testing.T) {
+	state := State{
+		Services: []ServiceState{
+			{Spec: ServiceSpec{Name: "web"}, Domain: "old.example.com"},
+			{Spec: ServiceSpec{Name: "api"}, Domain: "api.example.com"},
+			{Spec: ServiceSpec{Name: "legacy"}, Domain: "legacy.example.com"},
+			{Spec: ServiceSpec{Name: "admin"}},
+		},
+		Resources: []ResourceState{{ID: "r1", Spec: ResourceSpec{Name: "db"}}, {ID: "r2", Spec: ResourceSpec{Name: "old-db"}}},
+	}
+	spec := Spec{
+		Services:  []ServiceSpec{{Name: "web"}, {Name: "api"}},
+		Resources: []ResourceSpec{{Name: "db"}},
+		Domains:   []DomainSpec{{Service: "web", Domain: "new.example.com"}},
+	}
+
+	want := []string{"update domain web: old.example.com → new.example.com"}
+	if got := summaries(Diff(spec, state)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() without prune = %v, want %v", got, want)
+	}
+
+	spec.Prune = true
+	steps := Diff(spec, state)
+	want = []string{
+		"update domain web: old.example.com → new.example.com",
+		"delete domain api: api.example.com",
+		"delete service admin",
+		"delete domain legacy: legacy.example.com",
+		"delete service legacy",
+		"delete resource old-db",
+	}
+	if got := summaries(steps); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() with prune = %v, want %v", got, want)
+	}
+	if steps[len(steps)-1].ResourceID != "r2" {
+		t.Errorf("resource delete ID = %q, want r2", steps[len(steps)-1].ResourceID)
+	}
+}
+
+func TestInverse(t *testing.T) {
+	svc := &ServiceSpec{Name: "web", Memory: "512Mi"}
+	prev := &ServiceSpec{Name: "web", Memory: "256Mi", EnvVars: []deployments.EnvVar{{Key: "A", Value: "1"}}}
+	tests := []struct {
+		step Step
+		want string
+		ok   bool
+	}{
+		{step: Step{Action: ActionCreate, Kind: KindResource, Name: "db"}, want: "delete resource db", ok: true},
+		{step: Step{Action: ActionCreate, Kind: KindService, Name: "web", Service: svc}, want: "delete service web", ok: true},
+		{step: Step{Action: ActionCreate, Kind: KindDomain, Name: "web", Domain: "a.example.com"}, want: "delete domain web: a.example.com", ok: true},
+		{step: Step{Action: ActionUpdate, Kind: KindDomain, Name: "web", Domain: "b.example.com", PreviousDomain: "a.example.com"}, want: "update domain web: b.example.com → a.example.com", ok: true},
+		{step: Step{Action: ActionDelete, Kind: KindDomain, Name: "web", Domain: "a.example.com"}, want: "create domain web: a.example.com", ok: true},
+		{step: Step{Action: ActionUpdate, Kind: KindService, Name: "web", Fields: []string{"memory"}, Service: svc, Previous: prev}, want: "update service web (memory)", ok: true},
+		{step: Step{Action: ActionDelete, Kind: KindService, Name: "web"}},
+		{step: Step{Action: ActionDelete, Kind: KindResource, Name: "db"}},
+	}
+	for _, tt := range tests {
+		inv, ok := Inverse(tt.step)
+		if ok != tt.ok || (ok && inv.Summary() != tt.want) {
+			t.Errorf("Inverse(%s) = %q, %v; want %q, %v", tt.step.Summary(), inv.Summary(), ok, tt.want, tt.ok)
+		}
+	}
+
+	inv, _ := Inverse(Step{Action: ActionUpdate, Kind: KindService, Name: "web", Fields: []string{"memory"}, Service: svc, Previous: prev})
+	if inv.Service.Memory != "256Mi" || !inv.Service.SetEnv {
+		t.Errorf("service update inverse should restore the previous values and env: %+v", inv.Service)
+	}
+}
@@ -0,0 +1,216 @@
+package projectplan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/augustdev/autoclip/internal/deployments"
+	"github.com/augustdev/autoclip/internal/k8sdeployments"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/dnsdb"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
+	dbresources "github.com/augustdev/autoclip/internal/storage/pg/generated/resources"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/jackc/pgx/v5"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+// maxProjectItems bounds how many services and resources a project plan
+// reads; projects are far smaller in practice.
+const maxProjectItems = 500
+
+type Service struct {
+	temporalClient client.Client
+	servicesQ      services.Querier
+	resourcesQ     dbresources.Querier
+	dnsQ           dnsdb.Querier
+	projectsQ      projects.Querier
+	logger         *slog.Logger
+}
+
+func NewService(
+	temporalClient client.Client,
+	servicesQ services.Querier,
+	resourcesQ dbresources.Querier,
+	dnsQ dnsdb.Querier,
+	projectsQ projects.Querier,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		temporalClient: temporalClient,
+		servicesQ:      servicesQ,
+		resourcesQ:     resourcesQ,
+		dnsQ:           dnsQ,
+		projectsQ:      projectsQ,
+		logger:         logger,
+	}
+}
+
+// Plan diffs spec against the project's current services, resources and
+// custom domains.
+func (s *Service) Plan(ctx context.Context, userID string, spec Spec) (*Plan, error) {
+	if spec.Project == "" {
+		spec.Project = "default"
+	}
+	if err := Validate(spec); err != nil {
+		return nil, err
+	}
+	state, err := s.loadState(ctx, userID, spec.Project)
+	if err != nil {
+		return nil, err
+	}
+	steps := Diff(spec, *state)
+	for _, st := range steps {
+		if st.Action == ActionCreate && st.Kind == KindService && st.Service.Repo == "" && st.Service.Image == "" {
+			return nil, fmt.Errorf("service %q doesn't exist yet: repo (or build_pack=image with image) is required", st.Name)
+		}
+	}
+	return &Plan{Project: spec.Project, Steps: steps, Hash: planHash(steps)}, nil
+}
+
+// Apply plans spec again and runs the steps in ApplyProjectWorkflow,
+// waiting for it to finish. If planHash is set and the plan no longer
+// matches it, nothing is applied.
+func (s *Service) Apply(ctx context.Context, userID string, spec Spec, planHash string) (*Plan, *ApplyProjectWorkflowResult, error) {
+	plan, err := s.Plan(ctx, userID, spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if planHash != "" && planHash != plan.Hash {
+		return plan, nil, fmt.Errorf("the project changed since plan %s was made (now %s); review the new plan and apply again", planHash, plan.Hash)
+	}
+	if len(plan.Steps) == 0 {
+		return plan, &ApplyProjectWorkflowResult{}, nil
+	}
+
+	// One apply per project at a time: the ID is rejected while a run for
+	// the same project is still open.
+	workflowID := fmt.Sprintf("apply-project-%s-%s", userID, plan.Project)
+	run, err := s.temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: TaskQueue,
+		// Without this, ExecuteWorkflow returns the open run and the
+		// caller would silently wait on another apply.
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}, ApplyProjectWorkflow, ApplyProjectWorkflowInput{
+		UserID:  userID,
+		Project: plan.Project,
+		Steps:   plan.Steps,
+	})
+	if err != nil {
+		var started *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &started) {
+			return plan, nil, fmt.Errorf("another apply is already running for project %s", plan.Project)
+		}
+		return plan, nil, fmt.Errorf("failed to start apply workflow: %w", err)
+	}
+
+	var result ApplyProjectWorkflowResult
+	if err := run.Get(ctx, &result); err != nil {
+		return plan, nil, err
+	}
+	return plan, &result, nil
+}
+
+func (s *Service) loadState(ctx context.Context, userID, ref string) (*State, error) {
+	project, err := s.projectsQ.GetProjectByRef(ctx, projects.GetProjectByRefParams{
+		UserID: userID,
+		Ref:    ref,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Created by the first service or resource
+		return &State{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	svcs, err := s.servicesQ.ListServicesByProjectID(ctx, services.ListServicesByProjectIDParams{
+		ProjectID: project.ID,
+		Limit:     maxProjectItems,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	state := &State{}
+	for _, svc := range svcs {
+		domain, err := s.customDomain(ctx, svc.ID)
+		if err != nil {
+			return nil, err
+		}
+		state.Services = append(state.Services, ServiceState{Spec: serviceSpecFromRow(svc), Domain: domain})
+	}
+
+	res, err := s.resourcesQ.ListResourcesByProject(ctx, dbresources.ListResourcesByProjectParams{
+		ProjectID: project.ID,
+		Limit:     maxProjectItems,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+	for _, r := range res {
+		state.Resources = append(state.Resources, ResourceState{
+			ID:   r.ID,
+			Spec: ResourceSpec{Name: r.Name, Type: r.Type, Region: r.Region},
+		})
+	}
+	return state, nil
+}
+
+func (s *Service) customDomain(ctx context.Context, serviceID string) (string, error) {
+	records, err := s.dnsQ.ListDnsRecordsByServiceID(ctx, &serviceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list dns records: %w", err)
+	}
+	for _, rec := range records {
+		if !rec.Managed {
+			continue
+		}
+		hz, err := s.dnsQ.GetHostedZoneByID(ctx, rec.ZoneID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get hosted zone: %w", err)
+		}
+		if rec.Name == "@" {
+			return hz.Zone, nil
+		}
+		return rec.Name + "." + hz.Zone, nil
+	}
+	return "", nil
+}
+
+func serviceSpecFromRow(svc services.Service) ServiceSpec {
+	var bc k8sdeployments.BuildConfig
+	if len(svc.BuildConfig) > 0 {
+		_ = json.Unmarshal(svc.BuildConfig, &bc)
+	}
+	var envVars []deployments.EnvVar
+	if len(svc.EnvVars) > 0 {
+		_ = json.Unmarshal(svc.EnvVars, &envVars)
+	}
+	spec := ServiceSpec{
+		Repo:             svc.Repo,
+		GitProvider:      svc.GitProvider,
+		Branch:           svc.Branch,
+		BuildPack:        svc.BuildPack,
+		Port:             svc.Port,
+		Memory:           svc.Memory,
+		VCPUs:            svc.Vcpus,
+		BuildCommand:     bc.BuildCommand,
+		StartCommand:     bc.StartCommand,
+		RootDirectory:    bc.RootDirectory,
+		PublishDirectory: bc.PublishDirectory,
+		DockerfilePath:   bc.DockerfilePath,
+		Region:           svc.Region,
+		EnvVars:          envVars,
+	}
+	if svc.Name != nil {
+		spec.Name = *svc.Name
+	}
+	if svc.Image != nil {
+		spec.Image = *svc.Image
+	}
+	return spec
+}
@@ -0,0 +1,44 @@
+package projectplan
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
+	"github.com/jackc/pgx/v5"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+type noProjectsQ struct {
+	projects.Querier
+}
+
+func (noProjectsQ) GetProjectByRef(context.Context, projects.GetProjectByRefParams) (projects.Project, error) {
+	return projects.Project{}, pgx.ErrNoRows
+}
+
+// runningClient behaves like Temporal with a run already open for every
+// workflow ID.
+type runningClient struct {
+	client.Client
+}
+
+func (runningClient) ExecuteWorkflow(_ context.Context, options client.StartWorkflowOptions, _ interface{}, _ ...interface{}) (client.WorkflowRun, error) {
+	if !options.WorkflowExecutionErrorWhenAlreadyStarted {
+		// Temporal hands back the open run instead of an error
+		return nil, nil
+	}
+	return nil, serviceerror.NewWorkflowExecutionAlreadyStarted("already started", "", "")
+}
+
+func TestApply_RejectsConcurrentApply(t *testing.T) {
+	s := NewService(runningClient{}, nil, nil, nil, noProjectsQ{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	_, _, err := s.Apply(context.Background(), "u1", Spec{Resources: []ResourceSpec{{Name: "db"}}}, "")
+	if err == nil || !strings.Contains(err.Error(), "another apply is already running for project default") {
+		t.Fatalf("Apply() error = %v, want another apply is already running", err)
+	}
+}
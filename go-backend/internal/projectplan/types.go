@@ -0,0 +1,141 @@
+package projectplan
+
+import (
+	"github.com/augustdev/autoclip/internal/deployments"
+)
+
+// Spec declares everything a project should contain. Services, resources
+// and domains missing from it are only deleted when Prune is set.
+type Spec struct {
+	Project   string
+	Services  []ServiceSpec
+	Resources []ResourceSpec
+	Links     []LinkSpec
+	Domains   []DomainSpec
+	Prune     bool
+}
+
+// ServiceSpec is a service as create_service would take it, with the repo
+// already resolved. Empty fields keep the current value on update.
+type ServiceSpec struct {
+	Name             string               `json:"name"`
+	Repo             string               `json:"repo,omitempty"`
+	GitProvider      string               `json:"git_provider,omitempty"` // "github" or "internal"
+	InstallationID   int64                `json:"installation_id,omitempty"`
+	Branch           string               `json:"branch,omitempty"`
+	BuildPack        string               `json:"build_pack,omitempty"`
+	Image            string               `json:"image,omitempty"`
+	Port             string               `json:"port,omitempty"`
+	DefaultPort      string               `json:"default_port,omitempty"` // used on create when Port is empty
+	Memory           string               `json:"memory,omitempty"`
+	VCPUs            string               `json:"vcpus,omitempty"`
+	BuildCommand     string               `json:"build_command,omitempty"`
+	StartCommand     string               `json:"start_command,omitempty"`
+	RootDirectory    string               `json:"root_directory,omitempty"`
+	PublishDirectory string               `json:"publish_directory,omitempty"`
+	DockerfilePath   string               `json:"dockerfile_path,omitempty"`
+	Region           string               `json:"region,omitempty"`
+	EnvVars          []deployments.EnvVar `json:"env_vars,omitempty"`
+	Links            []LinkSpec           `json:"links,omitempty"`
+	// SetEnv reports whether the spec declared env_vars at all; without
+	// it the service's current env vars are kept and links are added.
+	SetEnv bool `json:"set_env,omitempty"`
+}
+
+type ResourceSpec struct {
+	Name   string `json:"name"`
+	Type   string `json:"type,omitempty"`
+	Size   string `json:"size,omitempty"`
+	Region string `json:"region,omitempty"`
+}
+
+// LinkSpec injects a resource's credentials into a service as
+// <EnvPrefix>_URL and <EnvPrefix>_AUTH_TOKEN.
+type LinkSpec struct {
+	Service   string `json:"service"`
+	Resource  string `json:"resource"`
+	EnvPrefix string `json:"env_prefix,omitempty"`
+}
+
+type DomainSpec struct {
+	Service string `json:"service"`
+	Domain  string `json:"domain"`
+}
+
+const DefaultLinkEnvPrefix = "DATABASE"
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+const (
+	KindResource = "resource"
+	KindService  = "service"
+	KindDomain   = "domain"
+)
+
+// Step is one change in a plan. Steps are ordered so that everything a
+// step depends on comes before it: resources, then services, then domains,
+// then deletes.
+type Step struct {
+	Action string `json:"action"`
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	// Fields lists what an update changes, for display.
+	Fields []string `json:"fields,omitempty"`
+
+	Resource *ResourceSpec `json:"resource,omitempty"`
+	Service  *ServiceSpec  `json:"service,omitempty"`
+	// Previous holds the changed fields' current values so a failed apply
+	// can put an updated service back.
+	Previous *ServiceSpec `json:"previous,omitempty"`
+
+	// Domain steps: Name is the service, Domain the custom domain.
+	// PreviousDomain is set when a domain replaces another one.
+	Domain         string `json:"domain,omitempty"`
+	PreviousDomain string `json:"previous_domain,omitempty"`
+	// ResourceID is set for resource deletes.
+	ResourceID string `json:"resource_id,omitempty"`
+}
+
+type Plan struct {
+	Project string `json:"project"`
+	Steps   []Step `json:"steps"`
+	// Hash identifies the plan; apply refuses to run if the plan it
+	// computes has a different hash than the one the caller reviewed.
+	Hash string `json:"hash"`
+}
+
+// State is what a project currently contains.
+type State struct {
+	Services  []ServiceState
+	Resources []ResourceState
+}
+
+type ServiceState struct {
+	Spec   ServiceSpec
+	Domain string
+}
+
+type ResourceState struct {
+	ID   string
+	Spec ResourceSpec
+}
+
+type ApplyProjectWorkflowInput struct {
+	UserID  string
+	Project string
+	Steps   []Step
+}
+
+type ApplyProjectWorkflowResult struct {
+	Applied []string
+}
+
+type StepInput struct {
+	UserID  string
+	Project string
+	Step    Step
+}
@@ -0,0 +1,78 @@
+package projectplan
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+)
+
+const TaskQueue = "default"
+
+// ApplyProjectWorkflow runs plan steps in order. If one fails, the steps
+// already applied are undone in reverse order and the error is returned.
+// Deletes run last because they can't be undone.
+func ApplyProjectWorkflow(ctx workflow.Context, input ApplyProjectWorkflowInput) (ApplyProjectWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting ApplyProjectWorkflow", "userID", input.UserID, "project", input.Project, "steps", len(input.Steps))
+
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+
+	var activities *Activities
+	var result ApplyProjectWorkflowResult
+	var undo []Step
+
+	for _, step := range input.Steps {
+		err := workflow.ExecuteActivity(ctx, activities.ApplyStep, StepInput{
+			UserID:  input.UserID,
+			Project: input.Project,
+			Step:    step,
+		}).Get(ctx, nil)
+		if err != nil {
+			logger.Error("Project step failed, compensating", "step", step.Summary(), "error", err)
+			compensate(ctx, input, undo)
+			return result, fmt.Errorf("%s failed, %d applied step(s) were rolled back: %w", step.Summary(), len(undo), err)
+		}
+		result.Applied = append(result.Applied, step.Summary())
+		if inverse, ok := Inverse(step); ok {
+			undo = append(undo, inverse)
+		}
+	}
+
+	logger.Info("ApplyProjectWorkflow completed", "userID", input.UserID, "project", input.Project)
+	return result, nil
+}
+
+// compensate runs undo in reverse. It keeps going past failures so one
+// stuck step doesn't leave everything before it in place.
+func compensate(ctx workflow.Context, input ApplyProjectWorkflowInput, undo []Step) {
+	logger := workflow.GetLogger(ctx)
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	var activities *Activities
+	for i := len(undo) - 1; i >= 0; i-- {
+		err := workflow.ExecuteActivity(ctx, activities.ApplyStep, StepInput{
+			UserID:  input.UserID,
+			Project: input.Project,
+			Step:    undo[i],
+		}).Get(ctx, nil)
+		if err != nil {
+			logger.Error("Compensation step failed", "step", undo[i].Summary(), "error", err)
+		}
+	}
+}
+
+func RegisterWorkflowsAndActivities(w worker.Worker, activities *Activities) {
+	w.RegisterWorkflow(ApplyProjectWorkflow)
+	w.RegisterActivity(activities.ApplyStep)
+}
@@ -0,0 +1,51 @@
+package projectplan
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestApplyProjectWorkflow_CompensatesInReverse(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+
+	var ran []string
+	env.RegisterActivityWithOptions(func(ctx context.Context, input StepInput) error {
+		ran = append(ran, input.Step.Summary())
+		if input.Step.Name == "broken" {
+			return temporal.NewNonRetryableApplicationError("boom", "test", errors.New("boom"))
+		}
+		return nil
+	}, activity.RegisterOptions{Name: "ApplyStep"})
+
+	env.ExecuteWorkflow(ApplyProjectWorkflow, ApplyProjectWorkflowInput{
+		UserID:  "u1",
+		Project: "default",
+		Steps: []Step{
+			{Action: ActionCreate, Kind: KindResource, Name: "db"},
+			{Action: ActionCreate, Kind: KindService, Name: "web", Service: &ServiceSpec{Name: "web"}},
+			{Action: ActionCreate, Kind: KindService, Name: "broken", Service: &ServiceSpec{Name: "broken"}},
+			{Action: ActionDelete, Kind: KindService, Name: "never"},
+		},
+	})
+
+	if !env.IsWorkflowCompleted() || env.GetWorkflowError() == nil {
+		t.Fatal("expected the workflow to fail")
+	}
+	want := []string{
+		"create resource db",
+		"create service web",
+		"create service broken",
+		"delete service web",
+		"delete resource db",
+	}
+	if !reflect.DeepEqual(ran, want) {
+		t.Errorf("ran %v, want %v", ran, want)
+	}
+}
@@ -93,3 +93,26 @@ func (c *Client) GetServiceMetrics(ctx context.Context, namespace, serviceName s
 
 	return &result, nil
 }
+
+// GetServiceRequestCount returns how many requests Traefik routed to the
+// service over the trailing window. Traefik names Ingress-backed services
+// "<namespace>-<name>-<port>@kubernetes" and IngressRoute-backed ones with
+// the @kubernetescrd provider suffix; both are counted. ok is false when
+// Prometheus has no series for the service at all, which says nothing
+// about whether it was idle.
+func (c *Client) GetServiceRequestCount(ctx context.Context, namespace, serviceName string, window time.Duration) (count float64, ok bool, err error) {
+	query := fmt.Sprintf(
+		`sum(increase(traefik_service_requests_total{service=~"%s-%s-[0-9]+@kubernetes(crd)?"}[%ds]))`,
+		namespace, serviceName, int(window.Seconds()),
+	)
+
+	now := fmt.Sprintf("%d", time.Now().Unix())
+	points, err := c.QueryRange(ctx, query, now, now, "60")
+	if err != nil {
+		return 0, false, fmt.Errorf("request count query: %w", err)
+	}
+	if len(points) == 0 {
+		return 0, false, nil
+	}
+	return points[len(points)-1].Value, true, nil
+}
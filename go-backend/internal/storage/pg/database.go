@@ -15,9 +15,11 @@ import (
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/githubcreds"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/gittokens"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/internalrepos"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/projectroutes"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/projects"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/resources"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
+	"github.com/augustdev/autoclip/internal/storage/pg/generated/sshkeys"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/users"
 	pgxdecimal "github.com/jackc/pgx-shopspring-decimal"
 	"github.com/jackc/pgx/v5"
@@ -47,6 +49,8 @@ type DB struct {
 	dnsQ            dnsdb.Querier
 	deploymentsQ    deploymentsdb.Querier
 	clustersQ       clusters.Querier
+	routesQ         projectroutes.Querier
+	sshKeysQ        sshkeys.Querier
 }
 
 func NewDatabase(lc fx.Lifecycle, config DbConfig, logger *slog.Logger) (*DB, error) {
@@ -144,6 +148,8 @@ func NewDatabase(lc fx.Lifecycle, config DbConfig, logger *slog.Logger) (*DB, er
 		dnsQ:            dnsdb.New(pool),
 		deploymentsQ:    deploymentsdb.New(pool),
 		clustersQ:       clusters.New(pool),
+		routesQ:         projectroutes.New(pool),
+		sshKeysQ:        sshkeys.New(pool),
 	}, nil
 }
 
@@ -196,6 +202,14 @@ func NewDeploymentQueries(database *DB) deploymentsdb.Querier {
 	return database.deploymentsQ
 }
 
+func NewProjectRouteQueries(database *DB) projectroutes.Querier {
+	return database.routesQ
+}
+
+func NewSSHKeyQueries(database *DB) sshkeys.Querier {
+	return database.sshKeysQ
+}
+
 func NewClusterMap(database *DB) (map[string]clusters.Cluster, error) {
 	all, err := database.clustersQ.ListClusters(context.Background())
 	if err != nil {
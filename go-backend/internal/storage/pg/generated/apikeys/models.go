@@ -54,6 +54,8 @@ type Deployment struct {
 	FinishedAt      pgtype.Timestamptz `json:"finished_at"`
 	CreatedAt       pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	GitTag          *string            `json:"git_tag"`
+	Manifest        []byte             `json:"manifest"`
 }
 
 type DnsRecord struct {
@@ -110,17 +112,32 @@ type HostedZone struct {
 }
 
 type InternalRepo struct {
-	ID        string             `json:"id"`
-	UserID    string             `json:"user_id"`
-	Name      string             `json:"name"`
-	CloneUrl  string             `json:"clone_url"`
-	Provider  string             `json:"provider"`
-	RepoID    *string            `json:"repo_id"`
-	FullName  string             `json:"full_name"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
-	BarePath  *string            `json:"bare_path"`
-	ProjectID string             `json:"project_id"`
+	ID               string             `json:"id"`
+	UserID           string             `json:"user_id"`
+	Name             string             `json:"name"`
+	CloneUrl         string             `json:"clone_url"`
+	Provider         string             `json:"provider"`
+	RepoID           *string            `json:"repo_id"`
+	FullName         string             `json:"full_name"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	BarePath         *string            `json:"bare_path"`
+	ProjectID        string             `json:"project_id"`
+	SizeBytes        int64              `json:"size_bytes"`
+	SizeMeasuredAt   pgtype.Timestamptz `json:"size_measured_at"`
+	SecretScanBypass bool               `json:"secret_scan_bypass"`
+	MirrorGithubRepo *string            `json:"mirror_github_repo"`
+	MirroredAt       pgtype.Timestamptz `json:"mirrored_at"`
+	MirrorError      *string            `json:"mirror_error"`
+}
+
+type PortAllocation struct {
+	Region     string             `json:"region"`
+	Protocol   string             `json:"protocol"`
+	PublicPort int32              `json:"public_port"`
+	ServiceID  string             `json:"service_id"`
+	TargetPort int32              `json:"target_port"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
 
 type Project struct {
@@ -133,6 +150,17 @@ type Project struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type ProjectRoute struct {
+	ID          string             `json:"id"`
+	ProjectID   string             `json:"project_id"`
+	ServiceID   string             `json:"service_id"`
+	Host        string             `json:"host"`
+	PathPrefix  string             `json:"path_prefix"`
+	StripPrefix bool               `json:"strip_prefix"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
 type Resource struct {
 	ID            string             `json:"id"`
 	UserID        string             `json:"user_id"`
@@ -151,6 +179,18 @@ type Resource struct {
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 }
 
+type SecretScanFinding struct {
+	ID           string             `json:"id"`
+	RepoFullName string             `json:"repo_full_name"`
+	UserID       *string            `json:"user_id"`
+	Rule         string             `json:"rule"`
+	Path         string             `json:"path"`
+	Line         int32              `json:"line"`
+	BlobSha      string             `json:"blob_sha"`
+	Bypassed     bool               `json:"bypassed"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
 type Service struct {
 	ID                  string             `json:"id"`
 	UserID              string             `json:"user_id"`
@@ -174,6 +214,48 @@ type Service struct {
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
 	Region              string             `json:"region"`
+	AccessPolicy        []byte             `json:"access_policy"`
+	Ports               []byte             `json:"ports"`
+	SleepAfterIdle      *int32             `json:"sleep_after_idle"`
+	SleepingAt          pgtype.Timestamptz `json:"sleeping_at"`
+	StoppedAt           pgtype.Timestamptz `json:"stopped_at"`
+	Image               *string            `json:"image"`
+	RegistryCredentials *string            `json:"registry_credentials"`
+	RegistryPurgedAt    pgtype.Timestamptz `json:"registry_purged_at"`
+	DeployPolicy        string             `json:"deploy_policy"`
+	DeployDebounce      *int32             `json:"deploy_debounce"`
+	TagPattern          *string            `json:"tag_pattern"`
+}
+
+type ServiceEvent struct {
+	ID           string             `json:"id"`
+	ServiceID    string             `json:"service_id"`
+	Action       string             `json:"action"`
+	Status       string             `json:"status"`
+	WorkflowID   string             `json:"workflow_id"`
+	ErrorMessage *string            `json:"error_message"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	FinishedAt   pgtype.Timestamptz `json:"finished_at"`
+}
+
+type SshKey struct {
+	ID          string             `json:"id"`
+	UserID      string             `json:"user_id"`
+	Name        string             `json:"name"`
+	PublicKey   string             `json:"public_key"`
+	Fingerprint string             `json:"fingerprint"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type TriggerEvent struct {
+	ID         string             `json:"id"`
+	ServiceID  string             `json:"service_id"`
+	Trigger    string             `json:"trigger"`
+	TriggerRef *string            `json:"trigger_ref"`
+	Status     string             `json:"status"`
+	Reason     *string            `json:"reason"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
 
 type User struct {
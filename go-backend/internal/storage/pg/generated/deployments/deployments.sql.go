@@ -55,11 +55,11 @@ func (q *Queries) CountDeploymentsByServiceID(ctx context.Context, serviceID str
 const createDeployment = `-- name: CreateDeployment :one
 INSERT INTO deployments (
     id, service_id, workflow_id, build_pack, build_config, env_vars_snapshot,
-    memory, vcpus, port, trigger, trigger_ref, commit_hash
+    memory, vcpus, port, trigger, trigger_ref, commit_hash, git_tag
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 )
-RETURNING id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at
+RETURNING id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at, git_tag, manifest
 `
 
 type CreateDeploymentParams struct {
@@ -75,6 +75,7 @@ type CreateDeploymentParams struct {
 	Trigger         string  `json:"trigger"`
 	TriggerRef      *string `json:"trigger_ref"`
 	CommitHash      *string `json:"commit_hash"`
+	GitTag          *string `json:"git_tag"`
 }
 
 func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentParams) (Deployment, error) {
@@ -91,6 +92,7 @@ func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentPara
 		arg.Trigger,
 		arg.TriggerRef,
 		arg.CommitHash,
+		arg.GitTag,
 	)
 	var i Deployment
 	err := row.Scan(
@@ -115,12 +117,74 @@ func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentPara
 		&i.FinishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.GitTag,
+		&i.Manifest,
 	)
 	return i, err
 }
 
+const createServiceEvent = `-- name: CreateServiceEvent :one
+INSERT INTO service_events (id, service_id, action, workflow_id)
+VALUES ($1, $2, $3, $4)
+RETURNING id, service_id, action, status, workflow_id, error_message, created_at, finished_at
+`
+
+type CreateServiceEventParams struct {
+	ID         string `json:"id"`
+	ServiceID  string `json:"service_id"`
+	Action     string `json:"action"`
+	WorkflowID string `json:"workflow_id"`
+}
+
+func (q *Queries) CreateServiceEvent(ctx context.Context, arg CreateServiceEventParams) (ServiceEvent, error) {
+	row := q.db.QueryRow(ctx, createServiceEvent,
+		arg.ID,
+		arg.ServiceID,
+		arg.Action,
+		arg.WorkflowID,
+	)
+	var i ServiceEvent
+	err := row.Scan(
+		&i.ID,
+		&i.ServiceID,
+		&i.Action,
+		&i.Status,
+		&i.WorkflowID,
+		&i.ErrorMessage,
+		&i.CreatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const createTriggerEvent = `-- name: CreateTriggerEvent :exec
+INSERT INTO trigger_events (id, service_id, trigger, trigger_ref, status, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateTriggerEventParams struct {
+	ID         string  `json:"id"`
+	ServiceID  string  `json:"service_id"`
+	Trigger    string  `json:"trigger"`
+	TriggerRef *string `json:"trigger_ref"`
+	Status     string  `json:"status"`
+	Reason     *string `json:"reason"`
+}
+
+func (q *Queries) CreateTriggerEvent(ctx context.Context, arg CreateTriggerEventParams) error {
+	_, err := q.db.Exec(ctx, createTriggerEvent,
+		arg.ID,
+		arg.ServiceID,
+		arg.Trigger,
+		arg.TriggerRef,
+		arg.Status,
+		arg.Reason,
+	)
+	return err
+}
+
 const getActiveDeploymentByServiceID = `-- name: GetActiveDeploymentByServiceID :one
-SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at FROM deployments
+SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at, git_tag, manifest FROM deployments
 WHERE service_id = $1 AND status = 'active'
 `
 
@@ -149,12 +213,33 @@ func (q *Queries) GetActiveDeploymentByServiceID(ctx context.Context, serviceID
 		&i.FinishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.GitTag,
+		&i.Manifest,
 	)
 	return i, err
 }
 
+const getCommitManifest = `-- name: GetCommitManifest :one
+SELECT manifest FROM deployments
+WHERE service_id = $1 AND commit_hash = $2 AND manifest IS NOT NULL
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetCommitManifestParams struct {
+	ServiceID  string  `json:"service_id"`
+	CommitHash *string `json:"commit_hash"`
+}
+
+func (q *Queries) GetCommitManifest(ctx context.Context, arg GetCommitManifestParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getCommitManifest, arg.ServiceID, arg.CommitHash)
+	var manifest []byte
+	err := row.Scan(&manifest)
+	return manifest, err
+}
+
 const getDeploymentByID = `-- name: GetDeploymentByID :one
-SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at FROM deployments WHERE id = $1
+SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at, git_tag, manifest FROM deployments WHERE id = $1
 `
 
 func (q *Queries) GetDeploymentByID(ctx context.Context, id string) (Deployment, error) {
@@ -182,12 +267,14 @@ func (q *Queries) GetDeploymentByID(ctx context.Context, id string) (Deployment,
 		&i.FinishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.GitTag,
+		&i.Manifest,
 	)
 	return i, err
 }
 
 const getDeploymentByWorkflowID = `-- name: GetDeploymentByWorkflowID :one
-SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at FROM deployments WHERE workflow_id = $1
+SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at, git_tag, manifest FROM deployments WHERE workflow_id = $1
 `
 
 func (q *Queries) GetDeploymentByWorkflowID(ctx context.Context, workflowID string) (Deployment, error) {
@@ -215,12 +302,14 @@ func (q *Queries) GetDeploymentByWorkflowID(ctx context.Context, workflowID stri
 		&i.FinishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.GitTag,
+		&i.Manifest,
 	)
 	return i, err
 }
 
 const getLatestDeploymentByServiceID = `-- name: GetLatestDeploymentByServiceID :one
-SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at FROM deployments
+SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at, git_tag, manifest FROM deployments
 WHERE service_id = $1
 ORDER BY created_at DESC
 LIMIT 1
@@ -251,12 +340,14 @@ func (q *Queries) GetLatestDeploymentByServiceID(ctx context.Context, serviceID
 		&i.FinishedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.GitTag,
+		&i.Manifest,
 	)
 	return i, err
 }
 
 const getLatestDeploymentsByServiceIDs = `-- name: GetLatestDeploymentsByServiceIDs :many
-SELECT DISTINCT ON (service_id) id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at FROM deployments
+SELECT DISTINCT ON (service_id) id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at, git_tag, manifest FROM deployments
 WHERE service_id = ANY($1::text[])
 ORDER BY service_id, created_at DESC
 `
@@ -292,6 +383,8 @@ func (q *Queries) GetLatestDeploymentsByServiceIDs(ctx context.Context, dollar_1
 			&i.FinishedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.GitTag,
+			&i.Manifest,
 		); err != nil {
 			return nil, err
 		}
@@ -304,7 +397,7 @@ func (q *Queries) GetLatestDeploymentsByServiceIDs(ctx context.Context, dollar_1
 }
 
 const listDeploymentsByServiceID = `-- name: ListDeploymentsByServiceID :many
-SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at FROM deployments
+SELECT id, service_id, workflow_id, workflow_run_id, commit_hash, image_ref, build_pack, build_config, env_vars_snapshot, memory, vcpus, port, status, error_message, build_progress, trigger, trigger_ref, started_at, finished_at, created_at, updated_at, git_tag, manifest FROM deployments
 WHERE service_id = $1
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
@@ -347,6 +440,83 @@ func (q *Queries) ListDeploymentsByServiceID(ctx context.Context, arg ListDeploy
 			&i.FinishedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.GitTag,
+			&i.Manifest,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentImageRefsByServiceID = `-- name: ListRecentImageRefsByServiceID :many
+SELECT image_ref FROM deployments
+WHERE service_id = $1
+  AND status IN ('active', 'superseded')
+  AND image_ref IS NOT NULL
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type ListRecentImageRefsByServiceIDParams struct {
+	ServiceID string `json:"service_id"`
+	Limit     int32  `json:"limit"`
+}
+
+func (q *Queries) ListRecentImageRefsByServiceID(ctx context.Context, arg ListRecentImageRefsByServiceIDParams) ([]*string, error) {
+	rows, err := q.db.Query(ctx, listRecentImageRefsByServiceID, arg.ServiceID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*string{}
+	for rows.Next() {
+		var image_ref *string
+		if err := rows.Scan(&image_ref); err != nil {
+			return nil, err
+		}
+		items = append(items, image_ref)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServiceEventsByServiceID = `-- name: ListServiceEventsByServiceID :many
+SELECT id, service_id, action, status, workflow_id, error_message, created_at, finished_at FROM service_events
+WHERE service_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type ListServiceEventsByServiceIDParams struct {
+	ServiceID string `json:"service_id"`
+	Limit     int32  `json:"limit"`
+}
+
+func (q *Queries) ListServiceEventsByServiceID(ctx context.Context, arg ListServiceEventsByServiceIDParams) ([]ServiceEvent, error) {
+	rows, err := q.db.Query(ctx, listServiceEventsByServiceID, arg.ServiceID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ServiceEvent{}
+	for rows.Next() {
+		var i ServiceEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServiceID,
+			&i.Action,
+			&i.Status,
+			&i.WorkflowID,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.FinishedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -434,6 +604,58 @@ func (q *Queries) MarkDeploymentRemoved(ctx context.Context, id string) error {
 	return err
 }
 
+const markServiceEventCompleted = `-- name: MarkServiceEventCompleted :exec
+UPDATE service_events SET status = 'completed', finished_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) MarkServiceEventCompleted(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, markServiceEventCompleted, id)
+	return err
+}
+
+const markServiceEventFailed = `-- name: MarkServiceEventFailed :exec
+UPDATE service_events SET status = 'failed', error_message = $2, finished_at = NOW() WHERE id = $1
+`
+
+type MarkServiceEventFailedParams struct {
+	ID           string  `json:"id"`
+	ErrorMessage *string `json:"error_message"`
+}
+
+func (q *Queries) MarkServiceEventFailed(ctx context.Context, arg MarkServiceEventFailedParams) error {
+	_, err := q.db.Exec(ctx, markServiceEventFailed, arg.ID, arg.ErrorMessage)
+	return err
+}
+
+const setDeploymentEffectiveConfig = `-- name: SetDeploymentEffectiveConfig :exec
+UPDATE deployments
+SET build_pack = $2, build_config = $3, port = $4, memory = $5, vcpus = $6, manifest = $7, updated_at = NOW()
+WHERE id = $1
+`
+
+type SetDeploymentEffectiveConfigParams struct {
+	ID          string `json:"id"`
+	BuildPack   string `json:"build_pack"`
+	BuildConfig []byte `json:"build_config"`
+	Port        string `json:"port"`
+	Memory      string `json:"memory"`
+	Vcpus       string `json:"vcpus"`
+	Manifest    []byte `json:"manifest"`
+}
+
+func (q *Queries) SetDeploymentEffectiveConfig(ctx context.Context, arg SetDeploymentEffectiveConfigParams) error {
+	_, err := q.db.Exec(ctx, setDeploymentEffectiveConfig,
+		arg.ID,
+		arg.BuildPack,
+		arg.BuildConfig,
+		arg.Port,
+		arg.Memory,
+		arg.Vcpus,
+		arg.Manifest,
+	)
+	return err
+}
+
 const supersedeActiveDeployment = `-- name: SupersedeActiveDeployment :exec
 UPDATE deployments
 SET status = 'superseded', finished_at = NOW(), updated_at = NOW()
@@ -12,18 +12,26 @@ type Querier interface {
 	CancelInFlightDeployments(ctx context.Context, arg CancelInFlightDeploymentsParams) ([]string, error)
 	CountDeploymentsByServiceID(ctx context.Context, serviceID string) (int64, error)
 	CreateDeployment(ctx context.Context, arg CreateDeploymentParams) (Deployment, error)
+	CreateServiceEvent(ctx context.Context, arg CreateServiceEventParams) (ServiceEvent, error)
+	CreateTriggerEvent(ctx context.Context, arg CreateTriggerEventParams) error
 	GetActiveDeploymentByServiceID(ctx context.Context, serviceID string) (Deployment, error)
+	GetCommitManifest(ctx context.Context, arg GetCommitManifestParams) ([]byte, error)
 	GetDeploymentByID(ctx context.Context, id string) (Deployment, error)
 	GetDeploymentByWorkflowID(ctx context.Context, workflowID string) (Deployment, error)
 	GetLatestDeploymentByServiceID(ctx context.Context, serviceID string) (Deployment, error)
 	GetLatestDeploymentsByServiceIDs(ctx context.Context, dollar_1 []string) ([]Deployment, error)
 	ListDeploymentsByServiceID(ctx context.Context, arg ListDeploymentsByServiceIDParams) ([]Deployment, error)
+	ListRecentImageRefsByServiceID(ctx context.Context, arg ListRecentImageRefsByServiceIDParams) ([]*string, error)
+	ListServiceEventsByServiceID(ctx context.Context, arg ListServiceEventsByServiceIDParams) ([]ServiceEvent, error)
 	MarkDeploymentActive(ctx context.Context, arg MarkDeploymentActiveParams) error
 	MarkDeploymentCancelled(ctx context.Context, id string) error
 	MarkDeploymentCompleted(ctx context.Context, id string) error
 	MarkDeploymentCrashed(ctx context.Context, arg MarkDeploymentCrashedParams) error
 	MarkDeploymentFailed(ctx context.Context, arg MarkDeploymentFailedParams) error
 	MarkDeploymentRemoved(ctx context.Context, id string) error
+	MarkServiceEventCompleted(ctx context.Context, id string) error
+	MarkServiceEventFailed(ctx context.Context, arg MarkServiceEventFailedParams) error
+	SetDeploymentEffectiveConfig(ctx context.Context, arg SetDeploymentEffectiveConfigParams) error
 	SupersedeActiveDeployment(ctx context.Context, serviceID string) error
 	UpdateDeploymentBuildProgress(ctx context.Context, arg UpdateDeploymentBuildProgressParams) error
 	UpdateDeploymentBuilding(ctx context.Context, id string) error
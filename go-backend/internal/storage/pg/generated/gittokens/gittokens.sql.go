@@ -67,8 +67,6 @@ SELECT gt.id, gt.token_hash, gt.token_prefix, gt.user_id, gt.repo_id, gt.scopes,
 FROM git_tokens gt
 LEFT JOIN internal_repos ir ON gt.repo_id = ir.id
 WHERE gt.token_hash = $1
-  AND gt.revoked_at IS NULL
-  AND (gt.expires_at IS NULL OR gt.expires_at > NOW())
 `
 
 type GetTokenByHashRow struct {
@@ -104,6 +102,62 @@ func (q *Queries) GetTokenByHash(ctx context.Context, tokenHash string) (GetToke
 	return i, err
 }
 
+const listActiveByUserID = `-- name: ListActiveByUserID :many
+SELECT gt.id, gt.token_hash, gt.token_prefix, gt.user_id, gt.repo_id, gt.scopes, gt.expires_at, gt.last_used_at, gt.revoked_at, gt.created_at, ir.full_name AS repo_full_name
+FROM git_tokens gt
+LEFT JOIN internal_repos ir ON gt.repo_id = ir.id
+WHERE gt.user_id = $1
+  AND gt.revoked_at IS NULL
+  AND (gt.expires_at IS NULL OR gt.expires_at > NOW())
+ORDER BY gt.created_at DESC
+`
+
+type ListActiveByUserIDRow struct {
+	ID           string             `json:"id"`
+	TokenHash    string             `json:"token_hash"`
+	TokenPrefix  string             `json:"token_prefix"`
+	UserID       string             `json:"user_id"`
+	RepoID       *string            `json:"repo_id"`
+	Scopes       []string           `json:"scopes"`
+	ExpiresAt    pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt   pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt    pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	RepoFullName *string            `json:"repo_full_name"`
+}
+
+func (q *Queries) ListActiveByUserID(ctx context.Context, userID string) ([]ListActiveByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, listActiveByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActiveByUserIDRow{}
+	for rows.Next() {
+		var i ListActiveByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TokenHash,
+			&i.TokenPrefix,
+			&i.UserID,
+			&i.RepoID,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+			&i.RepoFullName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listByRepoID = `-- name: ListByRepoID :many
 SELECT id, token_hash, token_prefix, user_id, repo_id, scopes, expires_at, last_used_at, revoked_at, created_at FROM git_tokens
 WHERE repo_id = $1
@@ -199,6 +253,24 @@ func (q *Queries) RevokeTokensByRepoID(ctx context.Context, repoID *string) erro
 	return err
 }
 
+const revokeUserToken = `-- name: RevokeUserToken :execrows
+UPDATE git_tokens SET revoked_at = NOW()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeUserTokenParams struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) RevokeUserToken(ctx context.Context, arg RevokeUserTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeUserToken, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateLastUsed = `-- name: UpdateLastUsed :exec
 UPDATE git_tokens SET last_used_at = NOW() WHERE id = $1
 `
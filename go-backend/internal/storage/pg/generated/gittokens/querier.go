@@ -12,10 +12,12 @@ type Querier interface {
 	CleanupExpired(ctx context.Context) error
 	CreateToken(ctx context.Context, arg CreateTokenParams) (GitToken, error)
 	GetTokenByHash(ctx context.Context, tokenHash string) (GetTokenByHashRow, error)
+	ListActiveByUserID(ctx context.Context, userID string) ([]ListActiveByUserIDRow, error)
 	ListByRepoID(ctx context.Context, repoID *string) ([]GitToken, error)
 	ListByUserID(ctx context.Context, userID string) ([]GitToken, error)
 	RevokeToken(ctx context.Context, id string) error
 	RevokeTokensByRepoID(ctx context.Context, repoID *string) error
+	RevokeUserToken(ctx context.Context, arg RevokeUserTokenParams) (int64, error)
 	UpdateLastUsed(ctx context.Context, id string) error
 }
 
@@ -12,7 +12,7 @@ import (
 const createInternalRepo = `-- name: CreateInternalRepo :one
 INSERT INTO internal_repos (id, user_id, project_id, name, clone_url, provider, repo_id, full_name, bare_path)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id
+RETURNING id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id, size_bytes, size_measured_at, secret_scan_bypass, mirror_github_repo, mirrored_at, mirror_error
 `
 
 type CreateInternalRepoParams struct {
@@ -52,10 +52,46 @@ func (q *Queries) CreateInternalRepo(ctx context.Context, arg CreateInternalRepo
 		&i.UpdatedAt,
 		&i.BarePath,
 		&i.ProjectID,
+		&i.SizeBytes,
+		&i.SizeMeasuredAt,
+		&i.SecretScanBypass,
+		&i.MirrorGithubRepo,
+		&i.MirroredAt,
+		&i.MirrorError,
 	)
 	return i, err
 }
 
+const createSecretScanFinding = `-- name: CreateSecretScanFinding :exec
+INSERT INTO secret_scan_findings (id, repo_full_name, user_id, rule, path, line, blob_sha, bypassed)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateSecretScanFindingParams struct {
+	ID           string  `json:"id"`
+	RepoFullName string  `json:"repo_full_name"`
+	UserID       *string `json:"user_id"`
+	Rule         string  `json:"rule"`
+	Path         string  `json:"path"`
+	Line         int32   `json:"line"`
+	BlobSha      string  `json:"blob_sha"`
+	Bypassed     bool    `json:"bypassed"`
+}
+
+func (q *Queries) CreateSecretScanFinding(ctx context.Context, arg CreateSecretScanFindingParams) error {
+	_, err := q.db.Exec(ctx, createSecretScanFinding,
+		arg.ID,
+		arg.RepoFullName,
+		arg.UserID,
+		arg.Rule,
+		arg.Path,
+		arg.Line,
+		arg.BlobSha,
+		arg.Bypassed,
+	)
+	return err
+}
+
 const deleteInternalRepo = `-- name: DeleteInternalRepo :exec
 DELETE FROM internal_repos WHERE id = $1
 `
@@ -75,7 +111,7 @@ func (q *Queries) DeleteInternalRepoByFullName(ctx context.Context, fullName str
 }
 
 const getInternalRepoByFullName = `-- name: GetInternalRepoByFullName :one
-SELECT id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id FROM internal_repos WHERE full_name = $1
+SELECT id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id, size_bytes, size_measured_at, secret_scan_bypass, mirror_github_repo, mirrored_at, mirror_error FROM internal_repos WHERE full_name = $1
 `
 
 func (q *Queries) GetInternalRepoByFullName(ctx context.Context, fullName string) (InternalRepo, error) {
@@ -93,12 +129,18 @@ func (q *Queries) GetInternalRepoByFullName(ctx context.Context, fullName string
 		&i.UpdatedAt,
 		&i.BarePath,
 		&i.ProjectID,
+		&i.SizeBytes,
+		&i.SizeMeasuredAt,
+		&i.SecretScanBypass,
+		&i.MirrorGithubRepo,
+		&i.MirroredAt,
+		&i.MirrorError,
 	)
 	return i, err
 }
 
 const getInternalRepoByID = `-- name: GetInternalRepoByID :one
-SELECT id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id FROM internal_repos WHERE id = $1
+SELECT id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id, size_bytes, size_measured_at, secret_scan_bypass, mirror_github_repo, mirrored_at, mirror_error FROM internal_repos WHERE id = $1
 `
 
 func (q *Queries) GetInternalRepoByID(ctx context.Context, id string) (InternalRepo, error) {
@@ -116,12 +158,18 @@ func (q *Queries) GetInternalRepoByID(ctx context.Context, id string) (InternalR
 		&i.UpdatedAt,
 		&i.BarePath,
 		&i.ProjectID,
+		&i.SizeBytes,
+		&i.SizeMeasuredAt,
+		&i.SecretScanBypass,
+		&i.MirrorGithubRepo,
+		&i.MirroredAt,
+		&i.MirrorError,
 	)
 	return i, err
 }
 
 const getInternalRepoByProjectAndName = `-- name: GetInternalRepoByProjectAndName :one
-SELECT id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id FROM internal_repos WHERE project_id = $1 AND name = $2
+SELECT id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id, size_bytes, size_measured_at, secret_scan_bypass, mirror_github_repo, mirrored_at, mirror_error FROM internal_repos WHERE project_id = $1 AND name = $2
 `
 
 type GetInternalRepoByProjectAndNameParams struct {
@@ -144,12 +192,18 @@ func (q *Queries) GetInternalRepoByProjectAndName(ctx context.Context, arg GetIn
 		&i.UpdatedAt,
 		&i.BarePath,
 		&i.ProjectID,
+		&i.SizeBytes,
+		&i.SizeMeasuredAt,
+		&i.SecretScanBypass,
+		&i.MirrorGithubRepo,
+		&i.MirroredAt,
+		&i.MirrorError,
 	)
 	return i, err
 }
 
 const listInternalReposByUserID = `-- name: ListInternalReposByUserID :many
-SELECT id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id FROM internal_repos
+SELECT id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id, size_bytes, size_measured_at, secret_scan_bypass, mirror_github_repo, mirrored_at, mirror_error FROM internal_repos
 WHERE user_id = $1
 ORDER BY created_at DESC
 `
@@ -175,6 +229,12 @@ func (q *Queries) ListInternalReposByUserID(ctx context.Context, userID string)
 			&i.UpdatedAt,
 			&i.BarePath,
 			&i.ProjectID,
+			&i.SizeBytes,
+			&i.SizeMeasuredAt,
+			&i.SecretScanBypass,
+			&i.MirrorGithubRepo,
+			&i.MirroredAt,
+			&i.MirrorError,
 		); err != nil {
 			return nil, err
 		}
@@ -185,3 +245,120 @@ func (q *Queries) ListInternalReposByUserID(ctx context.Context, userID string)
 	}
 	return items, nil
 }
+
+const markInternalRepoMirrored = `-- name: MarkInternalRepoMirrored :exec
+UPDATE internal_repos
+SET mirrored_at = NOW(), mirror_error = NULL
+WHERE full_name = $1
+`
+
+func (q *Queries) MarkInternalRepoMirrored(ctx context.Context, fullName string) error {
+	_, err := q.db.Exec(ctx, markInternalRepoMirrored, fullName)
+	return err
+}
+
+const setInternalRepoMirrorError = `-- name: SetInternalRepoMirrorError :exec
+UPDATE internal_repos
+SET mirror_error = $2
+WHERE full_name = $1
+`
+
+type SetInternalRepoMirrorErrorParams struct {
+	FullName    string  `json:"full_name"`
+	MirrorError *string `json:"mirror_error"`
+}
+
+func (q *Queries) SetInternalRepoMirrorError(ctx context.Context, arg SetInternalRepoMirrorErrorParams) error {
+	_, err := q.db.Exec(ctx, setInternalRepoMirrorError, arg.FullName, arg.MirrorError)
+	return err
+}
+
+const updateInternalRepoMirror = `-- name: UpdateInternalRepoMirror :one
+UPDATE internal_repos
+SET mirror_github_repo = $2, mirror_error = NULL, updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id, size_bytes, size_measured_at, secret_scan_bypass, mirror_github_repo, mirrored_at, mirror_error
+`
+
+type UpdateInternalRepoMirrorParams struct {
+	ID               string  `json:"id"`
+	MirrorGithubRepo *string `json:"mirror_github_repo"`
+}
+
+func (q *Queries) UpdateInternalRepoMirror(ctx context.Context, arg UpdateInternalRepoMirrorParams) (InternalRepo, error) {
+	row := q.db.QueryRow(ctx, updateInternalRepoMirror, arg.ID, arg.MirrorGithubRepo)
+	var i InternalRepo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CloneUrl,
+		&i.Provider,
+		&i.RepoID,
+		&i.FullName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BarePath,
+		&i.ProjectID,
+		&i.SizeBytes,
+		&i.SizeMeasuredAt,
+		&i.SecretScanBypass,
+		&i.MirrorGithubRepo,
+		&i.MirroredAt,
+		&i.MirrorError,
+	)
+	return i, err
+}
+
+const updateInternalRepoSecretScanBypass = `-- name: UpdateInternalRepoSecretScanBypass :one
+UPDATE internal_repos
+SET secret_scan_bypass = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_id, name, clone_url, provider, repo_id, full_name, created_at, updated_at, bare_path, project_id, size_bytes, size_measured_at, secret_scan_bypass, mirror_github_repo, mirrored_at, mirror_error
+`
+
+type UpdateInternalRepoSecretScanBypassParams struct {
+	ID               string `json:"id"`
+	SecretScanBypass bool   `json:"secret_scan_bypass"`
+}
+
+func (q *Queries) UpdateInternalRepoSecretScanBypass(ctx context.Context, arg UpdateInternalRepoSecretScanBypassParams) (InternalRepo, error) {
+	row := q.db.QueryRow(ctx, updateInternalRepoSecretScanBypass, arg.ID, arg.SecretScanBypass)
+	var i InternalRepo
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CloneUrl,
+		&i.Provider,
+		&i.RepoID,
+		&i.FullName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BarePath,
+		&i.ProjectID,
+		&i.SizeBytes,
+		&i.SizeMeasuredAt,
+		&i.SecretScanBypass,
+		&i.MirrorGithubRepo,
+		&i.MirroredAt,
+		&i.MirrorError,
+	)
+	return i, err
+}
+
+const updateInternalRepoSize = `-- name: UpdateInternalRepoSize :exec
+UPDATE internal_repos
+SET size_bytes = $2, size_measured_at = NOW()
+WHERE full_name = $1
+`
+
+type UpdateInternalRepoSizeParams struct {
+	FullName  string `json:"full_name"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+func (q *Queries) UpdateInternalRepoSize(ctx context.Context, arg UpdateInternalRepoSizeParams) error {
+	_, err := q.db.Exec(ctx, updateInternalRepoSize, arg.FullName, arg.SizeBytes)
+	return err
+}
@@ -10,12 +10,18 @@ import (
 
 type Querier interface {
 	CreateInternalRepo(ctx context.Context, arg CreateInternalRepoParams) (InternalRepo, error)
+	CreateSecretScanFinding(ctx context.Context, arg CreateSecretScanFindingParams) error
 	DeleteInternalRepo(ctx context.Context, id string) error
 	DeleteInternalRepoByFullName(ctx context.Context, fullName string) error
 	GetInternalRepoByFullName(ctx context.Context, fullName string) (InternalRepo, error)
 	GetInternalRepoByID(ctx context.Context, id string) (InternalRepo, error)
 	GetInternalRepoByProjectAndName(ctx context.Context, arg GetInternalRepoByProjectAndNameParams) (InternalRepo, error)
 	ListInternalReposByUserID(ctx context.Context, userID string) ([]InternalRepo, error)
+	MarkInternalRepoMirrored(ctx context.Context, fullName string) error
+	SetInternalRepoMirrorError(ctx context.Context, arg SetInternalRepoMirrorErrorParams) error
+	UpdateInternalRepoMirror(ctx context.Context, arg UpdateInternalRepoMirrorParams) (InternalRepo, error)
+	UpdateInternalRepoSecretScanBypass(ctx context.Context, arg UpdateInternalRepoSecretScanBypassParams) (InternalRepo, error)
+	UpdateInternalRepoSize(ctx context.Context, arg UpdateInternalRepoSizeParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -9,6 +9,9 @@ import (
 )
 
 type Querier interface {
+	AllocateServicePort(ctx context.Context, arg AllocateServicePortParams) (PortAllocation, error)
+	ClearServiceSleeping(ctx context.Context, id string) error
+	ClearServiceStopped(ctx context.Context, id string) error
 	CreateService(ctx context.Context, arg CreateServiceParams) (Service, error)
 	DeleteService(ctx context.Context, id string) error
 	GetServiceByID(ctx context.Context, id string) (Service, error)
@@ -17,11 +20,26 @@ type Querier interface {
 	GetServiceMetricsContext(ctx context.Context, arg GetServiceMetricsContextParams) (GetServiceMetricsContextRow, error)
 	GetServicesByRepoBranch(ctx context.Context, arg GetServicesByRepoBranchParams) ([]Service, error)
 	GetServicesByRepoBranchProvider(ctx context.Context, arg GetServicesByRepoBranchProviderParams) ([]Service, error)
+	GetTagServicesByRepoProvider(ctx context.Context, arg GetTagServicesByRepoProviderParams) ([]Service, error)
+	ListPortAllocationsByServiceID(ctx context.Context, serviceID string) ([]PortAllocation, error)
+	ListRegistryGCCandidatesByRegion(ctx context.Context, region string) ([]Service, error)
+	ListServicesByName(ctx context.Context, name *string) ([]Service, error)
 	ListServicesByProjectID(ctx context.Context, arg ListServicesByProjectIDParams) ([]Service, error)
 	ListServicesByProjectIDs(ctx context.Context, dollar_1 []string) ([]Service, error)
 	ListServicesByUserID(ctx context.Context, arg ListServicesByUserIDParams) ([]Service, error)
+	ListSleepCandidatesByRegion(ctx context.Context, region string) ([]Service, error)
+	MarkServiceRegistryPurged(ctx context.Context, id string) error
+	MarkServiceSleeping(ctx context.Context, id string) error
+	ReleaseServicePort(ctx context.Context, arg ReleaseServicePortParams) error
+	ReleaseServicePorts(ctx context.Context, serviceID string) error
 	SetCurrentDeploymentID(ctx context.Context, arg SetCurrentDeploymentIDParams) error
+	SetServiceDeployPolicy(ctx context.Context, arg SetServiceDeployPolicyParams) error
 	SetServiceFQDN(ctx context.Context, arg SetServiceFQDNParams) error
+	SetServiceImage(ctx context.Context, arg SetServiceImageParams) error
+	SetServicePorts(ctx context.Context, arg SetServicePortsParams) error
+	SetServiceSleepAfterIdle(ctx context.Context, arg SetServiceSleepAfterIdleParams) error
+	SetServiceStopped(ctx context.Context, id string) error
+	SetServiceTagPattern(ctx context.Context, arg SetServiceTagPatternParams) error
 	SoftDeleteService(ctx context.Context, id string) (Service, error)
 	UpdateServiceConfig(ctx context.Context, arg UpdateServiceConfigParams) (Service, error)
 }
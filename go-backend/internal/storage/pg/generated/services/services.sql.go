@@ -9,13 +9,80 @@ import (
 	"context"
 )
 
+const allocateServicePort = `-- name: AllocateServicePort :one
+INSERT INTO port_allocations (region, protocol, public_port, service_id, target_port)
+SELECT $1::text, $2::text, candidate, $3::text, $4::int
+FROM generate_series($5::int, $6::int) AS candidate
+WHERE NOT EXISTS (
+    SELECT 1 FROM port_allocations pa
+    WHERE pa.region = $1::text
+      AND pa.public_port = candidate
+)
+ORDER BY candidate
+LIMIT 1
+ON CONFLICT DO NOTHING
+RETURNING region, protocol, public_port, service_id, target_port, created_at
+`
+
+type AllocateServicePortParams struct {
+	Region     string `json:"region"`
+	Protocol   string `json:"protocol"`
+	ServiceID  string `json:"service_id"`
+	TargetPort int32  `json:"target_port"`
+	RangeStart int32  `json:"range_start"`
+	RangeEnd   int32  `json:"range_end"`
+}
+
+func (q *Queries) AllocateServicePort(ctx context.Context, arg AllocateServicePortParams) (PortAllocation, error) {
+	row := q.db.QueryRow(ctx, allocateServicePort,
+		arg.Region,
+		arg.Protocol,
+		arg.ServiceID,
+		arg.TargetPort,
+		arg.RangeStart,
+		arg.RangeEnd,
+	)
+	var i PortAllocation
+	err := row.Scan(
+		&i.Region,
+		&i.Protocol,
+		&i.PublicPort,
+		&i.ServiceID,
+		&i.TargetPort,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const clearServiceSleeping = `-- name: ClearServiceSleeping :exec
+UPDATE services
+SET sleeping_at = NULL
+WHERE id = $1 AND sleeping_at IS NOT NULL
+`
+
+func (q *Queries) ClearServiceSleeping(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, clearServiceSleeping, id)
+	return err
+}
+
+const clearServiceStopped = `-- name: ClearServiceStopped :exec
+UPDATE services
+SET stopped_at = NULL
+WHERE id = $1 AND stopped_at IS NOT NULL
+`
+
+func (q *Queries) ClearServiceStopped(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, clearServiceStopped, id)
+	return err
+}
+
 const createService = `-- name: CreateService :one
 INSERT INTO services (
     id, user_id, project_id, repo, branch, server_uuid, name, build_pack, port, env_vars, git_provider, build_config, memory, vcpus, region
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 )
-RETURNING id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region
+RETURNING id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern
 `
 
 type CreateServiceParams struct {
@@ -78,6 +145,17 @@ func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (S
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Region,
+		&i.AccessPolicy,
+		&i.Ports,
+		&i.SleepAfterIdle,
+		&i.SleepingAt,
+		&i.StoppedAt,
+		&i.Image,
+		&i.RegistryCredentials,
+		&i.RegistryPurgedAt,
+		&i.DeployPolicy,
+		&i.DeployDebounce,
+		&i.TagPattern,
 	)
 	return i, err
 }
@@ -92,7 +170,7 @@ func (q *Queries) DeleteService(ctx context.Context, id string) error {
 }
 
 const getServiceByID = `-- name: GetServiceByID :one
-SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region FROM services WHERE id = $1 AND is_deleted = false
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services WHERE id = $1 AND is_deleted = false
 `
 
 func (q *Queries) GetServiceByID(ctx context.Context, id string) (Service, error) {
@@ -121,12 +199,23 @@ func (q *Queries) GetServiceByID(ctx context.Context, id string) (Service, error
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Region,
+		&i.AccessPolicy,
+		&i.Ports,
+		&i.SleepAfterIdle,
+		&i.SleepingAt,
+		&i.StoppedAt,
+		&i.Image,
+		&i.RegistryCredentials,
+		&i.RegistryPurgedAt,
+		&i.DeployPolicy,
+		&i.DeployDebounce,
+		&i.TagPattern,
 	)
 	return i, err
 }
 
 const getServiceByNameAndProject = `-- name: GetServiceByNameAndProject :one
-SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region FROM services
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
 WHERE name = $1 AND project_id = $2 AND is_deleted = false
 `
 
@@ -161,12 +250,23 @@ func (q *Queries) GetServiceByNameAndProject(ctx context.Context, arg GetService
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Region,
+		&i.AccessPolicy,
+		&i.Ports,
+		&i.SleepAfterIdle,
+		&i.SleepingAt,
+		&i.StoppedAt,
+		&i.Image,
+		&i.RegistryCredentials,
+		&i.RegistryPurgedAt,
+		&i.DeployPolicy,
+		&i.DeployDebounce,
+		&i.TagPattern,
 	)
 	return i, err
 }
 
 const getServiceByNameAndUserProject = `-- name: GetServiceByNameAndUserProject :one
-SELECT a.id, a.user_id, a.project_id, a.repo, a.branch, a.git_provider, a.name, a.port, a.build_pack, a.env_vars, a.build_config, a.memory, a.vcpus, a.publish_directory, a.fqdn, a.custom_domain, a.server_uuid, a.current_deployment_id, a.is_deleted, a.created_at, a.updated_at, a.region FROM services a
+SELECT a.id, a.user_id, a.project_id, a.repo, a.branch, a.git_provider, a.name, a.port, a.build_pack, a.env_vars, a.build_config, a.memory, a.vcpus, a.publish_directory, a.fqdn, a.custom_domain, a.server_uuid, a.current_deployment_id, a.is_deleted, a.created_at, a.updated_at, a.region, a.access_policy, a.ports, a.sleep_after_idle, a.sleeping_at, a.stopped_at, a.image, a.registry_credentials, a.registry_purged_at, a.deploy_policy, a.deploy_debounce, a.tag_pattern FROM services a
 JOIN projects p ON a.project_id = p.id
 WHERE a.name = $1
   AND p.user_id = $2
@@ -208,6 +308,17 @@ func (q *Queries) GetServiceByNameAndUserProject(ctx context.Context, arg GetSer
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Region,
+		&i.AccessPolicy,
+		&i.Ports,
+		&i.SleepAfterIdle,
+		&i.SleepingAt,
+		&i.StoppedAt,
+		&i.Image,
+		&i.RegistryCredentials,
+		&i.RegistryPurgedAt,
+		&i.DeployPolicy,
+		&i.DeployDebounce,
+		&i.TagPattern,
 	)
 	return i, err
 }
@@ -247,8 +358,8 @@ func (q *Queries) GetServiceMetricsContext(ctx context.Context, arg GetServiceMe
 }
 
 const getServicesByRepoBranch = `-- name: GetServicesByRepoBranch :many
-SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region FROM services
-WHERE repo = $1 AND branch = $2 AND is_deleted = false
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
+WHERE repo = $1 AND branch = $2 AND tag_pattern IS NULL AND is_deleted = false
 `
 
 type GetServicesByRepoBranchParams struct {
@@ -288,6 +399,17 @@ func (q *Queries) GetServicesByRepoBranch(ctx context.Context, arg GetServicesBy
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
 		); err != nil {
 			return nil, err
 		}
@@ -300,8 +422,8 @@ func (q *Queries) GetServicesByRepoBranch(ctx context.Context, arg GetServicesBy
 }
 
 const getServicesByRepoBranchProvider = `-- name: GetServicesByRepoBranchProvider :many
-SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region FROM services
-WHERE repo = $1 AND branch = $2 AND git_provider = $3 AND is_deleted = false
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
+WHERE repo = $1 AND branch = $2 AND git_provider = $3 AND tag_pattern IS NULL AND is_deleted = false
 `
 
 type GetServicesByRepoBranchProviderParams struct {
@@ -342,6 +464,233 @@ func (q *Queries) GetServicesByRepoBranchProvider(ctx context.Context, arg GetSe
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTagServicesByRepoProvider = `-- name: GetTagServicesByRepoProvider :many
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
+WHERE repo = $1 AND git_provider = $2 AND tag_pattern IS NOT NULL AND is_deleted = false
+`
+
+type GetTagServicesByRepoProviderParams struct {
+	Repo        string `json:"repo"`
+	GitProvider string `json:"git_provider"`
+}
+
+func (q *Queries) GetTagServicesByRepoProvider(ctx context.Context, arg GetTagServicesByRepoProviderParams) ([]Service, error) {
+	rows, err := q.db.Query(ctx, getTagServicesByRepoProvider, arg.Repo, arg.GitProvider)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Service{}
+	for rows.Next() {
+		var i Service
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProjectID,
+			&i.Repo,
+			&i.Branch,
+			&i.GitProvider,
+			&i.Name,
+			&i.Port,
+			&i.BuildPack,
+			&i.EnvVars,
+			&i.BuildConfig,
+			&i.Memory,
+			&i.Vcpus,
+			&i.PublishDirectory,
+			&i.Fqdn,
+			&i.CustomDomain,
+			&i.ServerUuid,
+			&i.CurrentDeploymentID,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPortAllocationsByServiceID = `-- name: ListPortAllocationsByServiceID :many
+SELECT region, protocol, public_port, service_id, target_port, created_at FROM port_allocations
+WHERE service_id = $1
+ORDER BY protocol, target_port
+`
+
+func (q *Queries) ListPortAllocationsByServiceID(ctx context.Context, serviceID string) ([]PortAllocation, error) {
+	rows, err := q.db.Query(ctx, listPortAllocationsByServiceID, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PortAllocation{}
+	for rows.Next() {
+		var i PortAllocation
+		if err := rows.Scan(
+			&i.Region,
+			&i.Protocol,
+			&i.PublicPort,
+			&i.ServiceID,
+			&i.TargetPort,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegistryGCCandidatesByRegion = `-- name: ListRegistryGCCandidatesByRegion :many
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
+WHERE region = $1
+  AND (is_deleted = false OR registry_purged_at IS NULL)
+`
+
+func (q *Queries) ListRegistryGCCandidatesByRegion(ctx context.Context, region string) ([]Service, error) {
+	rows, err := q.db.Query(ctx, listRegistryGCCandidatesByRegion, region)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Service{}
+	for rows.Next() {
+		var i Service
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProjectID,
+			&i.Repo,
+			&i.Branch,
+			&i.GitProvider,
+			&i.Name,
+			&i.Port,
+			&i.BuildPack,
+			&i.EnvVars,
+			&i.BuildConfig,
+			&i.Memory,
+			&i.Vcpus,
+			&i.PublishDirectory,
+			&i.Fqdn,
+			&i.CustomDomain,
+			&i.ServerUuid,
+			&i.CurrentDeploymentID,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServicesByName = `-- name: ListServicesByName :many
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
+WHERE name = $1 AND is_deleted = false
+`
+
+func (q *Queries) ListServicesByName(ctx context.Context, name *string) ([]Service, error) {
+	rows, err := q.db.Query(ctx, listServicesByName, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Service{}
+	for rows.Next() {
+		var i Service
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProjectID,
+			&i.Repo,
+			&i.Branch,
+			&i.GitProvider,
+			&i.Name,
+			&i.Port,
+			&i.BuildPack,
+			&i.EnvVars,
+			&i.BuildConfig,
+			&i.Memory,
+			&i.Vcpus,
+			&i.PublishDirectory,
+			&i.Fqdn,
+			&i.CustomDomain,
+			&i.ServerUuid,
+			&i.CurrentDeploymentID,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
 		); err != nil {
 			return nil, err
 		}
@@ -354,7 +703,7 @@ func (q *Queries) GetServicesByRepoBranchProvider(ctx context.Context, arg GetSe
 }
 
 const listServicesByProjectID = `-- name: ListServicesByProjectID :many
-SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region FROM services
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
 WHERE project_id = $1 AND is_deleted = false
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
@@ -398,6 +747,17 @@ func (q *Queries) ListServicesByProjectID(ctx context.Context, arg ListServicesB
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
 		); err != nil {
 			return nil, err
 		}
@@ -410,7 +770,7 @@ func (q *Queries) ListServicesByProjectID(ctx context.Context, arg ListServicesB
 }
 
 const listServicesByProjectIDs = `-- name: ListServicesByProjectIDs :many
-SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region FROM services
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
 WHERE project_id = ANY($1::text[]) AND is_deleted = false
 ORDER BY created_at DESC
 `
@@ -447,6 +807,17 @@ func (q *Queries) ListServicesByProjectIDs(ctx context.Context, dollar_1 []strin
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
 		); err != nil {
 			return nil, err
 		}
@@ -459,7 +830,7 @@ func (q *Queries) ListServicesByProjectIDs(ctx context.Context, dollar_1 []strin
 }
 
 const listServicesByUserID = `-- name: ListServicesByUserID :many
-SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region FROM services
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
 WHERE user_id = $1 AND is_deleted = false
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
@@ -503,6 +874,81 @@ func (q *Queries) ListServicesByUserID(ctx context.Context, arg ListServicesByUs
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSleepCandidatesByRegion = `-- name: ListSleepCandidatesByRegion :many
+SELECT id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern FROM services
+WHERE region = $1
+  AND sleep_after_idle IS NOT NULL
+  AND sleeping_at IS NULL
+  AND stopped_at IS NULL
+  AND current_deployment_id IS NOT NULL
+  AND is_deleted = false
+`
+
+func (q *Queries) ListSleepCandidatesByRegion(ctx context.Context, region string) ([]Service, error) {
+	rows, err := q.db.Query(ctx, listSleepCandidatesByRegion, region)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Service{}
+	for rows.Next() {
+		var i Service
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProjectID,
+			&i.Repo,
+			&i.Branch,
+			&i.GitProvider,
+			&i.Name,
+			&i.Port,
+			&i.BuildPack,
+			&i.EnvVars,
+			&i.BuildConfig,
+			&i.Memory,
+			&i.Vcpus,
+			&i.PublishDirectory,
+			&i.Fqdn,
+			&i.CustomDomain,
+			&i.ServerUuid,
+			&i.CurrentDeploymentID,
+			&i.IsDeleted,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Region,
+			&i.AccessPolicy,
+			&i.Ports,
+			&i.SleepAfterIdle,
+			&i.SleepingAt,
+			&i.StoppedAt,
+			&i.Image,
+			&i.RegistryCredentials,
+			&i.RegistryPurgedAt,
+			&i.DeployPolicy,
+			&i.DeployDebounce,
+			&i.TagPattern,
 		); err != nil {
 			return nil, err
 		}
@@ -514,6 +960,54 @@ func (q *Queries) ListServicesByUserID(ctx context.Context, arg ListServicesByUs
 	return items, nil
 }
 
+const markServiceRegistryPurged = `-- name: MarkServiceRegistryPurged :exec
+UPDATE services
+SET registry_purged_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) MarkServiceRegistryPurged(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, markServiceRegistryPurged, id)
+	return err
+}
+
+const markServiceSleeping = `-- name: MarkServiceSleeping :exec
+UPDATE services
+SET sleeping_at = NOW()
+WHERE id = $1 AND is_deleted = false
+`
+
+func (q *Queries) MarkServiceSleeping(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, markServiceSleeping, id)
+	return err
+}
+
+const releaseServicePort = `-- name: ReleaseServicePort :exec
+DELETE FROM port_allocations
+WHERE service_id = $1 AND protocol = $2 AND target_port = $3
+`
+
+type ReleaseServicePortParams struct {
+	ServiceID  string `json:"service_id"`
+	Protocol   string `json:"protocol"`
+	TargetPort int32  `json:"target_port"`
+}
+
+func (q *Queries) ReleaseServicePort(ctx context.Context, arg ReleaseServicePortParams) error {
+	_, err := q.db.Exec(ctx, releaseServicePort, arg.ServiceID, arg.Protocol, arg.TargetPort)
+	return err
+}
+
+const releaseServicePorts = `-- name: ReleaseServicePorts :exec
+DELETE FROM port_allocations
+WHERE service_id = $1
+`
+
+func (q *Queries) ReleaseServicePorts(ctx context.Context, serviceID string) error {
+	_, err := q.db.Exec(ctx, releaseServicePorts, serviceID)
+	return err
+}
+
 const setCurrentDeploymentID = `-- name: SetCurrentDeploymentID :exec
 UPDATE services
 SET current_deployment_id = $2, updated_at = NOW()
@@ -530,6 +1024,23 @@ func (q *Queries) SetCurrentDeploymentID(ctx context.Context, arg SetCurrentDepl
 	return err
 }
 
+const setServiceDeployPolicy = `-- name: SetServiceDeployPolicy :exec
+UPDATE services
+SET deploy_policy = $2, deploy_debounce = $3, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false
+`
+
+type SetServiceDeployPolicyParams struct {
+	ID             string `json:"id"`
+	DeployPolicy   string `json:"deploy_policy"`
+	DeployDebounce *int32 `json:"deploy_debounce"`
+}
+
+func (q *Queries) SetServiceDeployPolicy(ctx context.Context, arg SetServiceDeployPolicyParams) error {
+	_, err := q.db.Exec(ctx, setServiceDeployPolicy, arg.ID, arg.DeployPolicy, arg.DeployDebounce)
+	return err
+}
+
 const setServiceFQDN = `-- name: SetServiceFQDN :exec
 UPDATE services
 SET fqdn = $2, updated_at = NOW()
@@ -546,11 +1057,87 @@ func (q *Queries) SetServiceFQDN(ctx context.Context, arg SetServiceFQDNParams)
 	return err
 }
 
+const setServiceImage = `-- name: SetServiceImage :exec
+UPDATE services
+SET image = $2, registry_credentials = $3, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false
+`
+
+type SetServiceImageParams struct {
+	ID                  string  `json:"id"`
+	Image               *string `json:"image"`
+	RegistryCredentials *string `json:"registry_credentials"`
+}
+
+func (q *Queries) SetServiceImage(ctx context.Context, arg SetServiceImageParams) error {
+	_, err := q.db.Exec(ctx, setServiceImage, arg.ID, arg.Image, arg.RegistryCredentials)
+	return err
+}
+
+const setServicePorts = `-- name: SetServicePorts :exec
+UPDATE services
+SET ports = $2, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false
+`
+
+type SetServicePortsParams struct {
+	ID    string `json:"id"`
+	Ports []byte `json:"ports"`
+}
+
+func (q *Queries) SetServicePorts(ctx context.Context, arg SetServicePortsParams) error {
+	_, err := q.db.Exec(ctx, setServicePorts, arg.ID, arg.Ports)
+	return err
+}
+
+const setServiceSleepAfterIdle = `-- name: SetServiceSleepAfterIdle :exec
+UPDATE services
+SET sleep_after_idle = $2, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false
+`
+
+type SetServiceSleepAfterIdleParams struct {
+	ID             string `json:"id"`
+	SleepAfterIdle *int32 `json:"sleep_after_idle"`
+}
+
+func (q *Queries) SetServiceSleepAfterIdle(ctx context.Context, arg SetServiceSleepAfterIdleParams) error {
+	_, err := q.db.Exec(ctx, setServiceSleepAfterIdle, arg.ID, arg.SleepAfterIdle)
+	return err
+}
+
+const setServiceStopped = `-- name: SetServiceStopped :exec
+UPDATE services
+SET stopped_at = NOW(), sleeping_at = NULL
+WHERE id = $1 AND is_deleted = false
+`
+
+func (q *Queries) SetServiceStopped(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, setServiceStopped, id)
+	return err
+}
+
+const setServiceTagPattern = `-- name: SetServiceTagPattern :exec
+UPDATE services
+SET tag_pattern = $2, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false
+`
+
+type SetServiceTagPatternParams struct {
+	ID         string  `json:"id"`
+	TagPattern *string `json:"tag_pattern"`
+}
+
+func (q *Queries) SetServiceTagPattern(ctx context.Context, arg SetServiceTagPatternParams) error {
+	_, err := q.db.Exec(ctx, setServiceTagPattern, arg.ID, arg.TagPattern)
+	return err
+}
+
 const softDeleteService = `-- name: SoftDeleteService :one
 UPDATE services
 SET is_deleted = true, updated_at = NOW()
 WHERE id = $1 AND is_deleted = false
-RETURNING id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region
+RETURNING id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern
 `
 
 func (q *Queries) SoftDeleteService(ctx context.Context, id string) (Service, error) {
@@ -579,6 +1166,17 @@ func (q *Queries) SoftDeleteService(ctx context.Context, id string) (Service, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Region,
+		&i.AccessPolicy,
+		&i.Ports,
+		&i.SleepAfterIdle,
+		&i.SleepingAt,
+		&i.StoppedAt,
+		&i.Image,
+		&i.RegistryCredentials,
+		&i.RegistryPurgedAt,
+		&i.DeployPolicy,
+		&i.DeployDebounce,
+		&i.TagPattern,
 	)
 	return i, err
 }
@@ -594,22 +1192,28 @@ UPDATE services SET
     build_config = $7,
     memory = $8,
     vcpus = $9,
+    access_policy = $10,
+    ports = $11,
+    sleep_after_idle = $12,
     updated_at = NOW()
-WHERE id = $10 AND is_deleted = false
-RETURNING id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region
+WHERE id = $13 AND is_deleted = false
+RETURNING id, user_id, project_id, repo, branch, git_provider, name, port, build_pack, env_vars, build_config, memory, vcpus, publish_directory, fqdn, custom_domain, server_uuid, current_deployment_id, is_deleted, created_at, updated_at, region, access_policy, ports, sleep_after_idle, sleeping_at, stopped_at, image, registry_credentials, registry_purged_at, deploy_policy, deploy_debounce, tag_pattern
 `
 
 type UpdateServiceConfigParams struct {
-	Repo        string `json:"repo"`
-	Branch      string `json:"branch"`
-	GitProvider string `json:"git_provider"`
-	Port        string `json:"port"`
-	EnvVars     []byte `json:"env_vars"`
-	BuildPack   string `json:"build_pack"`
-	BuildConfig []byte `json:"build_config"`
-	Memory      string `json:"memory"`
-	Vcpus       string `json:"vcpus"`
-	ID          string `json:"id"`
+	Repo           string `json:"repo"`
+	Branch         string `json:"branch"`
+	GitProvider    string `json:"git_provider"`
+	Port           string `json:"port"`
+	EnvVars        []byte `json:"env_vars"`
+	BuildPack      string `json:"build_pack"`
+	BuildConfig    []byte `json:"build_config"`
+	Memory         string `json:"memory"`
+	Vcpus          string `json:"vcpus"`
+	AccessPolicy   []byte `json:"access_policy"`
+	Ports          []byte `json:"ports"`
+	SleepAfterIdle *int32 `json:"sleep_after_idle"`
+	ID             string `json:"id"`
 }
 
 func (q *Queries) UpdateServiceConfig(ctx context.Context, arg UpdateServiceConfigParams) (Service, error) {
@@ -623,6 +1227,9 @@ func (q *Queries) UpdateServiceConfig(ctx context.Context, arg UpdateServiceConf
 		arg.BuildConfig,
 		arg.Memory,
 		arg.Vcpus,
+		arg.AccessPolicy,
+		arg.Ports,
+		arg.SleepAfterIdle,
 		arg.ID,
 	)
 	var i Service
@@ -649,6 +1256,17 @@ func (q *Queries) UpdateServiceConfig(ctx context.Context, arg UpdateServiceConf
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Region,
+		&i.AccessPolicy,
+		&i.Ports,
+		&i.SleepAfterIdle,
+		&i.SleepingAt,
+		&i.StoppedAt,
+		&i.Image,
+		&i.RegistryCredentials,
+		&i.RegistryPurgedAt,
+		&i.DeployPolicy,
+		&i.DeployDebounce,
+		&i.TagPattern,
 	)
 	return i, err
 }
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE services ADD COLUMN access_policy JSONB;
+
+-- +goose Down
+ALTER TABLE services DROP COLUMN IF EXISTS access_policy;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE project_routes (
+    id TEXT PRIMARY KEY,
+    project_id TEXT NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    service_id TEXT NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    host TEXT NOT NULL,
+    path_prefix TEXT NOT NULL DEFAULT '/',
+    strip_prefix BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(host, path_prefix)
+);
+CREATE INDEX idx_project_routes_project_id ON project_routes(project_id);
+CREATE INDEX idx_project_routes_service_id ON project_routes(service_id);
+CREATE INDEX idx_project_routes_host ON project_routes(host);
+
+-- +goose Down
+DROP TABLE IF EXISTS project_routes;
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE services ADD COLUMN ports JSONB;
+
+CREATE TABLE port_allocations (
+    region TEXT NOT NULL REFERENCES clusters(region),
+    protocol TEXT NOT NULL,
+    public_port INT NOT NULL,
+    service_id TEXT NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    target_port INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (region, protocol, public_port),
+    UNIQUE (service_id, protocol, target_port),
+    CONSTRAINT valid_port_protocol CHECK (protocol IN ('tcp', 'udp'))
+);
+CREATE INDEX idx_port_allocations_service_id ON port_allocations(service_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS port_allocations;
+ALTER TABLE services DROP COLUMN IF EXISTS ports;
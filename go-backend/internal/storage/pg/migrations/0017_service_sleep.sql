@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE services ADD COLUMN sleep_after_idle INT;
+ALTER TABLE services ADD COLUMN sleeping_at TIMESTAMPTZ;
+CREATE INDEX idx_services_sleep_candidates ON services(region)
+    WHERE sleep_after_idle IS NOT NULL AND is_deleted = false;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_services_sleep_candidates;
+ALTER TABLE services DROP COLUMN IF EXISTS sleeping_at;
+ALTER TABLE services DROP COLUMN IF EXISTS sleep_after_idle;
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE services ADD COLUMN stopped_at TIMESTAMPTZ;
+
+CREATE TABLE service_events (
+    id TEXT PRIMARY KEY,
+    service_id TEXT NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    action TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'queued',
+    workflow_id TEXT NOT NULL,
+    error_message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ,
+    CONSTRAINT valid_action CHECK (action IN ('stop', 'start', 'restart')),
+    CONSTRAINT valid_status CHECK (status IN ('queued', 'completed', 'failed'))
+);
+
+CREATE INDEX idx_service_events_service_id ON service_events(service_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS service_events;
+ALTER TABLE services DROP COLUMN IF EXISTS stopped_at;
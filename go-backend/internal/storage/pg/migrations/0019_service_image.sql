@@ -0,0 +1,9 @@
+-- +goose Up
+-- Services with build_pack = 'image' deploy a prebuilt image instead of
+-- building from git. registry_credentials is AES-GCM encrypted JSON.
+ALTER TABLE services ADD COLUMN image TEXT;
+ALTER TABLE services ADD COLUMN registry_credentials TEXT;
+
+-- +goose Down
+ALTER TABLE services DROP COLUMN IF EXISTS registry_credentials;
+ALTER TABLE services DROP COLUMN IF EXISTS image;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- Set once the registry GC has removed a deleted service's image
+-- repositories, so later runs skip it.
+ALTER TABLE services ADD COLUMN registry_purged_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE services DROP COLUMN IF EXISTS registry_purged_at;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- How new triggers interact with a deploy already in flight:
+-- cancel_previous, queue or debounce (wait deploy_debounce seconds for
+-- more pushes before deploying the latest one).
+ALTER TABLE services ADD COLUMN deploy_policy TEXT NOT NULL DEFAULT 'cancel_previous';
+ALTER TABLE services ADD COLUMN deploy_debounce INTEGER;
+
+-- +goose Down
+ALTER TABLE services DROP COLUMN IF EXISTS deploy_debounce;
+ALTER TABLE services DROP COLUMN IF EXISTS deploy_policy;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Triggers that did not produce a deployment, e.g. a push that touched
+-- none of a service's watch_paths.
+CREATE TABLE trigger_events (
+    id TEXT PRIMARY KEY,
+    service_id TEXT NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    trigger TEXT NOT NULL,
+    trigger_ref TEXT,
+    status TEXT NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT valid_status CHECK (status IN ('skipped'))
+);
+
+CREATE INDEX idx_trigger_events_service_id ON trigger_events(service_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS trigger_events;
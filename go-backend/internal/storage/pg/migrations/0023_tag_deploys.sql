@@ -0,0 +1,10 @@
+-- +goose Up
+-- Release services deploy only when a tag matching tag_pattern (e.g. 'v*')
+-- is pushed; branch pushes leave them alone. NULL tracks the branch.
+ALTER TABLE services ADD COLUMN tag_pattern TEXT;
+-- Tag a deployment was released from, alongside its commit_hash
+ALTER TABLE deployments ADD COLUMN git_tag TEXT;
+
+-- +goose Down
+ALTER TABLE deployments DROP COLUMN IF EXISTS git_tag;
+ALTER TABLE services DROP COLUMN IF EXISTS tag_pattern;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- ink.yaml read from the repo at build time, as validated JSON. The other
+-- snapshot columns (build_pack, build_config, port, memory, vcpus) hold the
+-- effective config after the manifest was merged over the service row.
+ALTER TABLE deployments ADD COLUMN manifest JSONB;
+
+-- +goose Down
+ALTER TABLE deployments DROP COLUMN IF EXISTS manifest;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Size of the bare repo on the git server's disk, measured after pushes
+-- and by the nightly maintenance run.
+ALTER TABLE internal_repos ADD COLUMN size_bytes BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE internal_repos ADD COLUMN size_measured_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE internal_repos DROP COLUMN IF EXISTS size_measured_at;
+ALTER TABLE internal_repos DROP COLUMN IF EXISTS size_bytes;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Pushes to internal repos are scanned for secrets. A repo with
+-- secret_scan_bypass set still has its pushes scanned and recorded, but
+-- not rejected.
+ALTER TABLE internal_repos ADD COLUMN secret_scan_bypass BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE secret_scan_findings (
+    id TEXT PRIMARY KEY,
+    repo_full_name TEXT NOT NULL,
+    user_id TEXT,
+    rule TEXT NOT NULL,
+    path TEXT NOT NULL,
+    line INTEGER NOT NULL,
+    blob_sha TEXT NOT NULL,
+    bypassed BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_secret_scan_findings_repo ON secret_scan_findings(repo_full_name, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS secret_scan_findings;
+ALTER TABLE internal_repos DROP COLUMN IF EXISTS secret_scan_bypass;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- An internal repo can be push mirrored to a GitHub repo (owner/name).
+-- The git server pushes after every accepted push and records the outcome.
+ALTER TABLE internal_repos ADD COLUMN mirror_github_repo TEXT;
+ALTER TABLE internal_repos ADD COLUMN mirrored_at TIMESTAMPTZ;
+ALTER TABLE internal_repos ADD COLUMN mirror_error TEXT;
+
+-- +goose Down
+ALTER TABLE internal_repos DROP COLUMN IF EXISTS mirror_error;
+ALTER TABLE internal_repos DROP COLUMN IF EXISTS mirrored_at;
+ALTER TABLE internal_repos DROP COLUMN IF EXISTS mirror_github_repo;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Public keys for pushing and pulling internal repos over SSH. A key works
+-- for every repo its user owns.
+CREATE TABLE ssh_keys (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    fingerprint TEXT NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_ssh_keys_fingerprint ON ssh_keys(fingerprint);
+CREATE INDEX idx_ssh_keys_user_id ON ssh_keys(user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS ssh_keys;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- A NodePort number is taken cluster-wide whatever the protocol, so a
+-- region's public ports must be unique across tcp and udp.
+CREATE UNIQUE INDEX idx_port_allocations_region_public_port ON port_allocations(region, public_port);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_port_allocations_region_public_port;
@@ -1,9 +1,9 @@
 -- name: CreateDeployment :one
 INSERT INTO deployments (
     id, service_id, workflow_id, build_pack, build_config, env_vars_snapshot,
-    memory, vcpus, port, trigger, trigger_ref, commit_hash
+    memory, vcpus, port, trigger, trigger_ref, commit_hash, git_tag
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 )
 RETURNING *;
 
@@ -29,6 +29,14 @@ WHERE service_id = $1
 ORDER BY created_at DESC
 LIMIT 1;
 
+-- name: ListRecentImageRefsByServiceID :many
+SELECT image_ref FROM deployments
+WHERE service_id = $1
+  AND status IN ('active', 'superseded')
+  AND image_ref IS NOT NULL
+ORDER BY created_at DESC
+LIMIT $2;
+
 -- name: UpdateDeploymentBuilding :exec
 UPDATE deployments
 SET status = 'building', started_at = NOW(), updated_at = NOW()
@@ -96,3 +104,35 @@ UPDATE deployments SET status = 'completed', finished_at = NOW(), updated_at = N
 
 -- name: MarkDeploymentRemoved :exec
 UPDATE deployments SET status = 'removed', finished_at = NOW(), updated_at = NOW() WHERE id = $1;
+
+-- name: CreateServiceEvent :one
+INSERT INTO service_events (id, service_id, action, workflow_id)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: MarkServiceEventCompleted :exec
+UPDATE service_events SET status = 'completed', finished_at = NOW() WHERE id = $1;
+
+-- name: MarkServiceEventFailed :exec
+UPDATE service_events SET status = 'failed', error_message = $2, finished_at = NOW() WHERE id = $1;
+
+-- name: ListServiceEventsByServiceID :many
+SELECT * FROM service_events
+WHERE service_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: CreateTriggerEvent :exec
+INSERT INTO trigger_events (id, service_id, trigger, trigger_ref, status, reason)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: SetDeploymentEffectiveConfig :exec
+UPDATE deployments
+SET build_pack = $2, build_config = $3, port = $4, memory = $5, vcpus = $6, manifest = $7, updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetCommitManifest :one
+SELECT manifest FROM deployments
+WHERE service_id = $1 AND commit_hash = $2 AND manifest IS NOT NULL
+ORDER BY created_at DESC
+LIMIT 1;
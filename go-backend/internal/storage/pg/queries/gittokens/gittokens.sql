@@ -7,9 +7,16 @@ RETURNING *;
 SELECT gt.*, ir.full_name AS repo_full_name
 FROM git_tokens gt
 LEFT JOIN internal_repos ir ON gt.repo_id = ir.id
-WHERE gt.token_hash = $1
+WHERE gt.token_hash = $1;
+
+-- name: ListActiveByUserID :many
+SELECT gt.*, ir.full_name AS repo_full_name
+FROM git_tokens gt
+LEFT JOIN internal_repos ir ON gt.repo_id = ir.id
+WHERE gt.user_id = $1
   AND gt.revoked_at IS NULL
-  AND (gt.expires_at IS NULL OR gt.expires_at > NOW());
+  AND (gt.expires_at IS NULL OR gt.expires_at > NOW())
+ORDER BY gt.created_at DESC;
 
 -- name: ListByRepoID :many
 SELECT * FROM git_tokens
@@ -26,6 +33,10 @@ ORDER BY created_at DESC;
 -- name: RevokeToken :exec
 UPDATE git_tokens SET revoked_at = NOW() WHERE id = $1;
 
+-- name: RevokeUserToken :execrows
+UPDATE git_tokens SET revoked_at = NOW()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+
 -- name: RevokeTokensByRepoID :exec
 UPDATE git_tokens SET revoked_at = NOW()
 WHERE repo_id = $1 AND revoked_at IS NULL;
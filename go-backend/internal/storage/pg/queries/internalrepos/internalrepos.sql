@@ -22,3 +22,34 @@ DELETE FROM internal_repos WHERE id = $1;
 
 -- name: DeleteInternalRepoByFullName :exec
 DELETE FROM internal_repos WHERE full_name = $1;
+
+-- name: UpdateInternalRepoSize :exec
+UPDATE internal_repos
+SET size_bytes = $2, size_measured_at = NOW()
+WHERE full_name = $1;
+
+-- name: UpdateInternalRepoSecretScanBypass :one
+UPDATE internal_repos
+SET secret_scan_bypass = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateSecretScanFinding :exec
+INSERT INTO secret_scan_findings (id, repo_full_name, user_id, rule, path, line, blob_sha, bypassed)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: UpdateInternalRepoMirror :one
+UPDATE internal_repos
+SET mirror_github_repo = $2, mirror_error = NULL, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: MarkInternalRepoMirrored :exec
+UPDATE internal_repos
+SET mirrored_at = NOW(), mirror_error = NULL
+WHERE full_name = $1;
+
+-- name: SetInternalRepoMirrorError :exec
+UPDATE internal_repos
+SET mirror_error = $2
+WHERE full_name = $1;
@@ -0,0 +1,26 @@
+-- name: CreateRoute :one
+INSERT INTO project_routes (id, project_id, service_id, host, path_prefix, strip_prefix)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListRoutesByProjectID :many
+SELECT * FROM project_routes
+WHERE project_id = $1
+ORDER BY host, path_prefix;
+
+-- name: ListRoutesByHost :many
+SELECT * FROM project_routes
+WHERE host = $1
+ORDER BY path_prefix;
+
+-- name: GetRouteByHostAndPath :one
+SELECT * FROM project_routes
+WHERE host = $1 AND path_prefix = $2;
+
+-- name: DeleteRoute :exec
+DELETE FROM project_routes WHERE id = $1;
+
+-- name: DeleteRoutesByServiceID :many
+DELETE FROM project_routes
+WHERE service_id = $1
+RETURNING *;
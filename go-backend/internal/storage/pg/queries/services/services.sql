@@ -32,7 +32,7 @@ RETURNING *;
 
 -- name: GetServicesByRepoBranch :many
 SELECT * FROM services
-WHERE repo = $1 AND branch = $2 AND is_deleted = false;
+WHERE repo = $1 AND branch = $2 AND tag_pattern IS NULL AND is_deleted = false;
 
 -- name: GetServiceByNameAndProject :one
 SELECT * FROM services
@@ -50,7 +50,7 @@ LIMIT 1;
 
 -- name: GetServicesByRepoBranchProvider :many
 SELECT * FROM services
-WHERE repo = $1 AND branch = $2 AND git_provider = $3 AND is_deleted = false;
+WHERE repo = $1 AND branch = $2 AND git_provider = $3 AND tag_pattern IS NULL AND is_deleted = false;
 
 -- name: SetCurrentDeploymentID :exec
 UPDATE services
@@ -78,6 +78,9 @@ UPDATE services SET
     build_config = @build_config,
     memory = @memory,
     vcpus = @vcpus,
+    access_policy = @access_policy,
+    ports = @ports,
+    sleep_after_idle = @sleep_after_idle,
     updated_at = NOW()
 WHERE id = @id AND is_deleted = false
 RETURNING *;
@@ -88,3 +91,102 @@ FROM services s
 JOIN users u ON u.id = s.user_id
 JOIN projects p ON p.id = s.project_id
 WHERE s.id = $1 AND s.user_id = $2 AND s.is_deleted = false;
+
+-- name: ListServicesByName :many
+SELECT * FROM services
+WHERE name = $1 AND is_deleted = false;
+
+-- name: SetServicePorts :exec
+UPDATE services
+SET ports = $2, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false;
+
+-- name: ListPortAllocationsByServiceID :many
+SELECT * FROM port_allocations
+WHERE service_id = $1
+ORDER BY protocol, target_port;
+
+-- name: AllocateServicePort :one
+INSERT INTO port_allocations (region, protocol, public_port, service_id, target_port)
+SELECT @region::text, @protocol::text, candidate, @service_id::text, @target_port::int
+FROM generate_series(@range_start::int, @range_end::int) AS candidate
+WHERE NOT EXISTS (
+    SELECT 1 FROM port_allocations pa
+    WHERE pa.region = @region::text
+      AND pa.public_port = candidate
+)
+ORDER BY candidate
+LIMIT 1
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: ReleaseServicePort :exec
+DELETE FROM port_allocations
+WHERE service_id = $1 AND protocol = $2 AND target_port = $3;
+
+-- name: ReleaseServicePorts :exec
+DELETE FROM port_allocations
+WHERE service_id = $1;
+
+-- name: SetServiceSleepAfterIdle :exec
+UPDATE services
+SET sleep_after_idle = $2, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false;
+
+-- name: GetTagServicesByRepoProvider :many
+SELECT * FROM services
+WHERE repo = $1 AND git_provider = $2 AND tag_pattern IS NOT NULL AND is_deleted = false;
+
+-- name: SetServiceTagPattern :exec
+UPDATE services
+SET tag_pattern = $2, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false;
+
+-- name: SetServiceDeployPolicy :exec
+UPDATE services
+SET deploy_policy = $2, deploy_debounce = $3, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false;
+
+-- name: ListSleepCandidatesByRegion :many
+SELECT * FROM services
+WHERE region = $1
+  AND sleep_after_idle IS NOT NULL
+  AND sleeping_at IS NULL
+  AND stopped_at IS NULL
+  AND current_deployment_id IS NOT NULL
+  AND is_deleted = false;
+
+-- name: MarkServiceSleeping :exec
+UPDATE services
+SET sleeping_at = NOW()
+WHERE id = $1 AND is_deleted = false;
+
+-- name: ClearServiceSleeping :exec
+UPDATE services
+SET sleeping_at = NULL
+WHERE id = $1 AND sleeping_at IS NOT NULL;
+
+-- name: SetServiceStopped :exec
+UPDATE services
+SET stopped_at = NOW(), sleeping_at = NULL
+WHERE id = $1 AND is_deleted = false;
+
+-- name: ClearServiceStopped :exec
+UPDATE services
+SET stopped_at = NULL
+WHERE id = $1 AND stopped_at IS NOT NULL;
+
+-- name: SetServiceImage :exec
+UPDATE services
+SET image = $2, registry_credentials = $3, updated_at = NOW()
+WHERE id = $1 AND is_deleted = false;
+
+-- name: ListRegistryGCCandidatesByRegion :many
+SELECT * FROM services
+WHERE region = $1
+  AND (is_deleted = false OR registry_purged_at IS NULL);
+
+-- name: MarkServiceRegistryPurged :exec
+UPDATE services
+SET registry_purged_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,18 @@
+-- name: CreateSSHKey :one
+INSERT INTO ssh_keys (id, user_id, name, public_key, fingerprint)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetSSHKeyByFingerprint :one
+SELECT * FROM ssh_keys WHERE fingerprint = $1;
+
+-- name: ListSSHKeysByUserID :many
+SELECT * FROM ssh_keys
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: DeleteSSHKey :execrows
+DELETE FROM ssh_keys WHERE id = $1 AND user_id = $2;
+
+-- name: UpdateSSHKeyLastUsed :exec
+UPDATE ssh_keys SET last_used_at = NOW() WHERE id = $1;
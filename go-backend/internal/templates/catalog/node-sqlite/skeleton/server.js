@@ -0,0 +1,28 @@
+import http from "node:http";
+import { createClient } from "@libsql/client";
+
+const db = createClient({
+  url: process.env.DATABASE_URL,
+  authToken: process.env.DATABASE_AUTH_TOKEN,
+});
+
+await db.execute(
+  "CREATE TABLE IF NOT EXISTS visits (id INTEGER PRIMARY KEY, at TEXT NOT NULL)"
+);
+
+const server = http.createServer(async (req, res) => {
+  if (req.url !== "/") {
+    res.writeHead(404).end("not found\n");
+    return;
+  }
+  await db.execute({
+    sql: "INSERT INTO visits (at) VALUES (?)",
+    args: [new Date().toISOString()],
+  });
+  const { rows } = await db.execute("SELECT COUNT(*) AS n FROM visits");
+  res.writeHead(200, { "Content-Type": "text/plain" });
+  res.end(`Hello! This page has been visited ${rows[0].n} times.\n`);
+});
+
+const port = process.env.PORT || 3000;
+server.listen(port, () => console.log(`listening on ${port}`));
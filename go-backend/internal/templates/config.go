@@ -0,0 +1,8 @@
+package templates
+
+type Config struct {
+	// GitServerAdminToken and GitServerCloneHost reach the git server over
+	// the cluster network; it only accepts the admin token there.
+	GitServerAdminToken string
+	GitServerCloneHost  string
+}
@@ -0,0 +1,115 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	skeletonBranch = "main"
+	commitAuthor   = "Ink"
+	commitEmail    = "templates@ml.ink"
+)
+
+type Service struct {
+	config Config
+	logger *slog.Logger
+}
+
+func NewService(config Config, logger *slog.Logger) *Service {
+	return &Service{config: config, logger: logger}
+}
+
+// Push commits the template's skeleton as the first commit on main of the
+// internal repo fullName (owner/repo). The repo must be empty: pushing
+// over existing history is rejected by the git server.
+func (s *Service) Push(ctx context.Context, t *Template, fullName string) error {
+	owner, repo, ok := strings.Cut(fullName, "/")
+	if !ok {
+		return fmt.Errorf("invalid internal repo: %s", fullName)
+	}
+	if s.config.GitServerAdminToken == "" || s.config.GitServerCloneHost == "" {
+		return fmt.Errorf("templates are not configured on this server")
+	}
+	remote := fmt.Sprintf("http://x-admin-token:%s@%s/%s/%s.git",
+		s.config.GitServerAdminToken, s.config.GitServerCloneHost, owner, repo)
+
+	files, err := t.Files()
+	if err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Initial commit from template %s v%d", t.Name, t.Version)
+	if err := pushFiles(ctx, files, remote, message); err != nil {
+		return err
+	}
+	s.logger.Info("pushed template skeleton", "template", t.Name, "version", t.Version, "repo", fullName)
+	return nil
+}
+
+// pushFiles writes files to a fresh repository, commits them and pushes
+// the commit to the main branch of remote.
+func pushFiles(ctx context.Context, files map[string][]byte, remote, message string) error {
+	dir, err := os.MkdirTemp("", "template-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for name, data := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			return fmt.Errorf("write %s: %w", name, err)
+		}
+		if err := os.WriteFile(p, data, 0o644); err != nil {
+			return fmt.Errorf("write %s: %w", name, err)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch", skeletonBranch},
+		{"add", "--all"},
+		{"-c", "user.name=" + commitAuthor, "-c", "user.email=" + commitEmail, "commit", "--quiet", "-m", message},
+		{"push", "--quiet", remote, "HEAD:refs/heads/" + skeletonBranch},
+	} {
+		if err := runGit(ctx, dir, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runGit(ctx context.Context, dir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		// The push URL carries the admin token; never echo it back.
+		name := args[0]
+		if name == "-c" {
+			name = "commit"
+		}
+		return fmt.Errorf("git %s failed: %w\noutput: %s", name, err, redactToken(string(output)))
+	}
+	return nil
+}
+
+func redactToken(s string) string {
+	const prefix = "x-admin-token:"
+	for {
+		idx := strings.Index(s, prefix)
+		if idx < 0 {
+			return s
+		}
+		end := strings.Index(s[idx:], "@")
+		if end < 0 {
+			return s[:idx] + "[redacted]"
+		}
+		s = s[:idx] + "[redacted]" + s[idx+end:]
+	}
+}
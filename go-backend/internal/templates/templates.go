@@ -0,0 +1,148 @@
+package templates
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+
+	"github.com/augustdev/autoclip/internal/projectplan"
+	"sigs.k8s.io/yaml"
+)
+
+// catalog holds one directory per template: template.yaml with the stack
+// spec, and skeleton/ with the files pushed as the repo's first commit.
+//
+//go:embed all:catalog
+var catalog embed.FS
+
+const (
+	specFileName = "template.yaml"
+	skeletonDir  = "skeleton"
+)
+
+var nameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Template is a starter app: a source skeleton plus the services,
+// resources and links it runs with. Services build from the repo the
+// skeleton is pushed to, so they don't name one.
+type Template struct {
+	Name        string                     `json:"name"`
+	Version     int                        `json:"version"`
+	Title       string                     `json:"title"`
+	Description string                     `json:"description"`
+	Services    []projectplan.ServiceSpec  `json:"services"`
+	Resources   []projectplan.ResourceSpec `json:"resources,omitempty"`
+	Links       []projectplan.LinkSpec     `json:"links,omitempty"`
+}
+
+// List returns every template, sorted by name.
+func List() ([]Template, error) {
+	entries, err := catalog.ReadDir("catalog")
+	if err != nil {
+		return nil, err
+	}
+	var out []Template
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		t, err := Get(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// Get loads and validates the named template.
+func Get(name string) (*Template, error) {
+	if !nameRe.MatchString(name) {
+		return nil, fmt.Errorf("template %q not found", name)
+	}
+	data, err := catalog.ReadFile(path.Join("catalog", name, specFileName))
+	if err != nil {
+		return nil, fmt.Errorf("template %q not found", name)
+	}
+	var t Template
+	if err := yaml.UnmarshalStrict(data, &t); err != nil {
+		return nil, fmt.Errorf("template %s: %w", name, err)
+	}
+	if t.Name != name {
+		return nil, fmt.Errorf("template %s: name is %q, want the directory name", name, t.Name)
+	}
+	if t.Version < 1 {
+		return nil, fmt.Errorf("template %s: version must be at least 1", name)
+	}
+	if len(t.Services) == 0 {
+		return nil, fmt.Errorf("template %s: declares no services", name)
+	}
+	for _, svc := range t.Services {
+		if svc.Repo != "" || svc.Image != "" {
+			return nil, fmt.Errorf("template %s: service %s builds from the template repo and can't set repo or image", name, svc.Name)
+		}
+	}
+	if err := projectplan.Validate(t.Spec("default", "app", "ink/app")); err != nil {
+		return nil, fmt.Errorf("template %s: %w", name, err)
+	}
+	return &t, nil
+}
+
+// Files returns the template's skeleton, keyed by slash-separated path
+// relative to the repo root.
+func (t *Template) Files() (map[string][]byte, error) {
+	root := path.Join("catalog", t.Name, skeletonDir)
+	files := map[string][]byte{}
+	err := fs.WalkDir(catalog, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := catalog.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[p[len(root)+1:]] = data
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("template %s: read skeleton: %w", t.Name, err)
+	}
+	return files, nil
+}
+
+// Spec is the project spec that runs the template as name in project,
+// building every service from repo. A single service is called name;
+// with several, and for resources, the template's names are prefixed with
+// name so two apps from the same template don't collide.
+func (t *Template) Spec(project, name, repo string) projectplan.Spec {
+	serviceName := func(s string) string {
+		if len(t.Services) == 1 {
+			return name
+		}
+		return name + "-" + s
+	}
+	resourceName := func(r string) string { return name + "-" + r }
+
+	spec := projectplan.Spec{Project: project}
+	for _, svc := range t.Services {
+		svc.Name = serviceName(svc.Name)
+		svc.Repo, svc.GitProvider = repo, "internal"
+		spec.Services = append(spec.Services, svc)
+	}
+	for _, r := range t.Resources {
+		r.Name = resourceName(r.Name)
+		spec.Resources = append(spec.Resources, r)
+	}
+	for _, l := range t.Links {
+		spec.Links = append(spec.Links, projectplan.LinkSpec{
+			Service:   serviceName(l.Service),
+			Resource:  resourceName(l.Resource),
+			EnvPrefix: l.EnvPrefix,
+		})
+	}
+	return spec
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/augustdev/autoclip/internal/deployments"
 	"github.com/augustdev/autoclip/internal/helpers"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/githubcreds"
 	"github.com/augustdev/autoclip/internal/storage/pg/generated/services"
@@ -18,7 +19,43 @@ import (
 type GitHubPushPayload struct {
 	Ref        string               `json:"ref"`
 	After      string               `json:"after"`
+	Deleted    bool                 `json:"deleted"`
 	Repository GitHubPushRepository `json:"repository"`
+	Commits    []GitHubPushCommit   `json:"commits"`
+	HeadCommit *GitHubPushCommit    `json:"head_commit"`
+}
+
+type GitHubPushCommit struct {
+	ID       string   `json:"id"`
+	Added    []string `json:"added"`
+	Removed  []string `json:"removed"`
+	Modified []string `json:"modified"`
+}
+
+// githubPushCommitLimit is the most commits GitHub lists in a push
+// payload; a push with more has its commits list truncated, so a full
+// list can't be told apart from a cut-off one.
+const githubPushCommitLimit = 2048
+
+// changedFiles returns every file the pushed commits touched, or nil when
+// the payload can't tell (no commits listed, or the list was truncated).
+func (p GitHubPushPayload) changedFiles() []string {
+	if len(p.Commits) == 0 || len(p.Commits) >= githubPushCommitLimit {
+		return nil
+	}
+	seen := map[string]bool{}
+	files := []string{}
+	for _, c := range p.Commits {
+		for _, list := range [][]string{c.Added, c.Removed, c.Modified} {
+			for _, f := range list {
+				if !seen[f] {
+					seen[f] = true
+					files = append(files, f)
+				}
+			}
+		}
+	}
+	return files
 }
 
 type GitHubPushRepository struct {
@@ -39,6 +76,7 @@ type GitHubInstallationPayload struct {
 type WebhookResponse struct {
 	Message     string           `json:"message"`
 	Deployments []DeploymentInfo `json:"deployments,omitempty"`
+	Skipped     []string         `json:"skipped,omitempty"`
 }
 
 type DeploymentInfo struct {
@@ -154,6 +192,11 @@ func (h *Handlers) handlePushWebhook(w http.ResponseWriter, r *http.Request, bod
 		return
 	}
 
+	if strings.HasPrefix(payload.Ref, "refs/tags/") {
+		h.handleTagPush(w, r, payload)
+		return
+	}
+
 	// Extract repo and branch
 	repo := payload.Repository.FullName
 	branch := strings.TrimPrefix(payload.Ref, "refs/heads/")
@@ -191,9 +234,16 @@ func (h *Handlers) handlePushWebhook(w http.ResponseWriter, r *http.Request, bod
 		return
 	}
 
-	// Start redeploy workflow for each service
+	// Start redeploy workflow for each service whose watch_paths changed
+	changed := payload.changedFiles()
 	var deploys []DeploymentInfo
+	var skipped []string
 	for _, svc := range matchingServices {
+		if h.deployService.SkipUnwatchedPush(r.Context(), svc, after, changed) {
+			skipped = append(skipped, svc.ID)
+			continue
+		}
+
 		workflowID, err := h.deployService.RedeployFromGitHubPush(r.Context(), svc.ID, after, delivery)
 		if err != nil {
 			h.logger.Error("failed to start redeploy workflow",
@@ -217,6 +267,78 @@ func (h *Handlers) handlePushWebhook(w http.ResponseWriter, r *http.Request, bod
 	json.NewEncoder(w).Encode(WebhookResponse{
 		Message:     "redeploy workflows started",
 		Deployments: deploys,
+		Skipped:     skipped,
+	})
+}
+
+// handleTagPush starts release deploys for services whose tag_pattern
+// matches the pushed tag. Deleted tags are ignored.
+func (h *Handlers) handleTagPush(w http.ResponseWriter, r *http.Request, payload GitHubPushPayload) {
+	repo := payload.Repository.FullName
+	tag := strings.TrimPrefix(payload.Ref, "refs/tags/")
+
+	h.logger.Info("received tag push webhook",
+		"repo", repo,
+		"tag", tag,
+		"deleted", payload.Deleted)
+
+	w.Header().Set("Content-Type", "application/json")
+	if payload.Deleted {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(WebhookResponse{Message: "ignored tag deletion"})
+		return
+	}
+
+	tagServices, err := h.servicesQ.GetTagServicesByRepoProvider(r.Context(), services.GetTagServicesByRepoProviderParams{
+		Repo:        repo,
+		GitProvider: "github",
+	})
+	if err != nil {
+		h.logger.Error("failed to query release services", "error", err)
+		http.Error(w, "failed to query services", http.StatusInternalServerError)
+		return
+	}
+
+	// "after" is the tag object for annotated tags; head_commit is the commit
+	var commitSHA string
+	if payload.HeadCommit != nil {
+		commitSHA = payload.HeadCommit.ID
+	}
+
+	var deploys []DeploymentInfo
+	for _, svc := range tagServices {
+		if svc.TagPattern == nil || !deployments.MatchesTagPattern(*svc.TagPattern, tag) {
+			continue
+		}
+
+		workflowID, err := h.deployService.RedeployFromTag(r.Context(), svc.ID, tag, commitSHA)
+		if err != nil {
+			h.logger.Error("failed to start release deploy",
+				"serviceID", svc.ID,
+				"tag", tag,
+				"error", err)
+			continue
+		}
+
+		h.logger.Info("started release deploy",
+			"serviceID", svc.ID,
+			"tag", tag,
+			"workflowID", workflowID)
+
+		deploys = append(deploys, DeploymentInfo{
+			ServiceID:  svc.ID,
+			WorkflowID: workflowID,
+		})
+	}
+
+	message := "release deploys started"
+	if len(deploys) == 0 {
+		message = "no services match this tag"
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(WebhookResponse{
+		Message:     message,
+		Deployments: deploys,
 	})
 }
 